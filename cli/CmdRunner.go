@@ -28,6 +28,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -40,6 +41,8 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/openthread/ot-ns/dispatcher"
+	"github.com/openthread/ot-ns/dissectpkt"
+	"github.com/openthread/ot-ns/pcap"
 	"github.com/openthread/ot-ns/progctx"
 	"github.com/openthread/ot-ns/radiomodel"
 	"github.com/openthread/ot-ns/simulation"
@@ -50,6 +53,8 @@ import (
 
 const (
 	Prompt = "> "
+
+	defaultFramesCount = 20 // number of frames shown by the 'frames' command by default.
 )
 
 type CommandContext struct {
@@ -227,6 +232,8 @@ func (rt *CmdRunner) execute(cmd *Command, output io.Writer) {
 		rt.executeLsNodes(cc, cc.Nodes)
 	} else if cmd.Partitions != nil {
 		rt.executeLsPartitions(cc)
+	} else if cmd.Pcap != nil {
+		rt.executePcap(cc, cmd.Pcap)
 	} else if cmd.Add != nil {
 		rt.executeAddNode(cc, cmd.Add)
 	} else if cmd.Del != nil {
@@ -245,6 +252,12 @@ func (rt *CmdRunner) execute(cmd *Command, output io.Writer) {
 		rt.executeCollectPings(cc, cc.Pings)
 	} else if cmd.Counters != nil {
 		rt.executeCounters(cc, cc.Counters)
+	} else if cmd.Stats != nil {
+		rt.executeStats(cc, cc.Stats)
+	} else if cmd.Cut != nil {
+		rt.executeCut(cc, cc.Cut)
+	} else if cmd.Heal != nil {
+		rt.executeHeal(cc, cc.Heal)
 	} else if cmd.Joins != nil {
 		rt.executeCollectJoins(cc, cc.Joins)
 	} else if cmd.Coaps != nil {
@@ -261,6 +274,10 @@ func (rt *CmdRunner) execute(cmd *Command, output io.Writer) {
 		rt.executeDemoLegend(cc, cmd.DemoLegend)
 	} else if cmd.Exit != nil {
 		rt.executeExit(cc, cmd.Exit)
+	} else if cmd.Frames != nil {
+		rt.executeFrames(cc, cmd.Frames)
+	} else if cmd.Heatmap != nil {
+		rt.executeHeatmap(cc, cc.Heatmap)
 	} else if cmd.Web != nil {
 		rt.executeWeb(cc, cc.Web)
 	} else if cmd.NetInfo != nil {
@@ -269,6 +286,8 @@ func (rt *CmdRunner) execute(cmd *Command, output io.Writer) {
 		rt.executeRadioModel(cc, cc.RadioModel)
 	} else if cmd.RadioParam != nil {
 		rt.executeRadioParam(cc, cc.RadioParam)
+	} else if cmd.RestartPolicy != nil {
+		rt.executeRestartPolicy(cc, cc.RestartPolicy)
 	} else if cmd.RxSens != nil {
 		rt.executeRxSens(cc, cc.RxSens)
 	} else if cmd.Energy != nil {
@@ -375,38 +394,32 @@ func (rt *CmdRunner) postAsyncWait(cc *CommandContext, f func(sim *simulation.Si
 
 func (rt *CmdRunner) executeAddNode(cc *CommandContext, cmd *AddCmd) {
 	logger.Debugf("Add: %#v", *cmd)
-	simCfg := cc.rt.sim.GetConfig()
-	cfg := simCfg.NewNodeConfig // copy current new-node config for simulation, and modify it.
-
-	if cmd.X != nil {
-		cfg.X = *cmd.X
-		cfg.IsAutoPlaced = false
+	opts := &simulation.NodeOptions{
+		Type:    cmd.Type.Val,
+		X:       cmd.X,
+		Y:       cmd.Y,
+		Restore: cmd.Restore != nil,
+		Rcp:     cmd.Rcp != nil,
 	}
-	if cmd.Y != nil {
-		cfg.Y = *cmd.Y
-		cfg.IsAutoPlaced = false
-	}
-
-	UpdateNodeConfig(&cfg, cmd.Type.Val)
-
 	if cmd.Id != nil {
-		cfg.ID = cmd.Id.Val
+		opts.Id = cmd.Id.Val
 	}
-
 	if cmd.RadioRange != nil {
-		cfg.RadioRange = cmd.RadioRange.Val
+		opts.RadioRange = cmd.RadioRange.Val
 	}
-
 	if cmd.Executable != nil {
-		cfg.ExecutablePath = simCfg.ExeConfig.DetermineExecutableBasedOnExeName(cmd.Executable.Path)
+		opts.Executable = cmd.Executable.Path
 	} else if cmd.Version != nil {
-		cfg.ExecutablePath = simulation.GetExecutableForThreadVersion(cmd.Version.Val)
+		opts.Version = cmd.Version.Val
+	}
+	cfg, err := cc.rt.sim.GetConfig().NewNodeConfigFor(opts)
+	if err != nil {
+		cc.error(err)
+		return
 	}
-
-	cfg.Restore = cmd.Restore != nil
 
 	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
-		node, err := sim.AddNode(&cfg)
+		node, err := sim.AddNode(cfg)
 		if err != nil {
 			cc.error(err)
 			return
@@ -634,21 +647,80 @@ func (rt *CmdRunner) executeRadio(cc *CommandContext, radio *RadioCmd) {
 			} else if radio.Off != nil {
 				sim.SetNodeFailed(node.Id, true)
 			} else if radio.FailTime != nil {
-				if radio.FailTime.FailDuration > 0 && radio.FailTime.FailInterval > radio.FailTime.FailDuration {
-					dnode.SetFailTime(dispatcher.FailTime{
-						FailDuration: uint64(radio.FailTime.FailDuration * 1000000),
-						FailInterval: uint64(radio.FailTime.FailInterval * 1000000),
-					})
-				} else if radio.FailTime.FailInterval <= radio.FailTime.FailDuration {
-					cc.errorf("ft parameter: fail-duration must be < fail-interval")
-				} else {
-					dnode.SetFailTime(dispatcher.NonFailTime)
+				ft := radio.FailTime
+				if ft.Exp == nil && ft.Weibull == nil && ft.FailDuration == 0 {
+					cc.error(dnode.SetFailTime(dispatcher.NonFailTime))
+					continue
+				}
+				model, err := newFailureModel(ft)
+				if err != nil {
+					cc.errorf("ft parameter: %v", err)
+					continue
 				}
+				kind := dispatcher.FailureKindRadio
+				if ft.Reboot != nil {
+					kind = dispatcher.FailureKindReboot
+					if ft.Reboot.Wipe != nil {
+						kind = dispatcher.FailureKindRebootWipe
+					}
+				}
+				dnode.SetFailureModel(model, kind)
 			}
 		}
 	})
 }
 
+func newFailureModel(ft *FailTimeParams) (dispatcher.FailureModel, error) {
+	if ft.Exp != nil {
+		return dispatcher.NewExponentialFailureModel(uint64(ft.Exp.Mtbf*1000000), uint64(ft.Exp.Mttr*1000000))
+	} else if ft.Weibull != nil {
+		return dispatcher.NewWeibullFailureModel(ft.Weibull.Shape, uint64(ft.Weibull.Mtbf*1000000),
+			uint64(ft.Weibull.Mttr*1000000))
+	}
+	return dispatcher.NewIntervalFailureModel(dispatcher.FailTime{
+		FailDuration: uint64(ft.FailDuration * 1000000),
+		FailInterval: uint64(ft.FailInterval * 1000000),
+	})
+}
+
+func (rt *CmdRunner) executeRestartPolicy(cc *CommandContext, cmd *RestartPolicyCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		for _, sel := range cmd.Nodes {
+			node, _ := rt.getNode(sim, sel)
+			if node == nil {
+				cc.errorf("node %d not found", sel.Id)
+				continue
+			}
+
+			if cmd.Never == nil && cmd.OnFailure == nil && cmd.Always == nil {
+				cc.outputf("%d\t%s\n", node.Id, node.GetRestartPolicy())
+				continue
+			}
+
+			policy := simulation.DefaultRestartPolicy()
+			if cmd.OnFailure != nil {
+				policy.Mode = simulation.RestartOnFailure
+			} else if cmd.Always != nil {
+				policy.Mode = simulation.RestartAlways
+			}
+			if cmd.Backoff != nil {
+				policy.Backoff = uint64(*cmd.Backoff * 1000000)
+				if policy.Backoff > policy.MaxBackoff {
+					policy.MaxBackoff = policy.Backoff
+				}
+			}
+			if cmd.MaxBackoff != nil {
+				policy.MaxBackoff = uint64(*cmd.MaxBackoff * 1000000)
+				if policy.MaxBackoff < policy.Backoff {
+					cc.errorf("max-backoff must be >= backoff")
+					return
+				}
+			}
+			node.SetRestartPolicy(policy)
+		}
+	})
+}
+
 func (rt *CmdRunner) executeMoveNode(cc *CommandContext, cmd *MoveCmd) {
 	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
 		cc.error(sim.MoveNodeTo(cmd.Target.Id, cmd.X, cmd.Y))
@@ -664,7 +736,11 @@ func (rt *CmdRunner) executeLsNodes(cc *CommandContext, cmd *NodesCmd) {
 			line.WriteString(fmt.Sprintf("id=%d\textaddr=%016x\trloc16=%04x\tx=%d\ty=%d\tstate=%s\tfailed=%v", nodeid, dnode.ExtAddr, dnode.Rloc16,
 				dnode.X, dnode.Y, dnode.Role, dnode.IsFailed()))
 			line.WriteString(fmt.Sprintf("\texe=%s", snode.GetExecutableName()))
+			line.WriteString(fmt.Sprintf("\tcrashes=%d", snode.GetCrashCount()))
 			cc.outputf("%s\n", line.String())
+			for _, stderrLine := range snode.GetLastStderrLines() {
+				cc.outputf("\tstderr: %s\n", stderrLine)
+			}
 		}
 	})
 }
@@ -743,6 +819,125 @@ func (rt *CmdRunner) executeCounters(cc *CommandContext, counters *CountersCmd)
 	})
 }
 
+func (rt *CmdRunner) executeStats(cc *CommandContext, cmd *StatsCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		d := sim.Dispatcher()
+		if cmd.Reset != nil {
+			d.ResetFrameStats()
+		} else if cmd.Links != nil {
+			for _, ls := range d.GetLinkFrameStats() {
+				cc.outputf("src=%d\tdst=%d\t%s\n", ls.Src, ls.Dst, formatFrameStats(&ls.FrameStats))
+			}
+		} else if cmd.Node != nil {
+			node, _ := rt.getNode(sim, *cmd.Node)
+			if node == nil {
+				cc.errorf("node %d not found", cmd.Node.Id)
+				return
+			}
+			ns := d.GetNodeFrameStats(node.Id)
+			cc.outputf("tx_frames=%d\n", ns.TxFrames)
+			cc.outputf("tx_types\t%s\n", formatFrameTypes(&ns))
+			cc.outputf("tx\t%s\n", formatFrameStats(&ns.Tx))
+			cc.outputf("rx\t%s\n", formatFrameStats(&ns.Rx))
+		} else {
+			for _, nodeid := range sim.GetNodes() {
+				ns := d.GetNodeFrameStats(nodeid)
+				cc.outputf("node=%d\ttx_frames=%d\ttx_delivered=%d\ttx_dropped=%d\trx_delivered=%d\trx_dropped=%d\n",
+					nodeid, ns.TxFrames, ns.Tx.Delivered, ns.Tx.TotalDropped(), ns.Rx.Delivered, ns.Rx.TotalDropped())
+			}
+		}
+	})
+}
+
+func (rt *CmdRunner) executeFrames(cc *CommandContext, cmd *FramesCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		d := sim.Dispatcher()
+		if cmd.Clear != "" {
+			d.ClearFrames()
+			return
+		}
+
+		filter := &dispatcher.FrameFilter{}
+		if cmd.Src != nil {
+			filter.Src = cmd.Src.Id
+		}
+		if cmd.Dst != nil {
+			filter.Dst = cmd.Dst.Id
+		}
+		if cmd.Type != nil {
+			frameType, err := dissectpkt.ParseWpanFrameType(*cmd.Type)
+			if err != nil {
+				cc.error(err)
+				return
+			}
+			filter.Type = &frameType
+		}
+		if cmd.Channel != nil {
+			filter.Channel = *cmd.Channel
+		}
+		if cmd.From != nil {
+			filter.From = *cmd.From
+		}
+		if cmd.To != nil {
+			filter.To = *cmd.To
+		}
+		count := defaultFramesCount
+		if cmd.Count != nil {
+			count = *cmd.Count
+		}
+
+		for _, fr := range d.GetFrames(filter, count) {
+			if cmd.Json != "" {
+				data, err := json.Marshal(fr)
+				if err != nil {
+					cc.error(err)
+					return
+				}
+				cc.outputf("%s\n", data)
+			} else {
+				cc.outputf("%s\n", formatFrameRecord(fr))
+			}
+		}
+	})
+}
+
+// formatFrameRecord formats a frame history record as a single line.
+func formatFrameRecord(fr *dispatcher.FrameRecord) string {
+	dst := "-"
+	if fr.Dst == BroadcastNodeId {
+		dst = "*"
+	} else if fr.Dst != InvalidNodeId {
+		dst = strconv.Itoa(fr.Dst)
+	}
+	receivers := make([]string, len(fr.Receivers))
+	for i, r := range fr.Receivers {
+		if r.Delivered {
+			receivers[i] = fmt.Sprintf("%d:ok(%ddBm)", r.Node, r.RssiDbm)
+		} else {
+			receivers[i] = fmt.Sprintf("%d:%s", r.Node, r.Reason)
+		}
+	}
+	return fmt.Sprintf("%d\tch=%d\t%d->%s\t%s\tseq=%d\tlen=%d\t%s\t%s", fr.Timestamp, fr.Channel, fr.Src, dst,
+		fr.Type, fr.Seq, fr.Length, fr.Info, strings.Join(receivers, " "))
+}
+
+func formatFrameTypes(ns *dispatcher.NodeFrameStats) string {
+	var parts []string
+	for frameType, n := range ns.TxFrameTypes {
+		parts = append(parts, fmt.Sprintf("%s=%d", dissectpkt.WpanFrameType(frameType), n))
+	}
+	return strings.Join(parts, "\t")
+}
+
+func formatFrameStats(fs *dispatcher.FrameStats) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("delivered=%d", fs.Delivered))
+	for reason, n := range fs.Dropped {
+		sb.WriteString(fmt.Sprintf("\t%s=%d", dispatcher.FrameDropReason(reason), n))
+	}
+	return sb.String()
+}
+
 func (rt *CmdRunner) executeWeb(cc *CommandContext, webcmd *WebCmd) {
 	if err := web.OpenWeb(rt.ctx); err != nil {
 		cc.error(err)
@@ -951,25 +1146,321 @@ func (rt *CmdRunner) executeUnwatch(cc *CommandContext, cmd *UnwatchCmd) {
 }
 
 func (rt *CmdRunner) executePlr(cc *CommandContext, cmd *PlrCmd) {
-	if cmd.Val == nil {
-		// get PLR
-		var plr float64
+	var model dispatcher.LossModel
+	var err error
+	isSet := true
+
+	// determine the new loss model, if any.
+	if cmd.Val != nil {
+		model = dispatcher.NewUniformLossModel(*cmd.Val)
+	} else if cmd.Ge != nil {
+		lossGood, lossBad := 0.0, 1.0
+		if cmd.Ge.LossGood != nil {
+			lossGood, lossBad = *cmd.Ge.LossGood, *cmd.Ge.LossBad
+		}
+		model, err = dispatcher.NewGilbertElliottLossModel(cmd.Ge.PGoodBad, cmd.Ge.PBadGood, lossGood, lossBad)
+	} else if cmd.Outage != nil {
+		model, err = dispatcher.NewPeriodicOutageLossModel(uint64(cmd.Outage.Period*1000000),
+			uint64(cmd.Outage.Duration*1000000))
+	} else if cmd.Default == nil {
+		isSet = false
+	}
+	if err != nil {
+		cc.error(err)
+		return
+	}
 
-		rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
-			plr = sim.Dispatcher().GetGlobalMessageDropRatio()
-		})
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		d := sim.Dispatcher()
+		if cmd.Node != nil {
+			// variant: plr node <node-id> [<model>]
+			if node, _ := rt.getNode(sim, *cmd.Node); node == nil {
+				cc.errorf("node %d not found", cmd.Node.Id)
+				return
+			}
+			if isSet {
+				d.SetNodeLossModel(cmd.Node.Id, model)
+			}
+			model = d.GetNodeLossModel(cmd.Node.Id)
+		} else if cmd.Link != nil {
+			// variant: plr link <src-id> <dst-id> [<model>]
+			for _, sel := range []NodeSelector{cmd.Link.Src, cmd.Link.Dst} {
+				if node, _ := rt.getNode(sim, sel); node == nil {
+					cc.errorf("node %d not found", sel.Id)
+					return
+				}
+			}
+			if isSet {
+				d.SetLinkLossModel(cmd.Link.Src.Id, cmd.Link.Dst.Id, model)
+			}
+			model = d.GetLinkLossModel(cmd.Link.Src.Id, cmd.Link.Dst.Id)
+		} else {
+			// variant: plr [<model>]
+			if isSet {
+				d.SetLossModel(model)
+			}
+			model = d.GetLossModel()
+		}
+	})
 
-		cc.outputf("%v\n", plr)
+	if cc.Err() != nil {
+		return
+	}
+	if model == nil {
+		if cmd.Node != nil || cmd.Link != nil {
+			cc.outputf("default\n")
+		} else {
+			cc.outputf("0\n")
+		}
 	} else {
-		// set PLR
-		rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
-			sim.Dispatcher().SetGlobalPacketLossRatio(*cmd.Val)
-			*cmd.Val = sim.Dispatcher().GetGlobalMessageDropRatio()
-		})
-		cc.outputf("%v\n", *cmd.Val)
+		cc.outputf("%s\n", model.String())
 	}
 }
 
+func (rt *CmdRunner) executeCut(cc *CommandContext, cmd *CutCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		if len(cmd.GroupA) == 0 {
+			// variant: 'cut' - list the active cuts.
+			for _, pc := range sim.Dispatcher().GetPartitionCuts() {
+				cc.outputf("groupA=%s\tgroupB=%s\n", joinNodeIds(pc.GroupA), joinNodeIds(pc.GroupB))
+			}
+			return
+		}
+
+		// variant: 'cut <node-id> ... | <node-id> ...'
+		var groups [2][]NodeId
+		for i, sels := range [][]NodeSelector{cmd.GroupA, cmd.GroupB} {
+			for _, sel := range sels {
+				node, _ := rt.getNode(sim, sel)
+				if node == nil {
+					cc.errorf("node %d not found", sel.Id)
+					return
+				}
+				groups[i] = append(groups[i], node.Id)
+			}
+		}
+		cc.error(sim.Dispatcher().CutPartition(groups[0], groups[1]))
+	})
+}
+
+func (rt *CmdRunner) executeHeal(cc *CommandContext, cmd *HealCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		sim.Dispatcher().HealPartitions()
+	})
+}
+
+func (rt *CmdRunner) executeHeatmap(cc *CommandContext, cmd *HeatmapCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		if cmd.Hide != "" {
+			sim.ShowRssiHeatmap(nil)
+			return
+		}
+
+		var txIds []NodeId
+		for _, sel := range cmd.Nodes {
+			txIds = append(txIds, sel.Id)
+		}
+		cellSize := simulation.DefaultHeatmapCellSize
+		if cmd.CellSize != nil {
+			cellSize = *cmd.CellSize
+		}
+		heatmap, err := sim.RssiHeatmap(txIds, cellSize)
+		if err != nil {
+			cc.error(err)
+			return
+		}
+		if cmd.Show != "" {
+			sim.ShowRssiHeatmap(heatmap)
+		}
+
+		reachable := 0
+		for _, cell := range heatmap.Cells {
+			if cell.Reachable {
+				reachable++
+			}
+		}
+		cc.outputf("x=%d y=%d cell=%d cols=%d rows=%d reachable=%.1f%%\n", heatmap.X, heatmap.Y, heatmap.CellSize,
+			heatmap.Cols, heatmap.Rows, 100.0*float64(reachable)/float64(len(heatmap.Cells)))
+		if cmd.Summary != "" {
+			return
+		}
+		for row := 0; row < heatmap.Rows; row++ {
+			for col := 0; col < heatmap.Cols; col++ {
+				cell := heatmap.Cell(col, row)
+				reachableStr := "-"
+				if cell.Reachable {
+					reachableStr = "ok"
+				}
+				cc.outputf("%d\t%d\trssi=%.1f\tsinr=%.1f\tnode=%d\t%s\n",
+					heatmap.X+col*heatmap.CellSize+heatmap.CellSize/2, heatmap.Y+row*heatmap.CellSize+heatmap.CellSize/2,
+					cell.Rssi, cell.Sinr, cell.NodeId, reachableStr)
+			}
+		}
+	})
+}
+
+func (rt *CmdRunner) executePcap(cc *CommandContext, cmd *PcapCmd) {
+	if cmd.Live != nil {
+		rt.executePcapLive(cc, cmd.Live)
+		return
+	}
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		d := sim.Dispatcher()
+		pf := d.GetPcapFile()
+		if pf == nil {
+			cc.errorf("pcap is disabled")
+			return
+		}
+		filter := d.GetPcapFilter()
+
+		if cmd.Nodes != nil {
+			var nodes []NodeId
+			for _, sel := range cmd.Nodes.Nodes {
+				node, _ := rt.getNode(sim, sel)
+				if node == nil {
+					cc.errorf("node %d not found", sel.Id)
+					return
+				}
+				nodes = append(nodes, node.Id)
+			}
+			filter.Nodes = nodes
+		} else if cmd.Types != nil {
+			var exclude []dissectpkt.WpanFrameType
+			for _, s := range cmd.Types.Exclude {
+				frameType, err := dissectpkt.ParseWpanFrameType(s)
+				if err != nil {
+					cc.error(err)
+					return
+				}
+				exclude = append(exclude, frameType)
+			}
+			filter.ExcludeTypes = exclude
+		} else if cmd.Rotate != nil {
+			if cmd.Rotate.Off != nil {
+				pf.SetRotation(0, 0)
+			} else if *cmd.Rotate.SizeMb <= 0 || (cmd.Rotate.Keep != nil && *cmd.Rotate.Keep < 0) {
+				cc.errorf("invalid rotation size or number of files to keep")
+			} else {
+				keep := 0
+				if cmd.Rotate.Keep != nil {
+					keep = *cmd.Rotate.Keep
+				}
+				pf.SetRotation(int64(*cmd.Rotate.SizeMb)*1000000, keep)
+			}
+		} else if cmd.On != nil {
+			duration := dispatcher.Ever
+			if cmd.On.Time != "" {
+				dur, err := time.ParseDuration(cmd.On.Time)
+				if err != nil {
+					dur, err = time.ParseDuration(cmd.On.Time + "s") // try parsing as seconds
+				}
+				if err != nil || dur <= 0 {
+					cc.errorf("could not parse time duration: %s", cmd.On.Time)
+					return
+				}
+				duration = uint64(dur / time.Microsecond)
+			}
+			filter.SetCapturing(true, d.CurTime, duration)
+		} else if cmd.Off != nil {
+			filter.SetCapturing(false, d.CurTime, dispatcher.Ever)
+		} else {
+			// variant: 'pcap' - show the capture status.
+			rt.outputPcapStatus(cc, pf, filter)
+		}
+	})
+}
+
+func (rt *CmdRunner) outputPcapStatus(cc *CommandContext, pf *pcap.RotatingFile, filter *dispatcher.PcapFilter) {
+	cc.outputf("file=%s\tsize=%d\trotations=%d\n", pf.Filename(), pf.Size(), pf.Rotations())
+	if maxSize, keep := pf.Rotation(); maxSize > 0 {
+		keepStr := "all"
+		if keep > 0 {
+			keepStr = strconv.Itoa(keep)
+		}
+		cc.outputf("rotate=%dMB\tkeep=%s\n", maxSize/1000000, keepStr)
+	} else {
+		cc.outputf("rotate=off\n")
+	}
+	if filter.Off {
+		cc.outputf("capture=off\n")
+	} else if filter.OffTime < dispatcher.Ever {
+		cc.outputf("capture=on\tuntil=%d\n", filter.OffTime)
+	} else {
+		cc.outputf("capture=on\n")
+	}
+	nodes := "all"
+	if len(filter.Nodes) > 0 {
+		nodes = joinNodeIds(filter.Nodes)
+	}
+	exclude := make([]string, len(filter.ExcludeTypes))
+	for i, t := range filter.ExcludeTypes {
+		exclude[i] = t.String()
+	}
+	excludeStr := "none"
+	if len(exclude) > 0 {
+		excludeStr = strings.Join(exclude, ",")
+	}
+	cc.outputf("nodes=%s\texclude=%s\n", nodes, excludeStr)
+	cc.outputf("captured=%d\tfiltered=%d\n", filter.Captured, filter.Filtered)
+}
+
+func (rt *CmdRunner) executePcapLive(cc *CommandContext, live *PcapLiveCmd) {
+	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
+		lc := sim.Dispatcher().GetLiveCapture()
+		if lc == nil {
+			cc.errorf("pcap is disabled")
+			return
+		}
+		var ep *pcap.LiveEndpoint
+		var err error
+		if live.Tcp != nil {
+			ep, err = lc.ListenTcp(*live.Tcp, live.Channels)
+		} else if live.Pipe != nil {
+			ep, err = lc.OpenPipe(*live.Pipe, live.Channels)
+		} else if live.Stop != nil {
+			cc.error(lc.CloseEndpoint(*live.Stop))
+			return
+		} else {
+			// variant: 'pcap live' - list the endpoints and their clients.
+			for _, ep := range lc.Endpoints() {
+				cc.outputf("%d\t%s\tch=%s\n", ep.Id, ep, joinChannels(ep.Filter))
+				for _, c := range ep.Clients() {
+					cc.outputf("\tclient %s\tch=%s\tdropped=%d\n", c.Name, joinChannels(c.Filter), c.Dropped)
+				}
+			}
+			return
+		}
+		if err != nil {
+			cc.error(err)
+			return
+		}
+		cc.outputf("%d\t%s\n", ep.Id, ep)
+	})
+}
+
+// joinChannels returns the channels as a comma-separated list, or "all" if there are none.
+func joinChannels(channels []ChannelId) string {
+	if len(channels) == 0 {
+		return "all"
+	}
+	parts := make([]string, len(channels))
+	for i, ch := range channels {
+		parts[i] = strconv.Itoa(ch)
+	}
+	return strings.Join(parts, ",")
+}
+
+func joinNodeIds(ids []NodeId) string {
+	var sb strings.Builder
+	for i, id := range ids {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(strconv.Itoa(id))
+	}
+	return sb.String()
+}
+
 func (rt *CmdRunner) executeScan(cc *CommandContext, cmd *ScanCmd) {
 	rt.postAsyncWait(cc, func(sim *simulation.Simulation) {
 		node, _ := rt.getNode(sim, cmd.Node)
@@ -1113,6 +1604,7 @@ func (rt *CmdRunner) executeExe(cc *CommandContext, cmd *ExeCmd) {
 		cfg := sim.GetConfig()
 		isSetDefault := cmd.Default != nil
 		isSetNodeType := len(cmd.NodeType.Val) > 0
+		isSetRcpType := len(cmd.RcpType.Val) > 0
 		isSetVersion := len(cmd.Version.Val) > 0
 		isSetPath := len(cmd.Path) > 0
 
@@ -1136,6 +1628,20 @@ func (rt *CmdRunner) executeExe(cc *CommandContext, cmd *ExeCmd) {
 				cc.outputf("br : %s\n", cfg.ExeConfig.Br)
 			}
 			return
+		} else if isSetRcpType {
+			// get or set the exe of the RCP, or of the host, of RCP nodes.
+			if cmd.RcpType.Val == "rcp" {
+				if isSetPath {
+					cfg.ExeConfig.Rcp = cmd.Path
+				}
+				cc.outputf("rcp    : %s\n", cfg.ExeConfig.Rcp)
+			} else {
+				if isSetPath {
+					cfg.ExeConfig.RcpHost = cmd.Path
+				}
+				cc.outputf("rcphost: %s\n", cfg.ExeConfig.RcpHost)
+			}
+			return
 		} else if isSetDefault && !isSetPath && !isSetNodeType && !isSetVersion {
 			// set defaults for all node types.
 			cfg.ExeConfig = cfg.ExeConfigDefault
@@ -1144,6 +1650,8 @@ func (rt *CmdRunner) executeExe(cc *CommandContext, cmd *ExeCmd) {
 			cfg.ExeConfig.Ftd = simulation.GetExecutableForThreadVersion(cmd.Version.Val)
 			cfg.ExeConfig.Mtd = cfg.ExeConfig.Ftd
 			cfg.ExeConfig.Br = cfg.ExeConfigDefault.Br
+			cfg.ExeConfig.Rcp = cfg.ExeConfigDefault.Rcp
+			cfg.ExeConfig.RcpHost = cfg.ExeConfigDefault.RcpHost
 		} else if !isSetDefault && !isSetNodeType && !isSetVersion && !isSetPath {
 			// display the exe output list.
 		} else {
@@ -1154,6 +1662,8 @@ func (rt *CmdRunner) executeExe(cc *CommandContext, cmd *ExeCmd) {
 		cc.outputf("ftd: %s\n", cfg.ExeConfig.Ftd)
 		cc.outputf("mtd: %s\n", cfg.ExeConfig.Mtd)
 		cc.outputf("br : %s\n", cfg.ExeConfig.Br)
+		cc.outputf("rcp    : %s\n", cfg.ExeConfig.Rcp)
+		cc.outputf("rcphost: %s\n", cfg.ExeConfig.RcpHost)
 		cc.outputf("Executables search path: %s\n", cfg.ExeConfig.SearchPathsString())
 		cc.outputf("Detected FTD path      : %s\n", cfg.ExeConfig.DetermineExecutableBasedOnConfig(&cfg.NewNodeConfig))
 	})
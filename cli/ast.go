@@ -42,13 +42,17 @@ type Command struct {
 	ConfigVisualization *ConfigVisualizationCmd `| @@` //nolint
 	CountDown           *CountDownCmd           `| @@` //nolint
 	Counters            *CountersCmd            `| @@` //nolint
+	Cut                 *CutCmd                 `| @@` //nolint
 	Debug               *DebugCmd               `| @@` //nolint
 	Del                 *DelCmd                 `| @@` //nolint
 	DemoLegend          *DemoLegendCmd          `| @@` //nolint
 	Energy              *EnergyCmd              `| @@` //nolint
 	Exe                 *ExeCmd                 `| @@` //nolint
 	Exit                *ExitCmd                `| @@` //nolint
+	Frames              *FramesCmd              `| @@` //nolint
 	Go                  *GoCmd                  `| @@` //nolint
+	Heal                *HealCmd                `| @@` //nolint
+	Heatmap             *HeatmapCmd             `| @@` //nolint
 	Help                *HelpCmd                `| @@` //nolint
 	Joins               *JoinsCmd               `| @@` //nolint
 	LogLevel            *LogLevelCmd            `| @@` //nolint
@@ -57,15 +61,18 @@ type Command struct {
 	Node                *NodeCmd                `| @@` //nolint
 	Nodes               *NodesCmd               `| @@` //nolint
 	Partitions          *PartitionsCmd          `| @@` //nolint
+	Pcap                *PcapCmd                `| @@` //nolint
 	Ping                *PingCmd                `| @@` //nolint
 	Pings               *PingsCmd               `| @@` //nolint
 	Plr                 *PlrCmd                 `| @@` //nolint
 	Radio               *RadioCmd               `| @@` //nolint
 	RadioModel          *RadioModelCmd          `| @@` //nolint
 	RadioParam          *RadioParamCmd          `| @@` //nolint
+	RestartPolicy       *RestartPolicyCmd       `| @@` //nolint
 	RxSens              *RxSensCmd              `| @@` //nolint
 	Scan                *ScanCmd                `| @@` //nolint
 	Speed               *SpeedCmd               `| @@` //nolint
+	Stats               *StatsCmd               `| @@` //nolint
 	Time                *TimeCmd                `| @@` //nolint
 	Title               *TitleCmd               `| @@` //nolint
 	Unwatch             *UnwatchCmd             `| @@` //nolint
@@ -279,6 +286,7 @@ type AddCmd struct {
 	Id         *AddNodeId      `| @@`                 //nolint
 	RadioRange *RadioRangeFlag `| @@`                 //nolint
 	Restore    *RestoreFlag    `| @@`                 //nolint
+	Rcp        *RcpFlag        `| @@`                 //nolint
 	Version    *ThreadVersion  `| @@`                 //nolint
 	Executable *ExecutableFlag `| @@ )*`              //nolint
 }
@@ -303,6 +311,11 @@ type RestoreFlag struct {
 	Dummy struct{} `"restore"` //nolint
 }
 
+// noinspection GoVetStructTag
+type RcpFlag struct {
+	Dummy struct{} `"rcp"` //nolint
+}
+
 // noinspection GoVetStructTag
 type ThreadVersion struct {
 	Val string `@("v11"|"v12"|"v13"|"v131")` //nolint
@@ -351,6 +364,20 @@ type ExitCmd struct {
 	Cmd struct{} `"exit"` //nolint
 }
 
+// noinspection GoVetStructTag
+type FramesCmd struct {
+	Cmd     struct{}      `"frames"`        //nolint
+	Src     *NodeSelector `( "src" @@`      //nolint
+	Dst     *NodeSelector `| "dst" @@`      //nolint
+	Type    *string       `| "type" @Ident` //nolint
+	Channel *int          `| "ch" @Int`     //nolint
+	From    *uint64       `| "from" @Int`   //nolint
+	To      *uint64       `| "to" @Int`     //nolint
+	Count   *int          `| "count" @Int`  //nolint
+	Json    string        `| @"json"`       //nolint
+	Clear   string        `| @"clear" )*`   //nolint
+}
+
 // noinspection GoVetStructTag
 type WebCmd struct {
 	Cmd struct{} `"web"` //nolint
@@ -372,11 +399,17 @@ type SaveFlag struct {
 type ExeCmd struct {
 	Cmd      struct{}       `"exe"`       //nolint
 	NodeType NodeTypeOrRole `( @@`        //nolint
+	RcpType  RcpExeType     `| @@`        //nolint
 	Default  *DefaultFlag   `| @@`        //nolint
 	Version  ThreadVersion  `| @@ )?`     //nolint
 	Path     string         `[ @String ]` //nolint
 }
 
+// noinspection GoVetStructTag
+type RcpExeType struct {
+	Val string `@("rcp"|"rcphost")` //nolint
+}
+
 // noinspection GoVetStructTag
 type DefaultFlag struct {
 	Dummy struct{} `"default"` //nolint
@@ -456,10 +489,125 @@ type CountersCmd struct {
 	Cmd struct{} `"counters"` //nolint
 }
 
+// noinspection GoVetStructTag
+type CutCmd struct {
+	Cmd    struct{}       `"cut"`           //nolint
+	GroupA []NodeSelector `[ ( @@ )+`       //nolint
+	GroupB []NodeSelector `  "|" ( @@ )+ ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type HealCmd struct {
+	Cmd struct{} `"heal"` //nolint
+}
+
+// noinspection GoVetStructTag
+type HeatmapCmd struct {
+	Cmd      struct{}       `"heatmap"`          //nolint
+	Hide     string         `[ @"hide"`          //nolint
+	Nodes    []NodeSelector `| ( @@ )*`          //nolint
+	CellSize *int           `  [ "cell" @Int ]`  //nolint
+	Show     string         `  [ @"show" ]`      //nolint
+	Summary  string         `  [ @"summary" ] ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type PcapCmd struct {
+	Cmd    struct{}       `"pcap"`     //nolint
+	Live   *PcapLiveCmd   `[ ( @@`     //nolint
+	Nodes  *PcapNodesCmd  `  | @@`     //nolint
+	Types  *PcapTypesCmd  `  | @@`     //nolint
+	Rotate *PcapRotateCmd `  | @@`     //nolint
+	On     *PcapOnCmd     `  | @@`     //nolint
+	Off    *OffFlag       `  | @@ ) ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type PcapNodesCmd struct {
+	Cmd   struct{}       `"nodes"`     //nolint
+	All   string         `( @"all"`    //nolint
+	Nodes []NodeSelector `| ( @@ )+ )` //nolint
+}
+
+// noinspection GoVetStructTag
+type PcapTypesCmd struct {
+	Cmd     struct{} `"types"`                   //nolint
+	All     string   `( @"all"`                  //nolint
+	Exclude []string `| "exclude" ( @Ident )+ )` //nolint
+}
+
+// noinspection GoVetStructTag
+type PcapRotateCmd struct {
+	Cmd    struct{} `"rotate"`            //nolint
+	Off    *OffFlag `( @@`                //nolint
+	SizeMb *int     `| @Int`              //nolint
+	Keep   *int     `  [ "keep" @Int ] )` //nolint
+}
+
+// noinspection GoVetStructTag
+type PcapOnCmd struct {
+	Cmd  struct{} `"on"`                                      //nolint
+	Time string   `[ @((Int|Float)["h"|"us"|"m"|"ms"|"s"]) ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type PcapLiveCmd struct {
+	Cmd      struct{} `"live"`               //nolint
+	Tcp      *string  `[ ( "tcp" @String`    //nolint
+	Pipe     *string  `  | "pipe" @String )` //nolint
+	Channels []int    `  [ "ch" ( @Int )+ ]` //nolint
+	Stop     *int     `| "stop" @Int ]`      //nolint
+}
+
 // noinspection GoVetStructTag
 type PlrCmd struct {
-	Cmd struct{} `"plr"`             //nolint
-	Val *float64 `[ (@Int|@Float) ]` //nolint
+	Cmd     struct{}              `"plr"`                 //nolint
+	Node    *NodeSelector         `[ ( "node" @@`         //nolint
+	Link    *LinkSelector         `  | "link" @@ ) ]`     //nolint
+	Default *DefaultFlag          `[ ( @@`                //nolint
+	Ge      *GilbertElliottParams `  | @@`                //nolint
+	Outage  *OutageParams         `  | @@`                //nolint
+	Val     *float64              `  | (@Int|@Float) ) ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type StatsCmd struct {
+	Cmd   struct{}      `"stats"`    //nolint
+	Node  *NodeSelector `[ ( @@`     //nolint
+	Links *LinksFlag    `  | @@`     //nolint
+	Reset *ResetFlag    `  | @@ ) ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type LinksFlag struct {
+	Dummy struct{} `"links"` //nolint
+}
+
+// noinspection GoVetStructTag
+type ResetFlag struct {
+	Dummy struct{} `"reset"` //nolint
+}
+
+// noinspection GoVetStructTag
+type LinkSelector struct {
+	Src NodeSelector `@@` //nolint
+	Dst NodeSelector `@@` //nolint
+}
+
+// noinspection GoVetStructTag
+type GilbertElliottParams struct {
+	Dummy    struct{} `"ge"`              //nolint
+	PGoodBad float64  `(@Int|@Float)`     //nolint
+	PBadGood float64  `(@Int|@Float)`     //nolint
+	LossGood *float64 `[ (@Int|@Float)`   //nolint
+	LossBad  *float64 `  (@Int|@Float) ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type OutageParams struct {
+	Dummy    struct{} `"outage"`      //nolint
+	Period   float64  `(@Int|@Float)` //nolint
+	Duration float64  `(@Int|@Float)` //nolint
 }
 
 // noinspection GoVetStructTag
@@ -468,6 +616,32 @@ type RadioModelCmd struct {
 	Model string   `[(@Ident|@Int)]` //nolint
 }
 
+// noinspection GoVetStructTag
+type RestartPolicyCmd struct {
+	Cmd        struct{}       `"restartpolicy"`           //nolint
+	Nodes      []NodeSelector `( @@ )+`                   //nolint
+	Never      *NeverFlag     `[ ( @@`                    //nolint
+	OnFailure  *OnFailureFlag `  | @@`                    //nolint
+	Always     *AlwaysFlag    `  | @@ )`                  //nolint
+	Backoff    *float64       `  [ (@Int|@Float)`         //nolint
+	MaxBackoff *float64       `    [ (@Int|@Float) ] ] ]` //nolint
+}
+
+// noinspection GoVetStructTag
+type NeverFlag struct {
+	Dummy struct{} `"never"` //nolint
+}
+
+// noinspection GoVetStructTag
+type OnFailureFlag struct {
+	Dummy struct{} `"on" "-" "failure"` //nolint
+}
+
+// noinspection GoVetStructTag
+type AlwaysFlag struct {
+	Dummy struct{} `"always"` //nolint
+}
+
 // noinspection GoVetStructTag
 type RadioParamCmd struct {
 	Cmd   struct{} `"radioparam"`      //nolint
@@ -513,9 +687,38 @@ type HelpCmd struct {
 
 // noinspection GoVetStructTag
 type FailTimeParams struct {
-	Dummy        struct{} `"ft"`          //nolint
-	FailDuration float64  `(@Int|@Float)` //nolint
-	FailInterval float64  `(@Int|@Float)` //nolint
+	Dummy        struct{}               `"ft"`              //nolint
+	Exp          *ExpFailTimeParams     `( @@`              //nolint
+	Weibull      *WeibullFailTimeParams `| @@`              //nolint
+	FailDuration float64                `| (@Int|@Float)`   //nolint
+	FailInterval float64                `  (@Int|@Float) )` //nolint
+	Reboot       *RebootParams          `[ @@ ]`            //nolint
+}
+
+// noinspection GoVetStructTag
+type ExpFailTimeParams struct {
+	Dummy struct{} `"exp"`         //nolint
+	Mtbf  float64  `(@Int|@Float)` //nolint
+	Mttr  float64  `(@Int|@Float)` //nolint
+}
+
+// noinspection GoVetStructTag
+type WeibullFailTimeParams struct {
+	Dummy struct{} `"weibull"`     //nolint
+	Shape float64  `(@Int|@Float)` //nolint
+	Mtbf  float64  `(@Int|@Float)` //nolint
+	Mttr  float64  `(@Int|@Float)` //nolint
+}
+
+// noinspection GoVetStructTag
+type RebootParams struct {
+	Dummy struct{}  `"reboot"` //nolint
+	Wipe  *WipeFlag `[ @@ ]`   //nolint
+}
+
+// noinspection GoVetStructTag
+type WipeFlag struct {
+	Dummy struct{} `"wipe"` //nolint
 }
 
 // noinspection GoVetStructTag
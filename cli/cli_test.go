@@ -59,6 +59,8 @@ func TestParseBytes(t *testing.T) {
 	assert.True(t, cmd.Add.RadioRange.Val == 1234)
 	assert.Nil(t, parseBytes([]byte("add router x 1 y 2 id 3 rr 1234"), &cmd))
 	assert.Nil(t, parseBytes([]byte("add router rr 1234 id 3 y 2 x 1"), &cmd))
+	assert.True(t, parseBytes([]byte("add router rcp"), &cmd) == nil && cmd.Add.Rcp != nil)
+	assert.True(t, parseBytes([]byte("add sed x 10 y 20 rcp"), &cmd) == nil && cmd.Add.Rcp != nil)
 
 	assert.Nil(t, parseBytes([]byte("autogo"), &cmd))
 	assert.NotNil(t, cmd.AutoGo)
@@ -76,6 +78,19 @@ func TestParseBytes(t *testing.T) {
 
 	assert.True(t, parseBytes([]byte("counters"), &cmd) == nil && cmd.Counters != nil)
 
+	assert.True(t, parseBytes([]byte("stats"), &cmd) == nil && cmd.Stats != nil && cmd.Stats.Node == nil &&
+		cmd.Stats.Links == nil && cmd.Stats.Reset == nil)
+	assert.True(t, parseBytes([]byte("stats 3"), &cmd) == nil && cmd.Stats != nil && cmd.Stats.Node.Id == 3)
+	assert.True(t, parseBytes([]byte("stats links"), &cmd) == nil && cmd.Stats != nil && cmd.Stats.Links != nil)
+	assert.True(t, parseBytes([]byte("stats reset"), &cmd) == nil && cmd.Stats != nil && cmd.Stats.Reset != nil)
+	assert.True(t, parseBytes([]byte("stats links 3"), &cmd) != nil)
+
+	assert.True(t, parseBytes([]byte("cut"), &cmd) == nil && cmd.Cut != nil && len(cmd.Cut.GroupA) == 0)
+	assert.True(t, parseBytes([]byte("cut 1 2 | 3"), &cmd) == nil && cmd.Cut != nil &&
+		len(cmd.Cut.GroupA) == 2 && len(cmd.Cut.GroupB) == 1)
+	assert.True(t, parseBytes([]byte("cut 1 2"), &cmd) != nil)
+	assert.True(t, parseBytes([]byte("cut 1 |"), &cmd) != nil)
+
 	assert.True(t, parseBytes([]byte("del 1"), &cmd) == nil && cmd.Del != nil)
 	assert.True(t, parseBytes([]byte("del 1 2"), &cmd) == nil && cmd.Del != nil)
 	assert.True(t, parseBytes([]byte("del 1 2 3"), &cmd) == nil && cmd.Del != nil)
@@ -86,7 +101,40 @@ func TestParseBytes(t *testing.T) {
 	assert.True(t, parseBytes([]byte("exe mtd \"MyExecutable_thingy\""), &cmd) == nil && cmd.Exe != nil)
 	assert.True(t, parseBytes([]byte("exe ftd \"./path/to/my/ot-cli-ftd\""), &cmd) == nil && cmd.Exe != nil)
 	assert.True(t, parseBytes([]byte("exe br \"./path/to/my/br-script.sh\""), &cmd) == nil && cmd.Exe != nil)
+	assert.True(t, parseBytes([]byte("exe rcp \"./ot-rcp\""), &cmd) == nil && cmd.Exe.RcpType.Val == "rcp")
+	assert.True(t, parseBytes([]byte("exe rcphost \"./ot-cli\""), &cmd) == nil && cmd.Exe.RcpType.Val == "rcphost")
 	assert.True(t, parseBytes([]byte("exe"), &cmd) == nil && cmd.Exe != nil)
+
+	assert.True(t, parseBytes([]byte("pcap live"), &cmd) == nil && cmd.Pcap.Live != nil)
+	assert.True(t, parseBytes([]byte("pcap live tcp \"127.0.0.1:9100\""), &cmd) == nil && *cmd.Pcap.Live.Tcp == "127.0.0.1:9100")
+	assert.True(t, parseBytes([]byte("pcap live pipe \"/tmp/otns.pipe\" ch 11 15"), &cmd) == nil &&
+		*cmd.Pcap.Live.Pipe == "/tmp/otns.pipe" && len(cmd.Pcap.Live.Channels) == 2)
+	assert.True(t, parseBytes([]byte("pcap live stop 2"), &cmd) == nil && *cmd.Pcap.Live.Stop == 2)
+	assert.NotNil(t, parseBytes([]byte("pcap live stop"), &cmd))
+	assert.True(t, parseBytes([]byte("pcap"), &cmd) == nil && cmd.Pcap != nil && cmd.Pcap.Live == nil)
+	assert.True(t, parseBytes([]byte("pcap nodes 1 2 3"), &cmd) == nil && len(cmd.Pcap.Nodes.Nodes) == 3)
+	assert.True(t, parseBytes([]byte("pcap nodes all"), &cmd) == nil && cmd.Pcap.Nodes.All == "all")
+	assert.True(t, parseBytes([]byte("pcap types exclude ack cmd"), &cmd) == nil &&
+		assert.ObjectsAreEqual([]string{"ack", "cmd"}, cmd.Pcap.Types.Exclude))
+	assert.True(t, parseBytes([]byte("pcap types all"), &cmd) == nil && cmd.Pcap.Types.All == "all")
+	assert.True(t, parseBytes([]byte("pcap rotate 100 keep 5"), &cmd) == nil &&
+		*cmd.Pcap.Rotate.SizeMb == 100 && *cmd.Pcap.Rotate.Keep == 5)
+	assert.True(t, parseBytes([]byte("pcap rotate off"), &cmd) == nil && cmd.Pcap.Rotate.Off != nil)
+	assert.True(t, parseBytes([]byte("pcap on"), &cmd) == nil && cmd.Pcap.On != nil && cmd.Pcap.On.Time == "")
+	assert.True(t, parseBytes([]byte("pcap on 10m"), &cmd) == nil && cmd.Pcap.On.Time == "10m")
+	assert.True(t, parseBytes([]byte("pcap off"), &cmd) == nil && cmd.Pcap.Off != nil)
+	assert.NotNil(t, parseBytes([]byte("pcap nodes"), &cmd))
+	assert.NotNil(t, parseBytes([]byte("pcap types exclude"), &cmd))
+
+	assert.True(t, parseBytes([]byte("frames"), &cmd) == nil && cmd.Frames != nil && cmd.Frames.Src == nil)
+	assert.True(t, parseBytes([]byte("frames src 1 dst 2 count 5"), &cmd) == nil &&
+		cmd.Frames.Src.Id == 1 && cmd.Frames.Dst.Id == 2 && *cmd.Frames.Count == 5)
+	assert.True(t, parseBytes([]byte("frames dst 2 src 1 json"), &cmd) == nil &&
+		cmd.Frames.Src.Id == 1 && cmd.Frames.Dst.Id == 2 && cmd.Frames.Json == "json")
+	assert.True(t, parseBytes([]byte("frames type mle ch 11 from 100 to 2000"), &cmd) == nil &&
+		*cmd.Frames.Type == "mle" && *cmd.Frames.Channel == 11 && *cmd.Frames.From == 100 && *cmd.Frames.To == 2000)
+	assert.True(t, parseBytes([]byte("frames clear"), &cmd) == nil && cmd.Frames.Clear == "clear")
+	assert.NotNil(t, parseBytes([]byte("frames src"), &cmd))
 	assert.True(t, parseBytes([]byte("exe default"), &cmd) == nil && cmd.Exe != nil)
 	assert.True(t, parseBytes([]byte("exe v12"), &cmd) == nil && cmd.Exe != nil)
 
@@ -109,6 +157,15 @@ func TestParseBytes(t *testing.T) {
 	assert.Nil(t, parseBytes([]byte("go 100 speed 2"), &cmd))
 	assert.NotNil(t, cmd.Go)
 
+	assert.True(t, parseBytes([]byte("heal"), &cmd) == nil && cmd.Heal != nil)
+
+	assert.True(t, parseBytes([]byte("heatmap"), &cmd) == nil && cmd.Heatmap != nil && len(cmd.Heatmap.Nodes) == 0)
+	assert.True(t, parseBytes([]byte("heatmap 1 3 cell 10 show"), &cmd) == nil && len(cmd.Heatmap.Nodes) == 2 &&
+		*cmd.Heatmap.CellSize == 10 && cmd.Heatmap.Show == "show")
+	assert.True(t, parseBytes([]byte("heatmap show summary"), &cmd) == nil && cmd.Heatmap.Summary == "summary")
+	assert.True(t, parseBytes([]byte("heatmap hide"), &cmd) == nil && cmd.Heatmap.Hide == "hide")
+	assert.NotNil(t, parseBytes([]byte("heatmap cell"), &cmd))
+
 	assert.True(t, parseBytes([]byte("joins"), &cmd) == nil && cmd.Joins != nil)
 
 	assert.True(t, parseBytes([]byte("log"), &cmd) == nil && cmd.LogLevel != nil)
@@ -145,12 +202,37 @@ func TestParseBytes(t *testing.T) {
 	assert.True(t, parseBytes([]byte("plr"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Val == nil)
 	assert.True(t, parseBytes([]byte("plr 1"), &cmd) == nil && cmd.Plr != nil && *cmd.Plr.Val == 1)
 	assert.True(t, parseBytes([]byte("plr 0.78910"), &cmd) == nil && cmd.Plr != nil && *cmd.Plr.Val == 0.78910)
+	assert.True(t, parseBytes([]byte("plr ge 0.01 0.3"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Ge != nil &&
+		cmd.Plr.Ge.PGoodBad == 0.01 && cmd.Plr.Ge.PBadGood == 0.3 && cmd.Plr.Ge.LossGood == nil)
+	assert.True(t, parseBytes([]byte("plr ge 0.01 0.3 0 0.9"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Ge != nil &&
+		*cmd.Plr.Ge.LossGood == 0 && *cmd.Plr.Ge.LossBad == 0.9)
+	assert.True(t, parseBytes([]byte("plr ge 0.01 0.3 0"), &cmd) != nil)
+	assert.True(t, parseBytes([]byte("plr outage 60 2.5"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Outage != nil &&
+		cmd.Plr.Outage.Period == 60 && cmd.Plr.Outage.Duration == 2.5)
+	assert.True(t, parseBytes([]byte("plr node 3"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Node.Id == 3 &&
+		cmd.Plr.Val == nil)
+	assert.True(t, parseBytes([]byte("plr node 3 0.2"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Node.Id == 3 &&
+		*cmd.Plr.Val == 0.2)
+	assert.True(t, parseBytes([]byte("plr node 3 default"), &cmd) == nil && cmd.Plr != nil && cmd.Plr.Default != nil)
+	assert.True(t, parseBytes([]byte("plr link 1 2 ge 0.1 0.5"), &cmd) == nil && cmd.Plr != nil &&
+		cmd.Plr.Link.Src.Id == 1 && cmd.Plr.Link.Dst.Id == 2 && cmd.Plr.Ge != nil)
+	assert.True(t, parseBytes([]byte("plr link 1"), &cmd) != nil)
 
 	assert.True(t, parseBytes([]byte("radio 1 on"), &cmd) == nil && cmd.Radio != nil)
 	assert.True(t, parseBytes([]byte("radio 1 off"), &cmd) == nil && cmd.Radio != nil)
 	assert.True(t, parseBytes([]byte("radio 1 2 3 on"), &cmd) == nil && cmd.Radio != nil)
 	assert.True(t, parseBytes([]byte("radio 4 5 6 off"), &cmd) == nil && cmd.Radio != nil)
 	assert.True(t, parseBytes([]byte("radio 4 5 6 ft 10 60"), &cmd) == nil && cmd.Radio != nil)
+	assert.True(t, parseBytes([]byte("radio 4 ft 10 60 reboot"), &cmd) == nil && cmd.Radio.FailTime.Reboot != nil && cmd.Radio.FailTime.Reboot.Wipe == nil)
+	assert.True(t, parseBytes([]byte("radio 4 ft exp 3600 30"), &cmd) == nil && cmd.Radio.FailTime.Exp != nil && cmd.Radio.FailTime.Exp.Mttr == 30)
+	assert.True(t, parseBytes([]byte("radio 4 ft weibull 1.5 3600 30.5 reboot wipe"), &cmd) == nil && cmd.Radio.FailTime.Weibull != nil && cmd.Radio.FailTime.Weibull.Shape == 1.5 && cmd.Radio.FailTime.Reboot.Wipe != nil)
+	assert.True(t, parseBytes([]byte("radio 4 ft weibull 1.5 3600"), &cmd) != nil)
+	assert.True(t, parseBytes([]byte("restartpolicy 1"), &cmd) == nil && cmd.RestartPolicy != nil && cmd.RestartPolicy.Never == nil && cmd.RestartPolicy.OnFailure == nil && cmd.RestartPolicy.Always == nil)
+	assert.True(t, parseBytes([]byte("restartpolicy 1 2 never"), &cmd) == nil && cmd.RestartPolicy != nil && len(cmd.RestartPolicy.Nodes) == 2 && cmd.RestartPolicy.Never != nil)
+	assert.True(t, parseBytes([]byte("restartpolicy 1 on-failure"), &cmd) == nil && cmd.RestartPolicy != nil && cmd.RestartPolicy.OnFailure != nil && cmd.RestartPolicy.Backoff == nil)
+	assert.True(t, parseBytes([]byte("restartpolicy 1 on-failure 2 120"), &cmd) == nil && cmd.RestartPolicy != nil && *cmd.RestartPolicy.Backoff == 2 && *cmd.RestartPolicy.MaxBackoff == 120)
+	assert.True(t, parseBytes([]byte("restartpolicy 3 always 0.5"), &cmd) == nil && cmd.RestartPolicy != nil && cmd.RestartPolicy.Always != nil && *cmd.RestartPolicy.Backoff == 0.5 && cmd.RestartPolicy.MaxBackoff == nil)
+	assert.True(t, parseBytes([]byte("restartpolicy always"), &cmd) != nil)
 	assert.True(t, parseBytes([]byte("radiomodel AnyName"), &cmd) == nil && cmd.RadioModel != nil && cmd.RadioModel.Model == "AnyName")
 	assert.True(t, parseBytes([]byte("radiomodel 42"), &cmd) == nil && cmd.RadioModel != nil && cmd.RadioModel.Model == "42")
 	assert.True(t, parseBytes([]byte("radiomodel"), &cmd) == nil && cmd.RadioModel != nil && cmd.RadioModel.Model == "")
@@ -50,36 +50,43 @@ var (
 )
 
 var commandHelp = map[string]string{
-	"help":       "Show help for a specific command.",
-	"add":        "Add a node to the simulation.",
-	"coaps":      "Enable collecting info about CoAP messages.",
-	"counters":   "Display runtime counters of the simulation.",
-	"cv":         "Configure visualization options.",
-	"del":        "Delete node(s) by node ID.",
-	"energy":     "Save node energy use information to a file.",
-	"exe":        "Display or set the OT executables used per node type.",
-	"exit":       "Exit OTNS (if not in node context) or exit node context.",
-	"go":         "Simulate for a specified time.",
-	"joins":      "Connect finished joiner sessions.",
-	"log":        "Inspect current log level or set a new log level.",
-	"move":       "Move a node to a target position.",
-	"netinfo":    "Set network info.",
-	"node":       "Switch CLI to a specific node context, or send a command to a specific node.",
-	"nodes":      "List all nodes.",
-	"partitions": "List all Thread Partitions.",
-	"pts":        "(synonym for: partitions)",
-	"ping":       "Ping from a given source node to a destination.",
-	"pings":      "Display finished 'ping' commands.",
-	"plr":        "Get or set the global packet loss ratio.",
-	"radio":      "Set a node's radio on/off or set fail-time parameters.",
-	"radiomodel": "Get or set the current RF simulation radio model.",
-	"scan":       "Let a node perform a network scan.",
-	"speed":      "Get or set the curent simulation speed.",
-	"time":       "Display current simulation time in us.",
-	"title":      "Set simulation window title.",
-	"watch":      "Enable additional detailed log messages for selected node(s).",
-	"unwatch":    "Disable the additional detailed log messages set by 'watch'.",
-	"web":        "Open a web browser for visualization.",
+	"help":          "Show help for a specific command.",
+	"add":           "Add a node to the simulation.",
+	"coaps":         "Enable collecting info about CoAP messages.",
+	"counters":      "Display runtime counters of the simulation.",
+	"cut":           "Block all radio frames between two groups of nodes, or list the active cuts.",
+	"cv":            "Configure visualization options.",
+	"del":           "Delete node(s) by node ID.",
+	"energy":        "Save node energy use information to a file.",
+	"exe":           "Display or set the OT executables used per node type.",
+	"exit":          "Exit OTNS (if not in node context) or exit node context.",
+	"frames":        "Show the recently dispatched frames with their delivery outcome, filtered by node, type, channel or time.",
+	"go":            "Simulate for a specified time.",
+	"heal":          "Remove all cuts made by 'cut', restoring normal radio reachability.",
+	"heatmap":       "Compute the RSSI/SINR coverage heatmap of node(s), and optionally show it in the web UI.",
+	"joins":         "Connect finished joiner sessions.",
+	"log":           "Inspect current log level or set a new log level.",
+	"move":          "Move a node to a target position.",
+	"netinfo":       "Set network info.",
+	"node":          "Switch CLI to a specific node context, or send a command to a specific node.",
+	"nodes":         "List all nodes.",
+	"partitions":    "List all Thread Partitions.",
+	"pts":           "(synonym for: partitions)",
+	"pcap":          "Filter, rotate and switch on/off the frame capture, or stream it live to Wireshark.",
+	"ping":          "Ping from a given source node to a destination.",
+	"pings":         "Display finished 'ping' commands.",
+	"plr":           "Get or set the packet loss ratio or loss model, globally or per node or link.",
+	"radio":         "Set a node's radio on/off or set fail-time parameters, optionally with process reboot.",
+	"radiomodel":    "Get or set the current RF simulation radio model.",
+	"restartpolicy": "Get or set the policy to restart a node after its process crashed.",
+	"scan":          "Let a node perform a network scan.",
+	"speed":         "Get or set the curent simulation speed.",
+	"stats":         "Display or reset per-node and per-link frame statistics with drop reasons.",
+	"time":          "Display current simulation time in us.",
+	"title":         "Set simulation window title.",
+	"watch":         "Enable additional detailed log messages for selected node(s).",
+	"unwatch":       "Disable the additional detailed log messages set by 'watch'.",
+	"web":           "Open a web browser for visualization.",
 }
 
 // Embed the CLI help file as a static resource.
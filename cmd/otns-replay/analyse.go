@@ -0,0 +1,299 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	. "github.com/openthread/ot-ns/types"
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+	"github.com/openthread/ot-ns/visualize/grpc/replay"
+)
+
+// timelineRecord is a record of the timeline of a replay: a change of a node, or the number of messages of a type sent
+// by a node during the message count interval ending at Timestamp.
+type timelineRecord struct {
+	Timestamp uint64 `json:"timestamp"`
+	Node      int32  `json:"node"`
+	Event     string `json:"event"`
+	Value     string `json:"value"`
+	Count     uint64 `json:"count,omitempty"`
+}
+
+type timelineWriter interface {
+	write(rec *timelineRecord) error
+	flush() error
+}
+
+type csvTimelineWriter struct {
+	w *csv.Writer
+}
+
+func newCsvTimelineWriter(w io.Writer) (*csvTimelineWriter, error) {
+	tw := &csvTimelineWriter{w: csv.NewWriter(w)}
+	return tw, tw.w.Write([]string{"timestamp", "node", "event", "value", "count"})
+}
+
+func (tw *csvTimelineWriter) write(rec *timelineRecord) error {
+	count := ""
+	if rec.Count > 0 {
+		count = strconv.FormatUint(rec.Count, 10)
+	}
+	return tw.w.Write([]string{
+		strconv.FormatUint(rec.Timestamp, 10),
+		strconv.Itoa(int(rec.Node)),
+		rec.Event,
+		rec.Value,
+		count,
+	})
+}
+
+func (tw *csvTimelineWriter) flush() error {
+	tw.w.Flush()
+	return tw.w.Error()
+}
+
+type jsonTimelineWriter struct {
+	enc *json.Encoder
+}
+
+func (tw *jsonTimelineWriter) write(rec *timelineRecord) error {
+	return tw.enc.Encode(rec)
+}
+
+func (tw *jsonTimelineWriter) flush() error {
+	return nil
+}
+
+// timelineAnalyser converts the entries of a replay into timeline records.
+type timelineAnalyser struct {
+	w         timelineWriter
+	interval  uint64                      // the message count interval (in us), or 0 to not count messages.
+	last      map[int32]map[string]string // the last value per node and event, to skip unchanged values.
+	counts    map[int32]map[string]uint64 // the message counts per node and message type in the current interval.
+	countsEnd uint64                      // the end of the current message count interval.
+}
+
+func newTimelineAnalyser(w timelineWriter, interval uint64) *timelineAnalyser {
+	return &timelineAnalyser{
+		w:         w,
+		interval:  interval,
+		last:      map[int32]map[string]string{},
+		counts:    map[int32]map[string]uint64{},
+		countsEnd: interval,
+	}
+}
+
+func (ta *timelineAnalyser) analyse(r *replay.Reader) error {
+	for {
+		entry, err := r.Peek()
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			break
+		}
+
+		if err = ta.onEntry(entry); err != nil {
+			return err
+		}
+		r.Advance()
+	}
+
+	if err := ta.writeCounts(); err != nil {
+		return err
+	}
+	return ta.w.flush()
+}
+
+func (ta *timelineAnalyser) onEntry(entry *pb.ReplayEntry) error {
+	if ta.interval > 0 && entry.Timestamp >= ta.countsEnd {
+		if err := ta.writeCounts(); err != nil {
+			return err
+		}
+		ta.countsEnd = (entry.Timestamp/ta.interval + 1) * ta.interval
+	}
+
+	if entry.Event == nil {
+		return nil
+	}
+
+	ts := entry.Timestamp
+	switch ev := entry.Event.Type.(type) {
+	case *pb.VisualizeEvent_AddNode:
+		delete(ta.last, ev.AddNode.NodeId)
+		return ta.write(ts, ev.AddNode.NodeId, "added", fmt.Sprintf("%d,%d", ev.AddNode.X, ev.AddNode.Y))
+	case *pb.VisualizeEvent_DeleteNode:
+		return ta.write(ts, ev.DeleteNode.NodeId, "deleted", "")
+	case *pb.VisualizeEvent_OnNodeFail:
+		return ta.write(ts, ev.OnNodeFail.NodeId, "failed", "")
+	case *pb.VisualizeEvent_OnNodeRecover:
+		return ta.write(ts, ev.OnNodeRecover.NodeId, "recovered", "")
+	case *pb.VisualizeEvent_SetNodeRole:
+		return ta.writeChange(ts, ev.SetNodeRole.NodeId, "role", OtDeviceRole(ev.SetNodeRole.Role).String())
+	case *pb.VisualizeEvent_SetNodePartitionId:
+		return ta.writeChange(ts, ev.SetNodePartitionId.NodeId, "partition", fmt.Sprintf("%08x", ev.SetNodePartitionId.PartitionId))
+	case *pb.VisualizeEvent_SetParent:
+		return ta.writeChange(ts, ev.SetParent.NodeId, "parent", fmt.Sprintf("%016x", ev.SetParent.ExtAddr))
+	case *pb.VisualizeEvent_OnExtAddrChange:
+		return ta.writeChange(ts, ev.OnExtAddrChange.NodeId, "ext_addr", fmt.Sprintf("%016x", ev.OnExtAddrChange.ExtAddr))
+	case *pb.VisualizeEvent_AddRouterTable:
+		return ta.write(ts, ev.AddRouterTable.NodeId, "router_added", fmt.Sprintf("%016x", ev.AddRouterTable.ExtAddr))
+	case *pb.VisualizeEvent_RemoveRouterTable:
+		return ta.write(ts, ev.RemoveRouterTable.NodeId, "router_removed", fmt.Sprintf("%016x", ev.RemoveRouterTable.ExtAddr))
+	case *pb.VisualizeEvent_AddChildTable:
+		return ta.write(ts, ev.AddChildTable.NodeId, "child_added", fmt.Sprintf("%016x", ev.AddChildTable.ExtAddr))
+	case *pb.VisualizeEvent_RemoveChildTable:
+		return ta.write(ts, ev.RemoveChildTable.NodeId, "child_removed", fmt.Sprintf("%016x", ev.RemoveChildTable.ExtAddr))
+	case *pb.VisualizeEvent_Send:
+		if ta.interval > 0 {
+			ta.countMessage(ev.Send)
+		}
+	}
+	return nil
+}
+
+func (ta *timelineAnalyser) countMessage(send *pb.SendEvent) {
+	msgType := "unknown"
+	if send.MvInfo != nil && send.MvInfo.MsgType != "" {
+		msgType = send.MvInfo.MsgType
+	}
+	if ta.counts[send.SrcId] == nil {
+		ta.counts[send.SrcId] = map[string]uint64{}
+	}
+	ta.counts[send.SrcId][msgType] += 1
+}
+
+func (ta *timelineAnalyser) writeCounts() error {
+	nodeids := make([]int32, 0, len(ta.counts))
+	for nodeid := range ta.counts {
+		nodeids = append(nodeids, nodeid)
+	}
+	sort.Slice(nodeids, func(i, j int) bool {
+		return nodeids[i] < nodeids[j]
+	})
+
+	for _, nodeid := range nodeids {
+		msgTypes := make([]string, 0, len(ta.counts[nodeid]))
+		for msgType := range ta.counts[nodeid] {
+			msgTypes = append(msgTypes, msgType)
+		}
+		sort.Strings(msgTypes)
+
+		for _, msgType := range msgTypes {
+			if err := ta.w.write(&timelineRecord{
+				Timestamp: ta.countsEnd,
+				Node:      nodeid,
+				Event:     "messages",
+				Value:     msgType,
+				Count:     ta.counts[nodeid][msgType],
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	ta.counts = map[int32]map[string]uint64{}
+	return nil
+}
+
+// writeChange writes a record if the value of the event of the node changed.
+func (ta *timelineAnalyser) writeChange(ts uint64, nodeid int32, event string, value string) error {
+	if ta.last[nodeid] == nil {
+		ta.last[nodeid] = map[string]string{}
+	}
+	if last, ok := ta.last[nodeid][event]; ok && last == value {
+		return nil
+	}
+	ta.last[nodeid][event] = value
+	return ta.write(ts, nodeid, event, value)
+}
+
+func (ta *timelineAnalyser) write(ts uint64, nodeid int32, event string, value string) error {
+	return ta.w.write(&timelineRecord{
+		Timestamp: ts,
+		Node:      nodeid,
+		Event:     event,
+		Value:     value,
+	})
+}
+
+// runAnalyse runs the "analyse" subcommand, which writes the timeline of a replay as CSV or JSON lines.
+func runAnalyse(arguments []string) error {
+	fs := flag.NewFlagSet("analyse", flag.ExitOnError)
+	format := fs.String("format", "csv", "output format: \"csv\" or \"json\" (JSON lines)")
+	output := fs.String("o", "", "output file (default: stdout)")
+	interval := fs.Duration("interval", time.Second, "simulation time interval of the message counts, or 0 to not count messages")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s analyse [flags] <replay>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(arguments)
+
+	if fs.NArg() != 1 || *interval < 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	r, err := replay.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out := os.Stdout
+	if *output != "" {
+		if out, err = os.Create(*output); err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	var w timelineWriter
+	switch *format {
+	case "csv":
+		if w, err = newCsvTimelineWriter(out); err != nil {
+			return err
+		}
+	case "json":
+		w = &jsonTimelineWriter{enc: json.NewEncoder(out)}
+	default:
+		return errors.Errorf("invalid format: %s", *format)
+	}
+
+	return newTimelineAnalyser(w, uint64(*interval/time.Microsecond)).analyse(r)
+}
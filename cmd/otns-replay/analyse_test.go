@@ -0,0 +1,101 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+	"github.com/openthread/ot-ns/visualize/grpc/replay"
+)
+
+func TestAnalyseReplay(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.replay")
+	rep := replay.NewReplay(filename)
+	at := func(ts uint64, event *pb.VisualizeEvent) {
+		rep.SetCurTime(ts)
+		rep.Append(event)
+	}
+	send := func(src int32, msgType string) *pb.VisualizeEvent {
+		return &pb.VisualizeEvent{Type: &pb.VisualizeEvent_Send{Send: &pb.SendEvent{
+			SrcId:  src,
+			DstId:  -1,
+			MvInfo: &pb.MsgVisualizeInfo{MsgType: msgType},
+		}}}
+	}
+	setRole := func(nodeid int32, role pb.OtDeviceRole) *pb.VisualizeEvent {
+		return &pb.VisualizeEvent{Type: &pb.VisualizeEvent_SetNodeRole{SetNodeRole: &pb.SetNodeRoleEvent{NodeId: nodeid, Role: role}}}
+	}
+
+	at(0, &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddNode{AddNode: &pb.AddNodeEvent{NodeId: 1, X: 100, Y: 200}}})
+	at(0, setRole(1, pb.OtDeviceRole_OT_DEVICE_ROLE_DETACHED))
+	at(200000, send(1, "MLE"))
+	at(300000, send(1, "MLE"))
+	at(400000, send(1, ""))
+	at(1500000, setRole(1, pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER))
+	at(1500000, setRole(1, pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER))
+	at(1500000, &pb.VisualizeEvent{Type: &pb.VisualizeEvent_SetNodePartitionId{SetNodePartitionId: &pb.SetNodePartitionIdEvent{NodeId: 1, PartitionId: 0x1234}}})
+	at(1600000, send(1, "MLE"))
+	at(3200000, &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddChildTable{AddChildTable: &pb.AddChildTableEvent{NodeId: 1, ExtAddr: 0xabcd}}})
+	assert.Nil(t, rep.Close())
+
+	r, err := replay.Open(filename)
+	assert.Nil(t, err)
+	defer r.Close()
+
+	var out bytes.Buffer
+	w, err := newCsvTimelineWriter(&out)
+	assert.Nil(t, err)
+	assert.Nil(t, newTimelineAnalyser(w, 1000000).analyse(r))
+	assert.Equal(t, `timestamp,node,event,value,count
+0,1,added,"100,200",
+0,1,role,detached,
+1000000,1,messages,MLE,2
+1000000,1,messages,unknown,1
+1500000,1,role,leader,
+1500000,1,partition,00001234,
+2000000,1,messages,MLE,1
+3200000,1,child_added,000000000000abcd,
+`, out.String())
+
+	out.Reset()
+	r, err = replay.Open(filename)
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Nil(t, newTimelineAnalyser(&jsonTimelineWriter{enc: json.NewEncoder(&out)}, 0).analyse(r))
+	assert.Equal(t, `{"timestamp":0,"node":1,"event":"added","value":"100,200"}
+{"timestamp":0,"node":1,"event":"role","value":"detached"}
+{"timestamp":1500000,"node":1,"event":"role","value":"leader"}
+{"timestamp":1500000,"node":1,"event":"partition","value":"00001234"}
+{"timestamp":3200000,"node":1,"event":"child_added","value":"000000000000abcd"}
+`, out.String())
+}
@@ -27,24 +27,22 @@
 package main
 
 import (
-	"bufio"
 	"context"
-	"os"
+	"math"
 	"time"
 
 	"github.com/openthread/ot-ns/logger"
 	"github.com/pkg/errors"
-	"google.golang.org/protobuf/encoding/prototext"
 
 	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+	"github.com/openthread/ot-ns/visualize/grpc/replay"
 )
 
-var (
-	unmarshalOptions = prototext.UnmarshalOptions{}
-)
+var errNotAvailableOnReplay = errors.Errorf("not available on replay")
 
 type grpcService struct {
 	replayFile string
+	player     *replayPlayer
 }
 
 func (gs *grpcService) Visualize(req *pb.VisualizeRequest, stream pb.VisualizeGrpcService_VisualizeServer) error {
@@ -75,15 +73,114 @@ waitloop:
 	return nil
 }
 
+// EnergyReport streams the energy report entries of the replay, aligned with the playback. As the energy charts can
+// not be cleared, seeking backwards does not re-send entries that were already sent, and seeking forward sends all
+// the entries up to the seek target at once.
 func (gs *grpcService) EnergyReport(req *pb.VisualizeRequest, stream pb.VisualizeGrpcService_EnergyReportServer) error {
-	//TODO: implement energy report for replay, if it fits.
-	var err error
-	return err
+	defer logger.Infof("EnergyReport finished.")
+
+	gs.player.start()
+
+	updateViewEvent := &pb.NetworkEnergyEvent{
+		Timestamp:   math.MaxUint64,
+		NodesEnergy: make([]*pb.NodeEnergy, 0),
+	}
+
+	reader, err := replay.Open(gs.replayFile)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		ts, _, changed := gs.player.position()
+
+		updateView := false
+		for {
+			entry, err := reader.Peek()
+			if err != nil {
+				return err
+			}
+			if entry == nil || entry.Timestamp > ts {
+				break
+			}
+			if entry.Energy != nil {
+				if err := stream.Send(entry.Energy); err != nil {
+					return err
+				}
+				updateView = true
+			}
+			reader.Advance()
+		}
+
+		if updateView {
+			if err := stream.Send(updateViewEvent); err != nil {
+				return err
+			}
+		}
+
+		next, err := reader.Peek()
+		if err != nil {
+			return err
+		}
+		if !gs.waitNextEntry(stream.Context(), next, changed) {
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (gs *grpcService) Command(ctx context.Context, req *pb.CommandRequest) (*pb.CommandResponse, error) {
+	return &pb.CommandResponse{
+		Output: gs.player.runCommand(req.Command),
+	}, nil
+}
+
+func (gs *grpcService) FrameStats(context.Context, *pb.FrameStatsRequest) (*pb.FrameStatsResponse, error) {
+	return nil, errors.Errorf("frame statistics are not available on replay")
+}
+
+func (gs *grpcService) AddNode(context.Context, *pb.AddNodeRequest) (*pb.AddNodeResponse, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) DeleteNode(context.Context, *pb.DeleteNodeRequest) (*pb.Empty, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) MoveNode(context.Context, *pb.MoveNodeRequest) (*pb.Empty, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) SetRadio(context.Context, *pb.SetRadioRequest) (*pb.Empty, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) Go(context.Context, *pb.GoRequest) (*pb.GoResponse, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) GetNodes(context.Context, *pb.Empty) (*pb.GetNodesResponse, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) GetPartitions(context.Context, *pb.Empty) (*pb.GetPartitionsResponse, error) {
+	return nil, errNotAvailableOnReplay
 }
 
-func (gs *grpcService) Command(context.Context, *pb.CommandRequest) (*pb.CommandResponse, error) {
-	// TODO: implement some commands for replay (e.g. speed)
-	return nil, errors.Errorf("can not run command on replay")
+func (gs *grpcService) GetPings(context.Context, *pb.Empty) (*pb.GetPingsResponse, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) NodeCommand(context.Context, *pb.NodeCommandRequest) (*pb.NodeCommandResponse, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) RssiHeatmap(context.Context, *pb.RssiHeatmapRequest) (*pb.RssiHeatmapResponse, error) {
+	return nil, errNotAvailableOnReplay
+}
+
+func (gs *grpcService) NodeLogs(*pb.NodeLogsRequest, pb.VisualizeGrpcService_NodeLogsServer) error {
+	return errNotAvailableOnReplay
 }
 
 func (gs *grpcService) visualizeStream(stream pb.VisualizeGrpcService_VisualizeServer, visualizeDone chan struct{}) {
@@ -96,27 +193,71 @@ func (gs *grpcService) visualizeStream(stream pb.VisualizeGrpcService_VisualizeS
 		}
 	}()
 
-	replay, err := os.Open(gs.replayFile)
+	gs.player.start()
+
+	reader, err := replay.Open(gs.replayFile)
 	logger.PanicIfError(err)
+	defer reader.Close()
 
-	scanner := bufio.NewScanner(bufio.NewReader(replay))
-	scanner.Split(bufio.ScanLines)
+	send := func(event *pb.VisualizeEvent) {
+		err := stream.Send(event)
+		logger.PanicIfError(err)
+	}
 
-	startTime := time.Now()
+	seekGen := -1 // a new stream starts with a seek to the current replay time.
+	for {
+		ts, gen, changed := gs.player.position()
 
-	for scanner.Scan() {
-		line := scanner.Text()
+		if gen != seekGen {
+			seekGen = gen
+			clearEvents := reader.State().ClearEvents()
+			logger.PanicIfError(reader.Seek(ts))
 
-		logger.Infof("visualize: %#v", line)
+			logger.Infof("visualize: seek to %d us", ts)
+			for _, event := range clearEvents {
+				send(event)
+			}
+			for _, event := range reader.State().Snapshot() {
+				send(event)
+			}
+			continue
+		}
 
-		var entry pb.ReplayEntry
-		err = unmarshalOptions.Unmarshal([]byte(line), &entry)
+		entry, err := reader.Peek()
 		logger.PanicIfError(err)
 
-		playTime := startTime.Add(time.Duration(entry.Timestamp) * time.Microsecond)
-		time.Sleep(time.Until(playTime))
+		if entry != nil && entry.Timestamp <= ts {
+			if entry.Event != nil {
+				send(entry.Event)
+			}
+			reader.Advance()
+			continue
+		}
 
-		err = stream.Send(entry.Event)
-		logger.PanicIfError(err)
+		if !gs.waitNextEntry(stream.Context(), entry, changed) {
+			return
+		}
+	}
+}
+
+// waitNextEntry waits until the next entry is due or the playback changes. At the end of the replay (next is nil), it
+// waits for a change of the playback. It returns false if ctx is done.
+func (gs *grpcService) waitNextEntry(ctx context.Context, next *pb.ReplayEntry, changed <-chan struct{}) bool {
+	var timeout <-chan time.Time
+	if next != nil {
+		if d, ok := gs.player.waitTime(next.Timestamp); ok {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+	}
+
+	select {
+	case <-timeout:
+		return true
+	case <-changed:
+		return true
+	case <-ctx.Done():
+		return false
 	}
 }
@@ -29,6 +29,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
@@ -36,6 +37,7 @@ import (
 	"github.com/openthread/ot-ns/logger"
 	"github.com/openthread/ot-ns/progctx"
 	"github.com/openthread/ot-ns/visualize/grpc/pb"
+	"github.com/openthread/ot-ns/visualize/grpc/replay"
 	"github.com/openthread/ot-ns/web"
 	webSite "github.com/openthread/ot-ns/web/site"
 	"google.golang.org/grpc"
@@ -43,9 +45,18 @@ import (
 
 var args struct {
 	ReplayFile string
+	Speed      float64
+	Convert    string
 }
 
 func parseArgs() {
+	speed := flag.String("speed", "1", "replay speed, as a multiple of the simulation time, or \"max\"")
+	flag.StringVar(&args.Convert, "convert", "", "convert the replay into the binary format, written to the given file, and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <replay>\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s analyse [flags] <replay>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
 	if len(flag.Args()) != 1 {
@@ -53,18 +64,43 @@ func parseArgs() {
 		os.Exit(1)
 	}
 
+	var err error
+	if args.Speed, err = parseReplaySpeed(*speed); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
 	args.ReplayFile = flag.Arg(0)
 }
 
 func main() {
+	if len(os.Args) > 1 && (os.Args[1] == "analyse" || os.Args[1] == "analyze") {
+		if err := runAnalyse(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	parseArgs()
 	checkReplayFile(args.ReplayFile)
 	logger.SetLevel(logger.InfoLevel)
 
+	if args.Convert != "" {
+		err := replay.Convert(args.ReplayFile, args.Convert)
+		logger.PanicIfError(err)
+		logger.Infof("converted %s to %s", args.ReplayFile, args.Convert)
+		return
+	}
+
 	ctx := progctx.New(context.Background())
 
 	server := grpc.NewServer(grpc.ReadBufferSize(1024*8), grpc.WriteBufferSize(1024*1024*1))
-	gs := &grpcService{replayFile: args.ReplayFile}
+	gs := &grpcService{
+		replayFile: args.ReplayFile,
+		player:     newReplayPlayer(args.Speed),
+	}
 	pb.RegisterVisualizeGrpcServiceServer(server, gs)
 
 	lis, err := net.Listen("tcp", ":8999")
@@ -0,0 +1,222 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxReplaySpeed is the replay speed used for "speed max".
+	maxReplaySpeed = 1000000
+)
+
+// replayPlayer is the playback clock of a replay, shared by all visualization streams. It maps the wall clock to the
+// replay time, which is the simulation time (in us) of the replayed events, taking pauses, speed changes and seeks
+// into account.
+type replayPlayer struct {
+	sync.Mutex
+	started bool
+	paused  bool
+	speed   float64
+	pos     uint64        // replay time at anchor.
+	anchor  time.Time     // wall clock time at which the replay time was pos.
+	seekGen int           // incremented on every seek, so that streams can detect seeks.
+	changed chan struct{} // closed (and replaced) on every change of the playback.
+}
+
+func newReplayPlayer(speed float64) *replayPlayer {
+	return &replayPlayer{
+		speed:   speed,
+		changed: make(chan struct{}),
+	}
+}
+
+// start starts the playback from the beginning, unless it was already started.
+func (p *replayPlayer) start() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.started {
+		p.started = true
+		p.anchor = time.Now()
+	}
+}
+
+// position returns the current replay time, the seek generation and a channel that is closed on the next change of
+// the playback.
+func (p *replayPlayer) position() (uint64, int, <-chan struct{}) {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.curTime(), p.seekGen, p.changed
+}
+
+// waitTime returns the wall clock duration until the replay time ts is reached, or false if the playback is paused.
+func (p *replayPlayer) waitTime(ts uint64) (time.Duration, bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.paused || !p.started {
+		return 0, false
+	}
+	cur := p.curTime()
+	if ts <= cur {
+		return 0, true
+	}
+	return time.Duration(float64(ts-cur)/p.speed) * time.Microsecond, true
+}
+
+func (p *replayPlayer) pause() {
+	p.update(func() {
+		p.paused = true
+	})
+}
+
+func (p *replayPlayer) resume() {
+	p.update(func() {
+		p.paused = false
+	})
+}
+
+func (p *replayPlayer) setSpeed(speed float64) {
+	p.update(func() {
+		p.speed = speed
+	})
+}
+
+// seek moves the playback to the replay time ts.
+func (p *replayPlayer) seek(ts uint64) {
+	p.update(func() {
+		p.pos = ts
+		p.seekGen += 1
+	})
+}
+
+func (p *replayPlayer) update(f func()) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.pos = p.curTime()
+	p.anchor = time.Now()
+	f()
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+func (p *replayPlayer) curTime() uint64 {
+	if p.paused || !p.started {
+		return p.pos
+	}
+	return p.pos + uint64(float64(time.Since(p.anchor)/time.Microsecond)*p.speed)
+}
+
+// runCommand runs a playback control command and returns its output in the format of the OTNS CLI.
+func (p *replayPlayer) runCommand(cmd string) []string {
+	output, err := p.command(strings.Fields(cmd))
+	if err != nil {
+		return append(output, fmt.Sprintf("Error: %v", err))
+	}
+	return append(output, "Done")
+}
+
+func (p *replayPlayer) command(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errors.Errorf("empty command")
+	}
+
+	switch {
+	case args[0] == "pause" && len(args) == 1:
+		p.pause()
+	case args[0] == "resume" && len(args) == 1:
+		p.resume()
+	case args[0] == "speed" && len(args) == 1:
+		p.Lock()
+		defer p.Unlock()
+		return []string{strconv.FormatFloat(p.speed, 'f', -1, 64)}, nil
+	case args[0] == "speed" && len(args) == 2:
+		speed, err := parseReplaySpeed(args[1])
+		if err != nil {
+			return nil, err
+		}
+		p.setSpeed(speed)
+	case args[0] == "seek" && len(args) == 2:
+		ts, err := p.parseSeekTime(args[1])
+		if err != nil {
+			return nil, err
+		}
+		p.seek(ts)
+	case args[0] == "time" && len(args) == 1:
+		ts, _, _ := p.position()
+		return []string{strconv.FormatUint(ts, 10)}, nil
+	default:
+		return nil, errors.Errorf("unknown replay command: %s", strings.Join(args, " "))
+	}
+	return nil, nil
+}
+
+func parseReplaySpeed(s string) (float64, error) {
+	if s == "max" {
+		return maxReplaySpeed, nil
+	}
+	speed, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(speed) || speed <= 0 {
+		return 0, errors.Errorf("invalid speed: %s", s)
+	}
+	if speed > maxReplaySpeed {
+		speed = maxReplaySpeed
+	}
+	return speed, nil
+}
+
+// parseSeekTime parses a seek target: a simulation time (e.g. "90s" or "1h5m"), or a duration relative to the current
+// replay time if prefixed by '+' or '-'.
+func (p *replayPlayer) parseSeekTime(s string) (uint64, error) {
+	relative := strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-")
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid seek time: %s", s)
+	}
+
+	var ts int64
+	if relative {
+		cur, _, _ := p.position()
+		ts = int64(cur)
+	}
+	ts += int64(d / time.Microsecond)
+	if ts < 0 {
+		ts = 0
+	}
+	return uint64(ts), nil
+}
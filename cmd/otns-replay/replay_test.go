@@ -0,0 +1,181 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+func TestReplayPlayer(t *testing.T) {
+	p := newReplayPlayer(1)
+
+	// the replay time does not advance before the playback is started.
+	ts, gen, _ := p.position()
+	assert.Equal(t, uint64(0), ts)
+	assert.Equal(t, 0, gen)
+	_, ok := p.waitTime(1000)
+	assert.False(t, ok)
+
+	p.start()
+	assert.Equal(t, []string{"Done"}, p.runCommand("pause"))
+	_, ok = p.waitTime(1000)
+	assert.False(t, ok)
+
+	_, _, changed := p.position()
+	assert.Equal(t, []string{"Done"}, p.runCommand("seek 1m30s"))
+	ts, gen, _ = p.position()
+	assert.Equal(t, uint64(90000000), ts)
+	assert.Equal(t, 1, gen)
+	assert.Equal(t, []string{"90000000", "Done"}, p.runCommand("time"))
+	select {
+	case <-changed:
+	default:
+		t.Fatal("seek did not signal a change of the playback")
+	}
+
+	assert.Equal(t, []string{"Done"}, p.runCommand("seek -100s"))
+	ts, _, _ = p.position()
+	assert.Equal(t, uint64(0), ts)
+	assert.Equal(t, []string{"Done"}, p.runCommand("seek +2s"))
+	ts, _, _ = p.position()
+	assert.Equal(t, uint64(2000000), ts)
+
+	assert.Equal(t, []string{"1", "Done"}, p.runCommand("speed"))
+	assert.Equal(t, []string{"Done"}, p.runCommand("speed 4"))
+	assert.Equal(t, []string{"4", "Done"}, p.runCommand("speed"))
+	assert.Equal(t, []string{"Done"}, p.runCommand("speed max"))
+	assert.Equal(t, []string{"1000000", "Done"}, p.runCommand("speed"))
+	assert.Equal(t, []string{"Done"}, p.runCommand("speed 0.5"))
+
+	assert.Equal(t, []string{"Done"}, p.runCommand("resume"))
+	d, ok := p.waitTime(3000000)
+	assert.True(t, ok)
+	assert.True(t, d <= 2*time.Second && d > time.Second)
+	d, ok = p.waitTime(1000)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	assert.Equal(t, []string{"Error: invalid speed: 0"}, p.runCommand("speed 0"))
+	assert.Equal(t, []string{"Error: invalid speed: NaN"}, p.runCommand("speed NaN"))
+	assert.Equal(t, []string{"Error: invalid seek time: 10"}, p.runCommand("seek 10"))
+	assert.Equal(t, []string{"Error: unknown replay command: add router"}, p.runCommand("add router"))
+}
+
+type testEnergyReportServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.NetworkEnergyEvent
+}
+
+func (s *testEnergyReportServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testEnergyReportServer) Send(event *pb.NetworkEnergyEvent) error {
+	s.events <- event
+	return nil
+}
+
+func writeTestReplay(t *testing.T, entries []*pb.ReplayEntry) string {
+	filename := filepath.Join(t.TempDir(), "test.replay")
+	f, err := os.Create(filename)
+	assert.Nil(t, err)
+	defer f.Close()
+
+	for _, entry := range entries {
+		data, err := prototext.Marshal(entry)
+		assert.Nil(t, err)
+		_, err = f.Write(append(data, '\n'))
+		assert.Nil(t, err)
+	}
+	return filename
+}
+
+func TestReplayEnergyReport(t *testing.T) {
+	energy := func(ts uint64) *pb.NetworkEnergyEvent {
+		return &pb.NetworkEnergyEvent{Timestamp: ts, NodesEnergy: []*pb.NodeEnergy{{NodeId: 1, Tx: float64(ts)}}}
+	}
+	gs := &grpcService{
+		replayFile: writeTestReplay(t, []*pb.ReplayEntry{
+			{Timestamp: 0, Event: &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddNode{AddNode: &pb.AddNodeEvent{NodeId: 1}}}},
+			{Timestamp: 1000, Energy: energy(1)},
+			{Timestamp: 2000, Event: &pb.VisualizeEvent{Type: &pb.VisualizeEvent_SetNodeRloc16{SetNodeRloc16: &pb.SetNodeRloc16Event{NodeId: 1}}}},
+			{Timestamp: 3000, Energy: energy(3)},
+			{Timestamp: 4000, Energy: energy(4)},
+		}),
+		player: newReplayPlayer(1),
+	}
+	gs.player.pause()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testEnergyReportServer{ctx: ctx, events: make(chan *pb.NetworkEnergyEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- gs.EnergyReport(&pb.VisualizeRequest{}, stream)
+	}()
+
+	expect := func(events ...*pb.NetworkEnergyEvent) {
+		for _, event := range events {
+			select {
+			case e := <-stream.events:
+				assert.True(t, proto.Equal(event, e), "expected %v, got %v", event, e)
+			case <-time.After(time.Second):
+				t.Fatalf("energy event %v not sent", event)
+			}
+		}
+		select {
+		case e := <-stream.events:
+			t.Fatalf("unexpected energy event %v", e)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	updateView := &pb.NetworkEnergyEvent{Timestamp: math.MaxUint64, NodesEnergy: []*pb.NodeEnergy{}}
+
+	gs.player.seek(2500)
+	expect(energy(1), updateView)
+	gs.player.seek(500)
+	expect()
+	gs.player.seek(3500)
+	expect(energy(3), updateView)
+	gs.player.seek(10000)
+	expect(energy(4), updateView)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
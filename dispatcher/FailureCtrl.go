@@ -27,6 +27,8 @@
 package dispatcher
 
 import (
+	"fmt"
+	"math"
 	"math/rand"
 
 	"github.com/openthread/ot-ns/logger"
@@ -41,19 +43,133 @@ var (
 	NonFailTime = FailTime{0, 0}
 )
 
+// FailureKind determines what a scheduled failure does to a node.
+type FailureKind int
+
+const (
+	// FailureKindRadio turns the node's radio off during the failure.
+	FailureKindRadio FailureKind = iota
+	// FailureKindReboot stops the node's OT process during the failure, as if powered off, and restarts it
+	// when the failure ends. The node's flash is kept.
+	FailureKindReboot
+	// FailureKindRebootWipe is like FailureKindReboot, but wipes the node's flash on restart.
+	FailureKindRebootWipe
+)
+
+func (k FailureKind) String() string {
+	switch k {
+	case FailureKindRadio:
+		return "radio"
+	case FailureKindReboot:
+		return "reboot"
+	case FailureKindRebootWipe:
+		return "reboot wipe"
+	default:
+		return fmt.Sprintf("FailureKind(%d)", int(k))
+	}
+}
+
+// FailureModel provides the up and down times of a node that fails repeatedly.
+type FailureModel interface {
+	// NextUpTime returns the time (us) until the next failure starts, counted from the end of the previous one.
+	NextUpTime() uint64
+
+	// NextDownTime returns the duration (us) of the next failure.
+	NextDownTime() uint64
+}
+
+// intervalFailureModel fails a node for a fixed duration, once in every interval, at a random moment.
+type intervalFailureModel struct {
+	failTime FailTime
+	remainTm uint64 // unit: us; time that remains in this fail cycle after failure has ended.
+}
+
+// NewIntervalFailureModel creates a FailureModel that fails for ft.FailDuration once in every ft.FailInterval.
+func NewIntervalFailureModel(ft FailTime) (FailureModel, error) {
+	if ft.FailDuration == 0 || ft.FailInterval <= ft.FailDuration {
+		return nil, fmt.Errorf("fail-duration must be > 0 and < fail-interval")
+	}
+	return &intervalFailureModel{failTime: ft}, nil
+}
+
+func (m *intervalFailureModel) NextUpTime() uint64 {
+	failStartTimeMax := int(m.failTime.FailInterval - m.failTime.FailDuration)
+	failTsRel := uint64(rand.Intn(failStartTimeMax))
+	upTime := failTsRel + m.remainTm
+	m.remainTm = m.failTime.FailInterval - m.failTime.FailDuration - failTsRel
+	logger.AssertTrue(m.remainTm < m.failTime.FailInterval)
+	return upTime
+}
+
+func (m *intervalFailureModel) NextDownTime() uint64 {
+	return m.failTime.FailDuration
+}
+
+// weibullFailureModel draws up and down times from Weibull distributions with a common shape parameter.
+// A shape of 1 gives exponentially distributed up and down times.
+type weibullFailureModel struct {
+	scaleUp   float64 // unit: us
+	scaleDown float64 // unit: us
+	invShape  float64
+}
+
+// NewExponentialFailureModel creates a FailureModel with exponentially distributed up and down times, with
+// mean time between failures mtbf and mean time to recover mttr (both in us).
+func NewExponentialFailureModel(mtbf uint64, mttr uint64) (FailureModel, error) {
+	return NewWeibullFailureModel(1.0, mtbf, mttr)
+}
+
+// NewWeibullFailureModel creates a FailureModel with Weibull distributed up and down times, with the given shape
+// parameter, mean time between failures mtbf and mean time to recover mttr (both in us).
+func NewWeibullFailureModel(shape float64, mtbf uint64, mttr uint64) (FailureModel, error) {
+	if shape <= 0 {
+		return nil, fmt.Errorf("shape must be > 0")
+	}
+	if mtbf == 0 || mttr == 0 {
+		return nil, fmt.Errorf("mtbf and mttr must be > 0")
+	}
+	// the mean of a Weibull distribution is scale * Gamma(1 + 1/shape).
+	g := math.Gamma(1.0 + 1.0/shape)
+	return &weibullFailureModel{
+		scaleUp:   float64(mtbf) / g,
+		scaleDown: float64(mttr) / g,
+		invShape:  1.0 / shape,
+	}, nil
+}
+
+func (m *weibullFailureModel) NextUpTime() uint64 {
+	return m.sample(m.scaleUp)
+}
+
+func (m *weibullFailureModel) NextDownTime() uint64 {
+	return m.sample(m.scaleDown)
+}
+
+func (m *weibullFailureModel) sample(scale float64) uint64 {
+	// inverse transform sampling; rand.Float64() is in [0,1) so the log argument is never 0.
+	t := uint64(scale * math.Pow(-math.Log(1.0-rand.Float64()), m.invShape))
+	if t == 0 {
+		t = 1
+	}
+	return t
+}
+
 type FailureCtrl struct {
 	owner           *Node
-	failTime        FailTime
+	model           FailureModel // nil if the node does not fail.
+	kind            FailureKind
 	recoverTs       uint64 // unit: us; timestamp when recovery from failure starts (valid if currently failed)
 	failTs          uint64 // unit: us; timestamp when failure starts (valid if currently not failed)
-	remainTm        uint64 // unit: us; time that remains in this fail cycle after failure has ended.
 	prevOpTimestamp uint64 // unit: us; time of previous reported next-operation timestamp.
+	processStopped  bool   // true if the node's OT process was stopped by the current failure.
 }
 
 func newFailureCtrl(owner *Node, failTime FailTime) *FailureCtrl {
 	fc := &FailureCtrl{
-		owner:    owner,
-		failTime: failTime,
+		owner: owner,
+	}
+	if failTime.CanFail() {
+		fc.model, _ = NewIntervalFailureModel(failTime)
 	}
 	return fc
 }
@@ -62,14 +178,36 @@ func (ft FailTime) CanFail() bool {
 	return ft.FailDuration > 0
 }
 
-func (fc *FailureCtrl) SetFailTime(failTime FailTime) {
-	fc.failTime = failTime
+// SetFailTime sets an interval failure model of the radio, or disables scheduled failures for NonFailTime.
+// It returns an error if the fail time is invalid.
+func (fc *FailureCtrl) SetFailTime(failTime FailTime) error {
+	var model FailureModel
+	if failTime.CanFail() {
+		var err error
+		if model, err = NewIntervalFailureModel(failTime); err != nil {
+			return err
+		}
+	}
+	fc.SetFailureModel(model, FailureKindRadio)
+	return nil
+}
+
+// SetFailureModel sets the model that determines when the node fails, and the kind of failure. A nil model
+// disables scheduled failures, which recovers the node if it is currently failed.
+func (fc *FailureCtrl) SetFailureModel(model FailureModel, kind FailureKind) {
+	fc.model = model
+	fc.kind = kind
 
 	fc.recoverTs = 0
 	fc.failTs = 0
-	fc.remainTm = 0
-	if !failTime.CanFail() && fc.owner.IsFailed() {
-		fc.owner.Recover()
+	if fc.owner.IsFailed() {
+		if model == nil {
+			fc.recover()
+		} else {
+			// stay failed, and recover as determined by the new model.
+			fc.recoverTs = fc.owner.CurTime + model.NextDownTime()
+			return
+		}
 	}
 	fc.calcNextFailTimestamp()
 }
@@ -79,7 +217,7 @@ func (fc *FailureCtrl) SetFailTime(failTime FailTime) {
 // that is set to 'true' if the next-operation timestamp moved further into the future.
 func (fc *FailureCtrl) OnTimeAdvanced(oldTime uint64) (uint64, bool) {
 	isUpdated := false
-	if !fc.failTime.CanFail() {
+	if fc.model == nil {
 		return Ever, isUpdated
 	}
 
@@ -91,7 +229,7 @@ func (fc *FailureCtrl) OnTimeAdvanced(oldTime uint64) (uint64, bool) {
 		if fc.owner.CurTime >= fc.recoverTs {
 			fc.recoverTs = 0
 			fc.calcNextFailTimestamp()
-			fc.owner.Recover()
+			fc.recover()
 			fc.prevOpTimestamp = fc.failTs
 			return fc.failTs, true
 		}
@@ -103,9 +241,9 @@ func (fc *FailureCtrl) OnTimeAdvanced(oldTime uint64) (uint64, bool) {
 	// if node is not failed currently
 	logger.AssertTrue(fc.recoverTs == 0)
 	if fc.owner.CurTime >= fc.failTs {
-		fc.recoverTs = fc.owner.CurTime + fc.failTime.FailDuration
+		fc.recoverTs = fc.owner.CurTime + fc.model.NextDownTime()
 		fc.failTs = 0
-		fc.owner.Fail()
+		fc.fail()
 		fc.prevOpTimestamp = fc.recoverTs
 		return fc.recoverTs, true
 	}
@@ -114,14 +252,28 @@ func (fc *FailureCtrl) OnTimeAdvanced(oldTime uint64) (uint64, bool) {
 	return fc.failTs, isUpdated
 }
 
+// fail fails the node, and stops its OT process for a reboot-type failure.
+func (fc *FailureCtrl) fail() {
+	fc.owner.Fail()
+	if fc.kind != FailureKindRadio {
+		fc.processStopped = true
+		fc.owner.D.cbHandler.OnNodeShutdown(fc.owner.Id)
+	}
+}
+
+// recover recovers the node, and restarts its OT process if the failure stopped it. The flash is wiped if
+// the current failure kind is FailureKindRebootWipe.
+func (fc *FailureCtrl) recover() {
+	fc.owner.Recover()
+	if fc.processStopped {
+		fc.processStopped = false
+		fc.owner.D.cbHandler.OnNodeReboot(fc.owner.Id, fc.kind == FailureKindRebootWipe)
+	}
+}
+
 func (fc *FailureCtrl) calcNextFailTimestamp() {
-	if !fc.failTime.CanFail() {
+	if fc.model == nil {
 		return
 	}
-	logger.AssertTrue(fc.failTime.FailDuration > 0 && fc.failTime.FailInterval > fc.failTime.FailDuration)
-	failStartTimeMax := int(fc.failTime.FailInterval - fc.failTime.FailDuration)
-	failTsRel := uint64(rand.Intn(failStartTimeMax))
-	fc.failTs = failTsRel + fc.owner.CurTime + fc.remainTm
-	fc.remainTm = fc.failTime.FailInterval - fc.failTime.FailDuration - failTsRel
-	logger.AssertTrue(fc.remainTm < fc.failTime.FailInterval)
+	fc.failTs = fc.owner.CurTime + fc.model.NextUpTime()
 }
@@ -47,6 +47,12 @@ func (m mockDispatcherCallback) OnNodeFail(nodeid NodeId) {
 func (m mockDispatcherCallback) OnNodeRecover(nodeid NodeId) {
 }
 
+func (m mockDispatcherCallback) OnNodeShutdown(nodeid NodeId) {
+}
+
+func (m mockDispatcherCallback) OnNodeReboot(nodeid NodeId, wipeFlash bool) {
+}
+
 func (m mockDispatcherCallback) OnUartWrite(nodeid NodeId, data []byte) {
 }
 
@@ -178,3 +184,211 @@ func TestFailureCtrlAddedOnAlreadyFailedNode(t *testing.T) {
 		node1.failureCtrl.OnTimeAdvanced(oldTime)
 	}
 }
+
+type rebootCountingCallback struct {
+	mockDispatcherCallback
+	shutdowns int
+	reboots   int
+	wipes     int
+}
+
+func (m *rebootCountingCallback) OnNodeShutdown(nodeid NodeId) {
+	m.shutdowns++
+}
+
+func (m *rebootCountingCallback) OnNodeReboot(nodeid NodeId, wipeFlash bool) {
+	m.reboots++
+	if wipeFlash {
+		m.wipes++
+	}
+}
+
+func TestFailureCtrlExponential(t *testing.T) {
+	node1 := mockNode1()
+	node1.failureCtrl = newFailureCtrl(node1, NonFailTime)
+	node1.D = &Dispatcher{
+		cbHandler: &mockDispatcherCallback{},
+		vis:       visualize.NewNopVisualizer(),
+	}
+	model, err := NewExponentialFailureModel(30*1e6, 10*1e6)
+	assert.Nil(t, err)
+	node1.SetFailureModel(model, FailureKindRadio)
+
+	failCount := 0
+	worksCount := 0
+
+	// simulate a 100-hour period
+	for i := 0; i < 3600000; i++ {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.D.CurTime = node1.CurTime
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+		if node1.IsFailed() {
+			failCount++
+		} else {
+			worksCount++
+		}
+	}
+
+	// verify that failure percentage is roughly MTTR / (MTBF + MTTR) = 25%
+	failPerc := float64(failCount) / float64(failCount+worksCount)
+	assert.True(t, failPerc > 0.23)
+	assert.True(t, failPerc < 0.27)
+}
+
+func TestFailureCtrlWeibull(t *testing.T) {
+	_, err := NewWeibullFailureModel(0, 10, 10)
+	assert.NotNil(t, err)
+	_, err = NewWeibullFailureModel(2.0, 0, 10)
+	assert.NotNil(t, err)
+
+	model, err := NewWeibullFailureModel(2.0, 1000000, 200000)
+	assert.Nil(t, err)
+	sumUp, sumDown := 0.0, 0.0
+	n := 100000
+	for i := 0; i < n; i++ {
+		sumUp += float64(model.NextUpTime())
+		sumDown += float64(model.NextDownTime())
+	}
+	assert.InDelta(t, 1000000, sumUp/float64(n), 20000)
+	assert.InDelta(t, 200000, sumDown/float64(n), 4000)
+}
+
+func TestFailureCtrlReboot(t *testing.T) {
+	node1 := mockNode1()
+	node1.failureCtrl = newFailureCtrl(node1, NonFailTime)
+	cb := &rebootCountingCallback{}
+	node1.D = &Dispatcher{
+		cbHandler: cb,
+		vis:       visualize.NewNopVisualizer(),
+	}
+	model, err := NewIntervalFailureModel(FailTime{FailDuration: 1e6, FailInterval: 10 * 1e6})
+	assert.Nil(t, err)
+	node1.SetFailureModel(model, FailureKindReboot)
+
+	// simulate a 100-second period: 10 fail intervals, minus the first/last one that may not complete.
+	// The process is shut down when a failure starts, and restarted when it ends.
+	for i := 0; i < 1000; i++ {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.D.CurTime = node1.CurTime
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+		assert.Equal(t, node1.IsFailed(), cb.shutdowns == cb.reboots+1)
+		assert.False(t, cb.reboots > cb.shutdowns)
+	}
+	assert.True(t, cb.reboots >= 9 && cb.reboots <= 10)
+	assert.Equal(t, 0, cb.wipes)
+
+	// a failure in progress at the change of the model ends with a reboot of the new kind.
+	failedAtSwitch := 0
+	if node1.IsFailed() {
+		failedAtSwitch = 1
+	}
+	node1.SetFailureModel(model, FailureKindRebootWipe)
+	for i := 0; i < 1000; i++ {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.D.CurTime = node1.CurTime
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+		assert.Equal(t, node1.IsFailed(), cb.shutdowns == cb.reboots+1)
+	}
+	assert.True(t, cb.wipes >= 9+failedAtSwitch && cb.wipes <= 10+failedAtSwitch)
+
+	_, err = NewIntervalFailureModel(FailTime{FailDuration: 10 * 1e6, FailInterval: 10 * 1e6})
+	assert.NotNil(t, err)
+}
+
+func TestFailureCtrlSetModelWhileFailed(t *testing.T) {
+	node1 := mockNode1()
+	node1.failureCtrl = newFailureCtrl(node1, NonFailTime)
+	node1.D = &Dispatcher{
+		cbHandler: &rebootCountingCallback{},
+		vis:       visualize.NewNopVisualizer(),
+	}
+	model, err := NewIntervalFailureModel(FailTime{FailDuration: 1e6, FailInterval: 10 * 1e6})
+	assert.Nil(t, err)
+	node1.SetFailureModel(model, FailureKindRadio)
+	for !node1.IsFailed() {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+	}
+
+	// the node stays failed when the model changes, and recovers per the new model.
+	node1.SetFailureModel(model, FailureKindRadio)
+	assert.True(t, node1.IsFailed())
+	for i := 0; i < 30 && node1.IsFailed(); i++ {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+	}
+	assert.False(t, node1.IsFailed())
+
+	node1.SetFailureModel(nil, FailureKindRadio)
+	assert.False(t, node1.IsFailed())
+}
+
+func TestFailureCtrlRecoverStoppedProcess(t *testing.T) {
+	model, err := NewIntervalFailureModel(FailTime{FailDuration: 1e6, FailInterval: 10 * 1e6})
+	assert.Nil(t, err)
+
+	// returns a node in a reboot-type failure, of which the process was stopped.
+	rebootFailedNode := func(cb *rebootCountingCallback) *Node {
+		node1 := mockNode1()
+		node1.failureCtrl = newFailureCtrl(node1, NonFailTime)
+		node1.D = &Dispatcher{
+			cbHandler: cb,
+			vis:       visualize.NewNopVisualizer(),
+			nodes:     map[NodeId]*Node{node1.Id: node1},
+		}
+		node1.SetFailureModel(model, FailureKindReboot)
+		for !node1.IsFailed() {
+			oldTime := node1.CurTime
+			node1.CurTime += 100000
+			node1.failureCtrl.OnTimeAdvanced(oldTime)
+		}
+		assert.Equal(t, 1, cb.shutdowns)
+		return node1
+	}
+
+	// 'radio on' restarts the process.
+	cb := &rebootCountingCallback{}
+	node1 := rebootFailedNode(cb)
+	node1.D.SetNodeFailed(node1.Id, false)
+	assert.False(t, node1.IsFailed())
+	assert.Equal(t, 1, cb.reboots)
+
+	// so does disabling the failure model.
+	cb = &rebootCountingCallback{}
+	node1 = rebootFailedNode(cb)
+	assert.Nil(t, node1.SetFailTime(NonFailTime))
+	assert.False(t, node1.IsFailed())
+	assert.Equal(t, 1, cb.reboots)
+
+	// and a recovery after a change to a radio failure model.
+	cb = &rebootCountingCallback{}
+	node1 = rebootFailedNode(cb)
+	node1.SetFailureModel(model, FailureKindRadio)
+	for i := 0; i < 30 && node1.IsFailed(); i++ {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+	}
+	assert.False(t, node1.IsFailed())
+	assert.Equal(t, 1, cb.reboots)
+	assert.Equal(t, 0, cb.wipes)
+
+	// a radio failure doesn't stop the process, so the recovery doesn't restart it.
+	node1.SetFailureModel(model, FailureKindRadio)
+	for !node1.IsFailed() {
+		oldTime := node1.CurTime
+		node1.CurTime += 100000
+		node1.failureCtrl.OnTimeAdvanced(oldTime)
+	}
+	node1.SetFailureModel(model, FailureKindReboot)
+	node1.D.SetNodeFailed(node1.Id, false)
+	assert.Equal(t, 1, cb.shutdowns)
+	assert.Equal(t, 1, cb.reboots)
+
+	assert.NotNil(t, node1.SetFailTime(FailTime{FailDuration: 10 * 1e6, FailInterval: 10 * 1e6}))
+}
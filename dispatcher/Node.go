@@ -78,17 +78,22 @@ type Node struct {
 	Role        OtDeviceRole
 	RadioNode   *radiomodel.RadioNode
 
-	conn          net.Conn
-	msgId         uint64
-	err           error
-	failureCtrl   *FailureCtrl
-	isFailed      bool
-	pendingPings  []*pingRequest
-	pingResults   []*PingResult
-	joinerState   OtJoinerState
-	joinerSession *joinerSession
-	joinResults   []*JoinResult
-	logger        *logger.NodeLogger
+	conn            net.Conn
+	msgId           uint64
+	err             error
+	protocolErrors  uint64
+	quarantined     bool
+	protocolVersion ProtocolVersion
+	capabilities    Capabilities
+	host            *rcpHost // host process, for an RCP node; nil otherwise.
+	failureCtrl     *FailureCtrl
+	isFailed        bool
+	pendingPings    []*pingRequest
+	pingResults     []*PingResult
+	joinerState     OtJoinerState
+	joinerSession   *joinerSession
+	joinResults     []*JoinResult
+	logger          *logger.NodeLogger
 }
 
 func newNode(d *Dispatcher, nodeid NodeId, cfg *NodeConfig) *Node {
@@ -114,9 +119,15 @@ func newNode(d *Dispatcher, nodeid NodeId, cfg *NodeConfig) *Node {
 		err:         nil, // keep track of connection errors.
 		RadioNode:   radiomodel.NewRadioNode(nodeid, radioCfg),
 		joinerState: OtJoinerStateIdle,
-		logger:      logger.GetNodeLogger(d.cfg.SimulationId, cfg),
+		// until the node's NodeInfo event is received, it's assumed to be a legacy node.
+		protocolVersion: ProtocolVersionLegacy,
+		capabilities:    CapabilitiesLegacy,
+		logger:          logger.GetNodeLogger(d.cfg.SimulationId, cfg),
 	}
 
+	if cfg.IsRcp {
+		nc.host = &rcpHost{curTime: d.CurTime}
+	}
 	nc.failureCtrl = newFailureCtrl(nc, NonFailTime)
 	return nc
 }
@@ -125,7 +136,8 @@ func (node *Node) String() string {
 	return GetNodeName(node.Id)
 }
 
-// SendToUART sends any data to virtual time UART of the node.
+// SendToUART sends any data to virtual time UART of the node. For an RCP node, this is the UART (CLI) of its
+// host process.
 func (node *Node) SendToUART(data []byte) error {
 	var err error
 	evt := &Event{
@@ -136,7 +148,11 @@ func (node *Node) SendToUART(data []byte) error {
 	}
 
 	node.logger.Tracef("UART-write: %s", data)
-	node.sendEvent(evt)
+	if node.host != nil {
+		node.sendHostEvent(evt)
+	} else {
+		node.sendEvent(evt)
+	}
 	if node.err != nil {
 		err = node.err
 	}
@@ -144,6 +160,12 @@ func (node *Node) SendToUART(data []byte) error {
 }
 
 func (node *Node) SendRxSensitivityEvent(includeData bool, rxSens int8) error {
+	if node.err != nil {
+		return node.err
+	}
+	if !node.HasCapability(CapRxSensitivity) {
+		return fmt.Errorf("%s does not support setting Rx sensitivity", node)
+	}
 	data := make([]byte, 0)
 	if includeData {
 		data = []byte{byte(rxSens)}
@@ -163,6 +185,9 @@ func (node *Node) SendRxSensitivityEvent(includeData bool, rxSens int8) error {
 // it sets evt.nodeId to the Id of the current node.
 // Any send-errors are stored in node.err.
 func (node *Node) sendEvent(evt *Event) {
+	if node.quarantined {
+		return // a quarantined node doesn't get events anymore, so it stays asleep.
+	}
 	node.msgId += 1
 	evt.NodeId = node.Id
 	evt.MsgId = node.msgId
@@ -170,9 +195,13 @@ func (node *Node) sendEvent(evt *Event) {
 	logger.AssertTrue(evt.Timestamp == node.D.CurTime)
 	evt.Delay = evt.Timestamp - oldTime
 
-	// time keeping - move node's time to the current send-event's time.
+	// time keeping - move node's time to the current send-event's time. The process of a node that is down
+	// in a reboot failure is stopped, so it doesn't get the event and isn't waited for.
+	isDown := node.isFailed && node.conn == nil
 	node.D.alarmMgr.SetNotified(node.Id)
-	node.D.setAlive(node.Id)
+	if !isDown {
+		node.D.setAlive(node.Id)
+	}
 	node.CurTime += evt.Delay
 	logger.AssertTrue(node.CurTime == node.D.CurTime)
 
@@ -188,6 +217,9 @@ func (node *Node) sendEvent(evt *Event) {
 			node.D.eventQueue.Add(wakeEvt)
 		}
 	}
+	if isDown {
+		return
+	}
 
 	err := node.sendRawData(evt.Serialize())
 	if err != nil {
@@ -214,8 +246,29 @@ func (node *Node) IsFailed() bool {
 	return node.isFailed
 }
 
+// GetProtocolErrors returns the number of malformed or unexpected events received from the node.
+func (node *Node) GetProtocolErrors() uint64 {
+	return node.protocolErrors
+}
+
+// GetProtocolVersion returns the event protocol version of the node.
+func (node *Node) GetProtocolVersion() ProtocolVersion {
+	return node.protocolVersion
+}
+
+// HasCapability returns true if the node supports the optional protocol feature(s) cap.
+func (node *Node) HasCapability(cap Capabilities) bool {
+	return node.capabilities.Has(cap)
+}
+
+// IsQuarantined returns true if the node was disconnected due to too many protocol errors, or due to
+// an incompatible protocol version.
+func (node *Node) IsQuarantined() bool {
+	return node.quarantined
+}
+
 func (node *Node) IsConnected() bool {
-	return node.conn != nil
+	return node.conn != nil && (node.host == nil || node.host.conn != nil)
 }
 
 func (node *Node) Fail() {
@@ -223,6 +276,7 @@ func (node *Node) Fail() {
 		node.isFailed = true
 		node.D.cbHandler.OnNodeFail(node.Id)
 		node.D.vis.OnNodeFail(node.Id)
+		node.D.subscribers.notify(func(sub EventSubscriber) { sub.OnNodeFail(node.Id) })
 		node.logger.Debugf("radio set to scheduled failure")
 	}
 }
@@ -232,6 +286,7 @@ func (node *Node) Recover() {
 		node.isFailed = false
 		node.D.cbHandler.OnNodeRecover(node.Id)
 		node.D.vis.OnNodeRecover(node.Id)
+		node.D.subscribers.notify(func(sub EventSubscriber) { sub.OnNodeRecover(node.Id) })
 		node.logger.Debugf("radio recovered from scheduled failure")
 	}
 }
@@ -240,8 +295,16 @@ func (node *Node) DumpStat() string {
 	return fmt.Sprintf("CurTime=%v, Failed=%-5v, RecoverTS=%v", node.CurTime, node.isFailed, node.failureCtrl.recoverTs)
 }
 
-func (node *Node) SetFailTime(failTime FailTime) {
-	node.failureCtrl.SetFailTime(failTime)
+// SetFailTime sets an interval failure model of the radio of the node, or disables scheduled failures for
+// NonFailTime. It returns an error if the fail time is invalid.
+func (node *Node) SetFailTime(failTime FailTime) error {
+	return node.failureCtrl.SetFailTime(failTime)
+}
+
+// SetFailureModel sets the model for scheduled failures of the node, and the kind of failure. A nil
+// model disables scheduled failures.
+func (node *Node) SetFailureModel(model FailureModel, kind FailureKind) {
+	node.failureCtrl.SetFailureModel(model, kind)
 }
 
 func (node *Node) onPingRequest(timestamp uint64, dstaddr string, datasize int) {
@@ -282,11 +345,13 @@ func (node *Node) onPingReply(timestamp uint64, dstaddr string, datasize int, ho
 }
 
 func (node *Node) addPingResult(dst string, datasize int, delay uint64) {
-	node.pingResults = append(node.pingResults, &PingResult{
+	result := &PingResult{
 		Dst:      dst,
 		DataSize: datasize,
 		Delay:    delay,
-	})
+	}
+	node.pingResults = append(node.pingResults, result)
+	node.D.subscribers.notify(func(sub EventSubscriber) { sub.OnPingResult(node.Id, result) })
 
 	if len(node.pingResults) > maxPingResultCount {
 		node.pingResults = node.pingResults[1:]
@@ -137,6 +137,17 @@ func (am *alarmMgr) NextTimestamp() uint64 {
 	return am.q[0].Timestamp
 }
 
+// PendingCount returns the number of nodes that have an alarm set.
+func (am *alarmMgr) PendingCount() int {
+	n := 0
+	for _, e := range am.q {
+		if e.Timestamp != Ever {
+			n++
+		}
+	}
+	return n
+}
+
 func (am *alarmMgr) DeleteNode(id NodeId) {
 	e := am.events[id]
 	logger.AssertNotNil(e)
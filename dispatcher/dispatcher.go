@@ -32,7 +32,6 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"math/rand"
 	"net"
 	"os"
 	"sort"
@@ -60,31 +59,34 @@ const (
 	DefaultReadTimeout        = time.Second * 5
 )
 
-type pcapFrameItem struct {
-	Ustime uint64
-	Data   []byte
-}
-
 type Config struct {
 	Speed             float64
 	Real              bool
 	DumpPackets       bool
 	PcapChannels      map[ChannelId]struct{}
+	PcapFormat        pcap.Format
+	PcapDecrypt       bool // write MAC secured frames decrypted to the pcap file.
+	PcapKeys          bool // write a Wireshark key file with the network keys next to the pcap file.
 	DefaultWatchOn    bool
 	DefaultWatchLevel string
 	VizUpdateTime     time.Duration
 	SimulationId      int
+	MaxProtocolErrors int // number of protocol errors after which a node is quarantined; 0 means never.
+	FrameHistorySize  int // number of recently dispatched frames kept for the 'frames' command.
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Speed:          1,
-		Real:           false,
-		DumpPackets:    false,
-		PcapChannels:   make(map[ChannelId]struct{}, 1),
-		DefaultWatchOn: false,
-		VizUpdateTime:  125 * time.Millisecond,
-		SimulationId:   0,
+		Speed:             1,
+		Real:              false,
+		DumpPackets:       false,
+		PcapChannels:      make(map[ChannelId]struct{}, 1),
+		PcapFormat:        pcap.FormatPcap,
+		DefaultWatchOn:    false,
+		VizUpdateTime:     125 * time.Millisecond,
+		SimulationId:      0,
+		MaxProtocolErrors: 10,
+		FrameHistorySize:  DefaultFrameHistorySize,
 	}
 }
 
@@ -95,6 +97,14 @@ type CallbackHandler interface {
 	// OnNodeRecover Notifies that the node's radio recovered from a simulated "fail" (off) state
 	OnNodeRecover(nodeid NodeId)
 
+	// OnNodeShutdown Notifies that the node started a simulated failure that requires its OT process to be
+	// stopped, as if powered off, until the node recovers.
+	OnNodeShutdown(nodeid NodeId)
+
+	// OnNodeReboot Notifies that the node recovered from a simulated failure that requires its OT process to
+	// be restarted, with its flash wiped if wipeFlash is true.
+	OnNodeReboot(nodeid NodeId, wipeFlash bool)
+
 	// OnUartWrite Notifies that the node's UART was written with data.
 	OnUartWrite(nodeid NodeId, data []byte)
 
@@ -111,37 +121,50 @@ type goDuration struct {
 }
 
 type Dispatcher struct {
-	ctx                   *progctx.ProgCtx
-	cfg                   Config
-	cbHandler             CallbackHandler
-	udpln                 net.Listener
-	socketName            string
-	eventChan             chan *Event
-	waitGroup             sync.WaitGroup
-	waitGroupNodes        sync.WaitGroup
-	CurTime               uint64
-	currentGoDuration     goDuration
-	pauseTime             uint64
-	alarmMgr              *alarmMgr
-	eventQueue            *sendQueue
-	nodes                 map[NodeId]*Node
-	deletedNodes          map[NodeId]struct{}
-	aliveNodes            map[NodeId]struct{}
-	pcap                  *pcap.File
-	pcapFrameChan         chan pcapFrameItem
-	vis                   visualize.Visualizer
-	taskChan              chan func()
-	speed                 float64
-	speedStartRealTime    time.Time
-	lastVizTime           time.Time
-	lastEnergyVizTime     uint64
-	speedStartTime        uint64
-	extaddrMap            map[uint64]*Node
-	rloc16Map             rloc16Map
-	goDurationChan        chan goDuration
-	globalPacketLossRatio float64
-	visOptions            VisualizationOptions
-	coaps                 *coapsHandler
+	ctx                *progctx.ProgCtx
+	cfg                Config
+	cbHandler          CallbackHandler
+	udpln              net.Listener
+	socketName         string
+	eventChan          chan *Event
+	waitGroup          sync.WaitGroup
+	waitGroupNodes     sync.WaitGroup
+	CurTime            uint64
+	currentGoDuration  goDuration
+	pauseTime          uint64
+	alarmMgr           *alarmMgr
+	eventQueue         *sendQueue
+	nodes              map[NodeId]*Node
+	deletedNodes       map[NodeId]struct{}
+	aliveNodes         map[NodeId]struct{}
+	pcap               *pcap.RotatingFile
+	pcapFilter         *PcapFilter
+	pcapFrameChan      chan *pcap.Frame
+	pcapLive           *pcap.LiveCapture
+	decrypter          *dissectpkt.Decrypter
+	vis                visualize.Visualizer
+	taskChan           chan func()
+	timedTasks         []timedTask
+	speed              float64
+	speedStartRealTime time.Time
+	lastVizTime        time.Time
+	lastEnergyVizTime  uint64
+	speedStartTime     uint64
+	extaddrMap         map[uint64]*Node
+	rloc16Map          rloc16Map
+	goDurationChan     chan goDuration
+	lossModel          LossModel
+	nodeLossModels     map[NodeId]LossModel
+	linkLossModels     map[linkId]LossModel
+	lostFrames         map[linkId]struct{}
+	partitionCuts      partitionCuts
+	frameStats         *frameStats
+	frameHistory       *frameHistory
+	subscribers        subscribers
+	statusPushHandlers map[string]StatusPushHandler
+	visOptions         VisualizationOptions
+	coaps              *coapsHandler
+	rcpConns           rcpConnections
 
 	Counters struct {
 		// Received event counters
@@ -151,6 +174,8 @@ type Dispatcher struct {
 		UartWriteEvents  uint64
 		CollisionEvents  uint64
 		OtherEvents      uint64
+		SpinelEvents     uint64
+		ProtocolErrors   uint64
 		// Packet dispatching counters
 		DispatchByExtAddrSucc   uint64
 		DispatchByExtAddrFail   uint64
@@ -184,7 +209,9 @@ func NewDispatcher(ctx *progctx.ProgCtx, cfg *Config, cbHandler CallbackHandler)
 		aliveNodes:         make(map[NodeId]struct{}),
 		extaddrMap:         map[uint64]*Node{},
 		rloc16Map:          rloc16Map{},
-		pcapFrameChan:      make(chan pcapFrameItem, 100000),
+		pcapFrameChan:      make(chan *pcap.Frame, 100000),
+		decrypter:          dissectpkt.NewDecrypter(),
+		pcapFilter:         newPcapFilter(),
 		speed:              cfg.Speed,
 		speedStartRealTime: time.Now(),
 		lastVizTime:        time.Unix(0, 0),
@@ -193,12 +220,19 @@ func NewDispatcher(ctx *progctx.ProgCtx, cfg *Config, cbHandler CallbackHandler)
 		watchingNodes:      map[NodeId]struct{}{},
 		goDurationChan:     make(chan goDuration, 1),
 		visOptions:         defaultVisualizationOptions(),
+		nodeLossModels:     map[NodeId]LossModel{},
+		linkLossModels:     map[linkId]LossModel{},
+		lostFrames:         map[linkId]struct{}{},
+		frameStats:         newFrameStats(),
+		frameHistory:       newFrameHistory(cfg.FrameHistorySize),
 		stopped:            false,
 	}
 	d.speed = d.normalizeSpeed(d.speed)
+	d.registerBuiltinStatusPushHandlers()
 	if len(d.cfg.PcapChannels) > 0 {
-		d.pcap, err = pcap.NewFile("current.pcap")
+		d.pcap, err = pcap.NewRotatingFile(d.cfg.PcapFormat, "current."+d.cfg.PcapFormat.FileExt(), d.pcapChannels())
 		logger.PanicIfError(err)
+		d.pcapLive = pcap.NewLiveCapture(d.cfg.PcapFormat, d.pcapChannels())
 		d.waitGroup.Add(1)
 		go d.pcapFrameWriter()
 	}
@@ -382,12 +416,28 @@ func (d *Dispatcher) goSimulateForDuration(duration goDuration) {
 // will need to be queued (scheduled).
 func (d *Dispatcher) handleRecvEvent(evt *Event) {
 	nodeid := evt.NodeId
+	if isHostId(nodeid) {
+		if node := d.nodes[-nodeid]; node != nil {
+			d.handleHostEvent(node, evt)
+		} else {
+			logger.Warnf("Event (type %v) received from unknown RCP host %v, discarding.", evt.Type, -nodeid)
+		}
+		return
+	}
 	node := d.nodes[nodeid]
 	if node == nil {
 		logger.Warnf("Event (type %v) received from unknown Node %v, discarding.", evt.Type, evt.NodeId)
 		return
 	}
 
+	if node.quarantined && evt.Type != EventTypeNodeDisconnected {
+		return // events of a quarantined node are discarded.
+	}
+	if evt.ParseError != nil {
+		d.onProtocolError(node, evt.ParseError)
+		return
+	}
+
 	node.conn = evt.Conn      // store socket connection for this node.
 	evt.Timestamp = d.CurTime // timestamp the incoming event
 
@@ -401,7 +451,7 @@ func (d *Dispatcher) handleRecvEvent(evt *Event) {
 	if d.cfg.Real && (evt.Type == EventTypeAlarmFired || evt.Type == EventTypeRadioReceived ||
 		evt.Type == EventTypeRadioCommStart || evt.Type == EventTypeRadioChannelSample ||
 		evt.Type == EventTypeRadioState) {
-		logger.Panicf("unexpected event in real mode: %v", evt.Type)
+		d.onProtocolError(node, fmt.Errorf("unexpected event in real mode: %v", evt.Type))
 		return
 	}
 
@@ -418,6 +468,10 @@ func (d *Dispatcher) handleRecvEvent(evt *Event) {
 		d.Counters.RadioEvents += 1
 		d.radioModel.HandleEvent(node.RadioNode, d.eventQueue, evt)
 	case EventTypeRadioState:
+		if !node.HasCapability(CapRadioState) {
+			d.onProtocolError(node, fmt.Errorf("RadioState event from a node without the radio-state capability"))
+			return
+		}
 		d.Counters.RadioEvents += 1
 		d.handleRadioState(node, evt)
 		d.radioModel.HandleEvent(node.RadioNode, d.eventQueue, evt)
@@ -426,21 +480,85 @@ func (d *Dispatcher) handleRecvEvent(evt *Event) {
 		d.handleStatusPush(node, string(evt.Data))
 	case EventTypeUartWrite:
 		d.Counters.UartWriteEvents += 1
-		d.cbHandler.OnUartWrite(node.Id, evt.Data)
+		if node.host != nil {
+			d.onRcpUartWrite(node, evt.Data)
+		} else {
+			d.cbHandler.OnUartWrite(node.Id, evt.Data)
+		}
 	case EventTypeExtAddr:
 		d.Counters.OtherEvents += 1
 		var extaddr = binary.BigEndian.Uint64(evt.Data[0:8])
+		if err := d.validateExtAddr(node, extaddr); err != nil {
+			d.onProtocolError(node, err)
+			break
+		}
 		node.onStatusPushExtAddr(extaddr)
 	case EventTypeNodeInfo:
 		d.Counters.OtherEvents += 1
+		d.handleNodeInfo(node, evt)
 	case EventTypeNodeDisconnected:
 		d.Counters.OtherEvents += 1
 		logger.Debugf("%s socket disconnected.", node)
 		d.setSleeping(node.Id)
 		d.alarmMgr.SetTimestamp(node.Id, Ever)
 	default:
-		logger.Panicf("received event type not implemented: %v", evt.Type)
+		d.onProtocolError(node, fmt.Errorf("received event type not implemented: %v", evt.Type))
+	}
+}
+
+// onProtocolError counts a malformed or unexpected event received from a node. When the node exceeds
+// the configured maximum number of protocol errors, it is quarantined: its socket is closed and all
+// further events from it are discarded, so that the rest of the simulation can continue.
+func (d *Dispatcher) onProtocolError(node *Node, err error) {
+	d.Counters.ProtocolErrors += 1
+	node.protocolErrors += 1
+	node.logger.Errorf("protocol error: %v", err)
+
+	if node.quarantined || d.cfg.MaxProtocolErrors <= 0 || node.protocolErrors < uint64(d.cfg.MaxProtocolErrors) {
+		return
+	}
+	node.logger.Errorf("quarantined after %d protocol errors, disconnecting node", node.protocolErrors)
+	d.quarantineNode(node)
+}
+
+// quarantineNode disconnects the node and discards all further events from it.
+func (d *Dispatcher) quarantineNode(node *Node) {
+	node.quarantined = true
+	if node.conn != nil {
+		_ = node.conn.Close()
+		node.conn = nil
+	}
+	if !d.cfg.Real {
+		d.setSleeping(node.Id)
+		d.alarmMgr.SetTimestamp(node.Id, Ever)
+	}
+}
+
+// handleNodeInfo handles the NodeInfo event that a node sends after connecting. It checks that the node
+// is compatible, and replies with the NodeInfo of OT-NS if the node supports the protocol handshake.
+// Incompatible nodes are rejected: these are disconnected and any next command to the node fails.
+// As long as OT-RFSIM nodes send the legacy NodeInfo event, only the legacy path is taken (see ProtocolVersion).
+func (d *Dispatcher) handleNodeInfo(node *Node, evt *Event) {
+	info := &evt.NodeInfoData
+	if err := info.CheckCompatible(); err != nil {
+		node.logger.Errorf("rejected: %v", err)
+		node.err = err
+		d.quarantineNode(node)
+		return
+	}
+
+	node.protocolVersion = info.ProtocolVersion
+	node.capabilities = info.Capabilities
+	if info.IsLegacy() {
+		node.logger.Debugf("legacy node, assuming protocol version %s", info.ProtocolVersion)
+		return
 	}
+	node.logger.Debugf("protocol version %s, capabilities %s", info.ProtocolVersion, info.Capabilities)
+	node.sendEvent(&Event{
+		Type:         EventTypeNodeInfo,
+		Timestamp:    d.CurTime,
+		NodeInfoData: NewNodeInfoEventData(node.Id),
+	})
 }
 
 // RecvEvents receives events from nodes, and handles these, until there is no more alive node.
@@ -549,10 +667,7 @@ func (d *Dispatcher) processNextEvent(simSpeed float64) bool {
 			// process next alarm
 			nextAlarm := d.alarmMgr.NextAlarm()
 			logger.AssertNotNil(nextAlarm)
-			node := d.nodes[nextAlarm.NodeId]
-			if node != nil {
-				d.advanceNodeTime(node, nextAlarm.Timestamp, false)
-			}
+			d.advanceProcessTime(nextAlarm.NodeId, nextAlarm.Timestamp, false)
 		} else {
 			// process next event from the queue
 			evt := d.eventQueue.PopNext()
@@ -590,6 +705,7 @@ func (d *Dispatcher) processNextEvent(simSpeed float64) bool {
 		nextSendTime = d.eventQueue.NextTimestamp()
 		nextEventTime = min(nextAlarmTime, nextSendTime)
 	}
+	d.postTimedTasks()
 
 	return len(d.nodes) > 0
 }
@@ -618,58 +734,83 @@ func (d *Dispatcher) eventsReader() {
 		d.waitGroupNodes.Add(1)
 		go func(myConn net.Conn) {
 			defer d.waitGroupNodes.Done()
-			defer myConn.Close()
+			d.readConn(myConn)
+		}(conn)
+	}
 
-			buf := make([]byte, 65536)
-			myNodeId := 0
+	logger.Tracef("waiting for dispatcher node socket threads to stop ...")
+	d.waitGroupNodes.Wait() // wait for all node goroutines to stop before closing eventsReader.
+}
 
-			for {
-				n, err := myConn.Read(buf)
+// readConn reads the events of a node process connection and passes these to the eventChan, until the connection
+// is closed.
+func (d *Dispatcher) readConn(myConn net.Conn) {
+	defer myConn.Close()
 
-				if errors.Is(err, io.EOF) {
-					break
-				} else if err != nil {
-					logger.NodeLogf(myNodeId, logger.ErrorLevel, "closing socket after read error: %+v", err)
-					break
-				}
+	buf := make([]byte, 65536)
+	myNodeId := 0
+	isHost := false // true if the connection is of the host process of an RCP node.
+	isClosing := false
+	connNodeId := func() NodeId { // id for events of this connection
+		if isHost {
+			return hostId(myNodeId)
+		}
+		return myNodeId
+	}
 
-				bufIdx := 0
-				for bufIdx < n {
-					evt := &Event{}
-					nextEventOffset := evt.Deserialize(buf[bufIdx:n])
-					if nextEventOffset == 0 { // a complete event wasn't found.
-						logger.Panicf("Node %d - Too many events, or incorrect event data - may increase 'buf' size in Dispatcher.eventsReader()", myNodeId)
-					}
-					bufIdx += nextEventOffset
-					// First event received should be NodeInfo type. From this, we learn nodeId.
-					if myNodeId == 0 && evt.Type == EventTypeNodeInfo {
-						myNodeId = evt.NodeInfoData.NodeId
-						logger.AssertTrue(myNodeId > 0)
-						logger.Debugf("Init event received from new Node %d", myNodeId)
-					}
-					evt.NodeId = myNodeId
-					evt.Conn = myConn
-					d.eventChan <- evt
-				}
+	for {
+		n, err := myConn.Read(buf)
+
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			logger.NodeLogf(myNodeId, logger.ErrorLevel, "closing socket after read error: %+v", err)
+			break
+		}
 
-				if n > len(buf)/2 { // increase buf size when needed
-					buf = make([]byte, len(buf)*2)
-					logger.NodeLogf(myNodeId, logger.WarnLevel, "increasing eventsReader() buf size to: %d KB", len(buf)/1024)
+		bufIdx := 0
+		for bufIdx < n {
+			evt := &Event{}
+			nextEventOffset, err := evt.Deserialize(buf[bufIdx:n])
+			if nextEventOffset == 0 { // a complete event wasn't found.
+				// the stream can't be resynchronized, so the node is disconnected.
+				logger.NodeLogf(myNodeId, logger.ErrorLevel, "closing socket after incomplete or incorrect event data (%d bytes)", n-bufIdx)
+				d.eventChan <- &Event{
+					NodeId:     connNodeId(),
+					ParseError: fmt.Errorf("incomplete or incorrect event data (%d bytes)", n-bufIdx),
 				}
+				isClosing = true
+				break
 			}
-
-			// Once the socket is disconnected, signal one last event.
-			d.eventChan <- &Event{
-				Delay:  0,
-				Type:   EventTypeNodeDisconnected,
-				NodeId: myNodeId,
-				Conn:   nil,
+			bufIdx += nextEventOffset
+			// First event received should be NodeInfo type. From this, we learn nodeId.
+			if myNodeId == 0 && evt.Type == EventTypeNodeInfo && err == nil {
+				myNodeId = evt.NodeInfoData.NodeId
+				isHost = d.rcpConns.isHostConn(myNodeId)
+				logger.Debugf("Init event received from new Node %d (RCP host: %t)", myNodeId, isHost)
 			}
-		}(conn)
+			evt.NodeId = connNodeId()
+			evt.Conn = myConn
+			evt.ParseError = err
+			d.eventChan <- evt
+		}
+		if isClosing {
+			break
+		}
+
+		if n > len(buf)/2 { // increase buf size when needed
+			buf = make([]byte, len(buf)*2)
+			logger.NodeLogf(myNodeId, logger.WarnLevel, "increasing eventsReader() buf size to: %d KB", len(buf)/1024)
+		}
 	}
 
-	logger.Tracef("waiting for dispatcher node socket threads to stop ...")
-	d.waitGroupNodes.Wait() // wait for all node goroutines to stop before closing eventsReader.
+	// Once the socket is disconnected, signal one last event.
+	d.eventChan <- &Event{
+		Delay:  0,
+		Type:   EventTypeNodeDisconnected,
+		NodeId: connNodeId(),
+		Conn:   nil,
+	}
 }
 
 func (d *Dispatcher) advanceNodeTime(node *Node, timestamp uint64, force bool) {
@@ -700,14 +841,32 @@ func (d *Dispatcher) sendRadioCommRxStartEvents(srcNode *Node, evt *Event) {
 	if srcNode.isFailed {
 		return // source node can't send - don't send
 	}
+	if !d.subscribers.isEmpty() {
+		frameEvt := newFrameEvent(evt, srcNode.Id, InvalidNodeId)
+		d.subscribers.notify(func(sub EventSubscriber) { sub.OnFrameTransmitted(frameEvt) })
+	}
+
+	pktinfo := dissectpkt.Dissect(evt.Data)
 
 	// record the sent frame in Pcap/Dump logs - once, at time of Tx start. Only do pcap if channel is
-	// configured to be recorded in the pcap file.
-	if _, ok := d.cfg.PcapChannels[int(evt.RadioCommData.Channel)]; ok {
-		d.pcapFrameChan <- pcapFrameItem{evt.Timestamp, evt.Data[RadioMessagePsduOffset:]}
+	// configured to be recorded in the pcap file, and the capture filter accepts the frame.
+	if _, ok := d.cfg.PcapChannels[int(evt.RadioCommData.Channel)]; ok &&
+		d.pcapFilter.accepts(srcNode.Id, pktinfo.Type(), evt.Timestamp) {
+		data := evt.Data
+		if d.cfg.PcapDecrypt {
+			data = d.decrypter.DecryptFrame(data, srcNode.ExtAddr)
+		}
+		d.pcapFrameChan <- &pcap.Frame{
+			Ustime:     evt.Timestamp,
+			Data:       data[RadioMessagePsduOffset:],
+			Channel:    int(evt.RadioCommData.Channel),
+			TxPowerDbm: evt.RadioCommData.PowerDbm,
+			NodeId:     srcNode.Id,
+			Comment:    fmt.Sprintf("rloc16 0x%04x, extaddr %016x", srcNode.Rloc16, srcNode.ExtAddr),
+		}
 	}
 	if d.cfg.DumpPackets {
-		d.dumpPacket(evt)
+		d.dumpPacket(srcNode, evt)
 	}
 
 	// dispatch the message to all in range that are receiving.
@@ -721,7 +880,6 @@ func (d *Dispatcher) sendRadioCommRxStartEvents(srcNode *Node, evt *Event) {
 	d.Counters.DispatchAllInRange++
 
 	// visualize the transmission and (intended) reception of the frame, based on addressing.
-	pktinfo := dissectpkt.Dissect(evt.Data)
 	pktFrame := pktinfo.MacFrame
 	dstAddrMode := pktFrame.FrameControl.DestAddrMode()
 
@@ -729,10 +887,10 @@ func (d *Dispatcher) sendRadioCommRxStartEvents(srcNode *Node, evt *Event) {
 		// unicast ExtAddr frame
 		dstNode := d.extaddrMap[pktFrame.DstAddrExtended]
 		if dstNode != nil && neighborNodes[dstNode.Id] != nil {
-			d.visSendFrame(srcNode.Id, dstNode.Id, pktFrame, evt.RadioCommData)
+			d.visSendFrame(srcNode.Id, dstNode.Id, pktinfo, evt.RadioCommData)
 		} else {
 			// extAddr didn't exist or was out of range
-			d.visSendFrame(srcNode.Id, InvalidNodeId, pktFrame, evt.RadioCommData)
+			d.visSendFrame(srcNode.Id, InvalidNodeId, pktinfo, evt.RadioCommData)
 		}
 	} else if dstAddrMode == wpan.AddrModeShort && pktFrame.DstAddrShort != threadconst.BroadcastRloc16 {
 		// unicast short addr frame. May go to multiple if multiple nodes use same short addr.
@@ -741,15 +899,15 @@ func (d *Dispatcher) sendRadioCommRxStartEvents(srcNode *Node, evt *Event) {
 		if len(dstNodes) > 0 {
 			for _, dstNode := range dstNodes {
 				if neighborNodes[dstNode.Id] != nil {
-					d.visSendFrame(srcNode.Id, dstNode.Id, pktFrame, evt.RadioCommData)
+					d.visSendFrame(srcNode.Id, dstNode.Id, pktinfo, evt.RadioCommData)
 				}
 			}
 		} else {
-			d.visSendFrame(srcNode.Id, InvalidNodeId, pktFrame, evt.RadioCommData)
+			d.visSendFrame(srcNode.Id, InvalidNodeId, pktinfo, evt.RadioCommData)
 		}
 	} else {
 		// broadcast frame
-		d.visSendFrame(srcNode.Id, BroadcastNodeId, pktFrame, evt.RadioCommData)
+		d.visSendFrame(srcNode.Id, BroadcastNodeId, pktinfo, evt.RadioCommData)
 	}
 }
 
@@ -759,12 +917,15 @@ func (d *Dispatcher) sendRadioCommRxDoneEvents(srcNode *Node, evt *Event) {
 	logger.AssertTrue(evt.Type == EventTypeRadioRxDone)
 
 	if srcNode.isFailed {
+		d.frameStats.onSrcFailed(srcNode.Id)
 		return // source node can't send - don't send, and don't log in pcap.
 	}
-
 	// try to dispatch the message by address directly to the right node
 	pktinfo := dissectpkt.Dissect(evt.Data)
 	pktFrame := pktinfo.MacFrame
+	d.frameStats.onTx(srcNode.Id, pktinfo.Type())
+	d.frameHistory.begin(d.newFrameRecord(srcNode, evt, pktinfo))
+	defer d.frameHistory.end()
 	dispatchedByDstAddr := false
 	dstAddrMode := pktFrame.FrameControl.DestAddrMode()
 
@@ -774,6 +935,8 @@ func (d *Dispatcher) sendRadioCommRxDoneEvents(srcNode *Node, evt *Event) {
 		if dstnode != srcNode && dstnode != nil {
 			if d.checkRadioReachable(srcNode, dstnode) {
 				d.sendOneRadioFrame(evt, srcNode, dstnode)
+			} else {
+				d.onFrameDropped(srcNode.Id, dstnode.Id, unreachableReason(srcNode, dstnode))
 			}
 			d.Counters.DispatchByExtAddrSucc++
 		} else {
@@ -791,6 +954,8 @@ func (d *Dispatcher) sendRadioCommRxDoneEvents(srcNode *Node, evt *Event) {
 				if d.checkRadioReachable(srcNode, dstNode) {
 					d.sendOneRadioFrame(evt, srcNode, dstNode)
 					dispatchCnt++
+				} else if dstNode != srcNode {
+					d.onFrameDropped(srcNode.Id, dstNode.Id, unreachableReason(srcNode, dstNode))
 				}
 			}
 			d.Counters.DispatchByShortAddrSucc++
@@ -818,25 +983,44 @@ func (d *Dispatcher) checkRadioReachable(src *Node, dst *Node) bool {
 		d.radioModel.CheckRadioReachable(src.RadioNode, dst.RadioNode)
 }
 
+// unreachableReason determines why the addressed dst node can't be reached by a frame from src.
+func unreachableReason(src *Node, dst *Node) FrameDropReason {
+	if dst.isFailed {
+		return DropDstFailed
+	}
+	if dst.RadioNode.RadioState != RadioRx || dst.RadioNode.RadioChannel != src.RadioNode.RadioChannel {
+		return DropRadioModel
+	}
+	return DropOutOfRange
+}
+
 func (d *Dispatcher) sendOneRadioFrame(evt *Event,
 	srcnode *Node, dstnode *Node) {
 	logger.AssertFalse(d.cfg.Real)
 	logger.AssertTrue(EventTypeRadioCommStart == evt.Type || EventTypeRadioRxDone == evt.Type)
 	logger.AssertTrue(srcnode != dstnode)
 
+	// frame statistics are only counted at the end of the frame (EventTypeRadioRxDone).
+	isRxDone := evt.Type == EventTypeRadioRxDone
+
 	// Tx failure cases below:
 	//   1) 'failed' state of the dest node
 	if dstnode.isFailed {
+		d.countDroppedFrame(isRxDone, srcnode, dstnode, DropDstFailed)
 		return
 	}
 
-	//   2) dispatcher's random packet loss Event (separate from radio model)
-	if d.globalPacketLossRatio > 0 {
-		datalen := len(evt.Data)
-		succRate := math.Pow(1.0-d.globalPacketLossRatio, float64(datalen)/128.0)
-		if rand.Float64() >= succRate {
-			return
-		}
+	//   2) a forced network partition (cut) separates the source and dest node
+	if d.partitionCuts.IsCut(srcnode.Id, dstnode.Id) {
+		d.countDroppedFrame(isRxDone, srcnode, dstnode, DropPartitionCut)
+		return
+	}
+
+	//   3) dispatcher's random packet loss (separate from radio model), decided once per frame at the
+	//      start of the frame and then also applied to the end of the same frame.
+	if d.isFrameLost(evt, srcnode, dstnode) {
+		d.countDroppedFrame(isRxDone, srcnode, dstnode, DropLossModel)
+		return
 	}
 
 	// create new Event copy for individual dispatch to dstNode.
@@ -844,14 +1028,85 @@ func (d *Dispatcher) sendOneRadioFrame(evt *Event,
 	evt2.NodeId = dstnode.Id
 
 	// Tx failure cases below:
-	//   3) radio model indicates failure on this specific link (e.g. interference) now.
+	//   4) radio model indicates failure on this specific link (e.g. interference) now.
 	// Below lets the radio model process every individual dispatch, to set RSSI, error, etc.
 	if d.radioModel.OnEventDispatch(srcnode.RadioNode, dstnode.RadioNode, &evt2) {
 		// send the event plus time keeping - moves dstnode's time to the current send-event's time.
 		dstnode.sendEvent(&evt2)
+		if !isRxDone {
+			return
+		}
+		if evt2.RadioCommData.Error == OT_ERROR_FCS {
+			d.onFrameDropped(srcnode.Id, dstnode.Id, DropInterference)
+		} else {
+			d.onFrameDelivered(srcnode.Id, dstnode.Id, evt2.RadioCommData.PowerDbm)
+			if !d.subscribers.isEmpty() {
+				frameEvt := newFrameEvent(&evt2, srcnode.Id, dstnode.Id)
+				d.subscribers.notify(func(sub EventSubscriber) { sub.OnFrameReceived(frameEvt) })
+			}
+		}
+	} else {
+		d.countDroppedFrame(isRxDone, srcnode, dstnode, DropRadioModel)
 	}
 }
 
+func (d *Dispatcher) countDroppedFrame(isRxDone bool, srcnode *Node, dstnode *Node, reason FrameDropReason) {
+	if isRxDone {
+		d.onFrameDropped(srcnode.Id, dstnode.Id, reason)
+	}
+}
+
+func (d *Dispatcher) onFrameDelivered(src NodeId, dst NodeId, rssi int8) {
+	d.frameStats.onDelivered(src, dst)
+	d.frameHistory.onDelivered(dst, rssi)
+}
+
+func (d *Dispatcher) onFrameDropped(src NodeId, dst NodeId, reason FrameDropReason) {
+	d.frameStats.onDropped(src, dst, reason)
+	d.frameHistory.onDropped(dst, reason)
+}
+
+// newFrameRecord returns the frame history record of the frame of evt, transmitted by srcNode. Its Type and
+// Info are set when the frame is queried.
+func (d *Dispatcher) newFrameRecord(srcNode *Node, evt *Event, pktinfo *dissectpkt.PktInfo) *FrameRecord {
+	frame := pktinfo.MacFrame
+	dst := InvalidNodeId
+	switch frame.FrameControl.DestAddrMode() {
+	case wpan.AddrModeExtended:
+		if dstNode := d.extaddrMap[frame.DstAddrExtended]; dstNode != nil {
+			dst = dstNode.Id
+		}
+	case wpan.AddrModeShort:
+		if frame.DstAddrShort == threadconst.BroadcastRloc16 {
+			dst = BroadcastNodeId
+		} else if dstNodes := d.rloc16Map[frame.DstAddrShort]; len(dstNodes) > 0 {
+			dst = dstNodes[0].Id
+		}
+	}
+	return &FrameRecord{
+		Timestamp:  evt.Timestamp,
+		Channel:    ChannelId(evt.RadioCommData.Channel),
+		Src:        srcNode.Id,
+		Dst:        dst,
+		Seq:        frame.Seq,
+		Length:     len(evt.Data) - RadioMessagePsduOffset,
+		PowerDbm:   evt.RadioCommData.PowerDbm,
+		data:       evt.Data,
+		srcExtAddr: srcNode.ExtAddr,
+	}
+}
+
+// GetFrames returns the last (at most) max frames of the frame history that match the filter, oldest first.
+// If max is 0, all matching frames are returned.
+func (d *Dispatcher) GetFrames(filter *FrameFilter, max int) []*FrameRecord {
+	return d.frameHistory.query(filter, max, d.decrypter)
+}
+
+// ClearFrames clears the frame history.
+func (d *Dispatcher) ClearFrames() {
+	d.frameHistory.clear()
+}
+
 func (d *Dispatcher) setAlive(nodeid NodeId) {
 	logger.AssertFalse(d.cfg.Real)
 	logger.AssertFalse(d.isDeleted(nodeid))
@@ -886,14 +1141,17 @@ func (d *Dispatcher) syncAliveNodes() {
 
 	logger.Warnf("syncing %d alive nodes: %v", len(d.aliveNodes), d.aliveNodes)
 	for nodeid := range d.aliveNodes {
-		d.advanceNodeTime(d.nodes[nodeid], d.CurTime, true)
+		d.advanceProcessTime(nodeid, d.CurTime, true)
 	}
 }
 
 // syncAllNodes advances all the node's time to current dispatcher time.
 func (d *Dispatcher) syncAllNodes() {
-	for nodeid := range d.nodes {
-		d.advanceNodeTime(d.nodes[nodeid], d.CurTime, false)
+	for _, node := range d.nodes {
+		d.advanceNodeTime(node, d.CurTime, false)
+		if node.host != nil {
+			d.advanceHostTime(node, d.CurTime, false)
+		}
 	}
 	d.RecvEvents() // blocks until all nodes asleep again.
 }
@@ -902,20 +1160,63 @@ func (d *Dispatcher) pcapFrameWriter() {
 	defer d.waitGroup.Done()
 
 	defer func() {
+		d.pcapLive.Close()
 		err := d.pcap.Close()
 		if err != nil {
 			logger.Errorf("failed to close pcap: %v", err)
 		}
 	}()
 
-	for item := range d.pcapFrameChan {
-		err := d.pcap.AppendFrame(item.Ustime, item.Data)
+	for frame := range d.pcapFrameChan {
+		err := d.pcap.WriteFrame(frame)
 		if err != nil {
 			logger.Errorf("write pcap failed:%+v", err)
 		}
+		d.pcapLive.WriteFrame(frame)
 	}
 }
 
+// AddNetworkKey adds the network key of a Thread network, with its current key sequence, which is used to
+// decrypt frames for the packet dump, and for the pcap file if so configured.
+func (d *Dispatcher) AddNetworkKey(networkKey []byte, keySequence uint32) {
+	added, err := d.decrypter.AddNetworkKey(networkKey, keySequence)
+	if err != nil {
+		logger.Warnf("network key not added: %v", err)
+		return
+	}
+	if added && d.cfg.PcapKeys && d.pcap != nil {
+		fn := "current." + pcap.WiresharkKeysFileName
+		if err = pcap.WriteWiresharkKeys(fn, d.decrypter.NetworkKeys()); err != nil {
+			logger.Errorf("write %s failed: %v", fn, err)
+		}
+	}
+}
+
+// GetPcapFile returns the pcap file, or nil if pcap is disabled.
+func (d *Dispatcher) GetPcapFile() *pcap.RotatingFile {
+	return d.pcap
+}
+
+// GetPcapFilter returns the filter of the frames that are captured.
+func (d *Dispatcher) GetPcapFilter() *PcapFilter {
+	return d.pcapFilter
+}
+
+// GetLiveCapture returns the live capture that streams the pcap frames to clients, or nil if pcap is disabled.
+func (d *Dispatcher) GetLiveCapture() *pcap.LiveCapture {
+	return d.pcapLive
+}
+
+// pcapChannels returns the channels configured to be captured in the pcap file, sorted.
+func (d *Dispatcher) pcapChannels() []ChannelId {
+	channels := make([]ChannelId, 0, len(d.cfg.PcapChannels))
+	for ch := range d.cfg.PcapChannels {
+		channels = append(channels, ch)
+	}
+	sort.Ints(channels)
+	return channels
+}
+
 func (d *Dispatcher) SetVisualizer(vis visualize.Visualizer) {
 	logger.AssertNotNil(vis)
 	d.vis = vis
@@ -927,98 +1228,6 @@ func (d *Dispatcher) GetVisualizer() visualize.Visualizer {
 	return d.vis
 }
 
-func (d *Dispatcher) handleStatusPush(srcnode *Node, data string) {
-	srcnode.logger.Tracef("status push: %#v", data)
-	statuses := strings.Split(data, ";")
-	srcid := srcnode.Id
-	for _, status := range statuses {
-		sp := strings.Split(status, "=")
-		if len(sp) != 2 {
-			continue
-		}
-		if sp[0] == "transmit" {
-			d.visStatusPushTransmit(srcnode, sp[1])
-		} else if sp[0] == "role" {
-			role, err := strconv.Atoi(sp[1])
-			logger.PanicIfError(err)
-			d.setNodeRole(srcnode, OtDeviceRole(role))
-		} else if sp[0] == "rloc16" {
-			rloc16, err := strconv.Atoi(sp[1])
-			logger.PanicIfError(err)
-			d.setNodeRloc16(srcid, uint16(rloc16))
-		} else if sp[0] == "ping_request" {
-			// e.x. ping_request=fdde:ad00:beef:0:556:90c8:ffaf:b7a3$0$4026600960
-			args := strings.Split(sp[1], ",")
-			dstaddr := args[0]
-			datasize, err := strconv.Atoi(args[1])
-			logger.PanicIfError(err)
-			timestamp, err := strconv.ParseUint(args[2], 10, 64)
-			logger.PanicIfError(err)
-			srcnode.onPingRequest(d.convertNodeMilliTime(srcnode, uint32(timestamp)), dstaddr, datasize)
-		} else if sp[0] == "ping_reply" {
-			//e.x.ping_reply=fdde:ad00:beef:0:556:90c8:ffaf:b7a3$0$0$64
-			args := strings.Split(sp[1], ",")
-			dstaddr := args[0]
-			datasize, err := strconv.Atoi(args[1])
-			logger.PanicIfError(err)
-			timestamp, err := strconv.ParseUint(args[2], 10, 64)
-			logger.PanicIfError(err)
-			hoplimit, err := strconv.Atoi(args[3])
-			logger.PanicIfError(err)
-			srcnode.onPingReply(d.convertNodeMilliTime(srcnode, uint32(timestamp)), dstaddr, datasize, hoplimit)
-		} else if sp[0] == "coap" {
-			d.handleCoapEvent(srcnode, sp[1])
-		} else if sp[0] == "parid" {
-			// set partition id
-			parid, err := strconv.ParseUint(sp[1], 16, 32)
-			logger.PanicIfError(err)
-			srcnode.PartitionId = uint32(parid)
-			d.vis.SetNodePartitionId(srcid, uint32(parid))
-		} else if sp[0] == "router_added" {
-			extaddr, err := strconv.ParseUint(sp[1], 16, 64)
-			logger.PanicIfError(err)
-			if d.visOptions.RouterTable {
-				d.vis.AddRouterTable(srcid, extaddr)
-			}
-		} else if sp[0] == "router_removed" {
-			extaddr, err := strconv.ParseUint(sp[1], 16, 64)
-			logger.PanicIfError(err)
-			if d.visOptions.RouterTable {
-				d.vis.RemoveRouterTable(srcid, extaddr)
-			}
-		} else if sp[0] == "child_added" {
-			extaddr, err := strconv.ParseUint(sp[1], 16, 64)
-			logger.PanicIfError(err)
-			if d.visOptions.ChildTable {
-				d.vis.AddChildTable(srcid, extaddr)
-			}
-		} else if sp[0] == "child_removed" {
-			extaddr, err := strconv.ParseUint(sp[1], 16, 64)
-			logger.PanicIfError(err)
-			if d.visOptions.ChildTable {
-				d.vis.RemoveChildTable(srcid, extaddr)
-			}
-		} else if sp[0] == "parent" {
-			extaddr, err := strconv.ParseUint(sp[1], 16, 64)
-			logger.PanicIfError(err)
-			d.vis.SetParent(srcid, extaddr)
-		} else if sp[0] == "joiner_state" {
-			joinerState, err := strconv.Atoi(sp[1])
-			logger.PanicIfError(err)
-			srcnode.onJoinerState(OtJoinerState(joinerState))
-		} else if sp[0] == "extaddr" {
-			extaddr, err := strconv.ParseUint(sp[1], 16, 64)
-			logger.PanicIfError(err)
-			srcnode.onStatusPushExtAddr(extaddr)
-		} else if sp[0] == "mode" {
-			mode := ParseNodeMode(sp[1])
-			d.vis.SetNodeMode(srcid, mode)
-		} else {
-			logger.Warnf("received unknown status push: %s=%s", sp[0], sp[1])
-		}
-	}
-}
-
 func (d *Dispatcher) AddNode(nodeid NodeId, cfg *NodeConfig) *Node {
 	logger.AssertNil(d.nodes[nodeid])
 	logger.Debugf("dispatcher AddNode id=%d", nodeid)
@@ -1031,6 +1240,12 @@ func (d *Dispatcher) AddNode(nodeid NodeId, cfg *NodeConfig) *Node {
 	d.vis.AddNode(nodeid, cfg.X, cfg.Y, cfg.RadioRange)
 	d.radioModel.AddNode(nodeid, node.RadioNode)
 	d.setAlive(nodeid)
+	if node.host != nil {
+		delete(d.deletedNodes, hostId(nodeid))
+		d.alarmMgr.AddNode(hostId(nodeid))
+		d.setAlive(hostId(nodeid))
+		d.rcpConns.expect(nodeid)
+	}
 
 	if d.cfg.DefaultWatchOn {
 		d.WatchNode(nodeid, logger.ParseLevelString(d.cfg.DefaultWatchLevel))
@@ -1060,28 +1275,34 @@ func (d *Dispatcher) setNodeRloc16(srcid NodeId, rloc16 uint16) {
 	d.vis.SetNodeRloc16(srcid, rloc16)
 }
 
-func (d *Dispatcher) visStatusPushTransmit(srcnode *Node, s string) {
+func (d *Dispatcher) visStatusPushTransmit(srcnode *Node, s string) error {
 	var fcf wpan.FrameControl
 
 	// only visualize `transmit` status emitting in real mode because simulation nodes already have radio events visualized
 	if !d.cfg.Real {
-		return
+		return nil
 	}
 
 	parts := strings.Split(s, ",")
 
 	if len(parts) < 3 {
-		logger.Panicf("invalid status push: transmit=%s", s)
+		return fmt.Errorf("expected at least 3 arguments, got %d", len(parts))
 	}
 
 	channel, err := strconv.Atoi(parts[0])
-	logger.PanicIfError(err)
+	if err != nil {
+		return err
+	}
 	fcfval, err := strconv.ParseUint(parts[1], 16, 16)
-	logger.PanicIfError(err)
+	if err != nil {
+		return err
+	}
 	fcf = wpan.FrameControl(fcfval)
 
 	seq, err := strconv.Atoi(parts[2])
-	logger.PanicIfError(err)
+	if err != nil {
+		return err
+	}
 
 	dstAddrMode := fcf.DestAddrMode()
 
@@ -1091,9 +1312,15 @@ func (d *Dispatcher) visStatusPushTransmit(srcnode *Node, s string) {
 		Seq:          uint8(seq),
 	}
 
+	if (dstAddrMode == wpan.AddrModeExtended || dstAddrMode == wpan.AddrModeShort) && len(parts) < 4 {
+		return fmt.Errorf("expected 4 arguments, got %d", len(parts))
+	}
+
 	if dstAddrMode == wpan.AddrModeExtended {
 		dstExtend, err := strconv.ParseUint(parts[3], 16, 64)
-		logger.PanicIfError(err)
+		if err != nil {
+			return err
+		}
 
 		visInfo.DstAddrExtended = dstExtend
 
@@ -1105,7 +1332,9 @@ func (d *Dispatcher) visStatusPushTransmit(srcnode *Node, s string) {
 		}
 	} else if dstAddrMode == wpan.AddrModeShort {
 		dstShortVal, err := strconv.ParseUint(parts[3], 16, 16)
-		logger.PanicIfError(err)
+		if err != nil {
+			return err
+		}
 
 		dstShort := uint16(dstShortVal)
 		visInfo.DstAddrShort = dstShort
@@ -1127,9 +1356,12 @@ func (d *Dispatcher) visStatusPushTransmit(srcnode *Node, s string) {
 	} else {
 		d.vis.Send(srcnode.Id, BroadcastNodeId, visInfo)
 	}
+
+	return nil
 }
 
-func (d *Dispatcher) visSendFrame(srcid NodeId, dstid NodeId, pktframe *wpan.MacFrame, commData RadioCommEventData) {
+func (d *Dispatcher) visSendFrame(srcid NodeId, dstid NodeId, pktinfo *dissectpkt.PktInfo, commData RadioCommEventData) {
+	pktframe := pktinfo.MacFrame
 	d.visSend(srcid, dstid, &visualize.MsgVisualizeInfo{
 		Channel:         pktframe.Channel,
 		FrameControl:    pktframe.FrameControl,
@@ -1138,6 +1370,7 @@ func (d *Dispatcher) visSendFrame(srcid NodeId, dstid NodeId, pktframe *wpan.Mac
 		DstAddrExtended: pktframe.DstAddrExtended,
 		SendDurationUs:  uint32(commData.Duration),
 		PowerDbm:        commData.PowerDbm,
+		MsgType:         pktinfo.Type().String(),
 	})
 }
 
@@ -1165,19 +1398,14 @@ func (d *Dispatcher) advanceTime(ts uint64) {
 	logger.AssertTrue(d.CurTime <= ts, "%v > %v", d.CurTime, ts)
 	if d.CurTime < ts {
 		d.CurTime = ts
+		d.vis.SetCurTime(ts)
 		if d.cfg.Real {
 			d.syncAllNodes()
 		}
 	}
 
 	if time.Since(d.lastVizTime) >= d.cfg.VizUpdateTime {
-		elapsedTime := int64(d.CurTime - d.speedStartTime)
-		elapsedRealTime := time.Since(d.speedStartRealTime) / time.Microsecond
-		if elapsedRealTime > 0 {
-			d.vis.AdvanceTime(ts, float64(elapsedTime)/float64(elapsedRealTime))
-		} else {
-			d.vis.AdvanceTime(ts, MaxSimulateSpeed)
-		}
+		d.vis.AdvanceTime(ts, d.GetAchievedSpeed())
 		d.lastVizTime = time.Now()
 	}
 
@@ -1192,6 +1420,38 @@ func (d *Dispatcher) PostAsync(task func()) {
 	d.taskChan <- task
 }
 
+// timedTask is a task to be executed at simulation time ts, see PostAsyncAt.
+type timedTask struct {
+	ts   uint64
+	task func()
+}
+
+// PostAsyncAt posts a task to be executed once the simulation time reaches ts. The dispatcher stops at ts
+// for the task, even if no node has an event at that time. It must be called from the dispatcher goroutine.
+func (d *Dispatcher) PostAsyncAt(ts uint64, task func()) {
+	logger.AssertTrue(ts >= d.CurTime)
+	d.timedTasks = append(d.timedTasks, timedTask{ts: ts, task: task})
+	// an event without node, which is discarded when processed at ts.
+	d.eventQueue.Add(&Event{
+		Type:      EventTypeAlarmFired,
+		NodeId:    InvalidNodeId,
+		Timestamp: ts,
+	})
+}
+
+// postTimedTasks posts the timed tasks that are due at the current time, for execution by handleTasks.
+func (d *Dispatcher) postTimedTasks() {
+	pending := d.timedTasks[:0]
+	for _, t := range d.timedTasks {
+		if t.ts <= d.CurTime {
+			d.PostAsync(t.task)
+		} else {
+			pending = append(pending, t)
+		}
+	}
+	d.timedTasks = pending
+}
+
 func (d *Dispatcher) handleTasks() {
 	defer func() {
 		err := recover()
@@ -1263,6 +1523,36 @@ func (d *Dispatcher) SetNodePos(id NodeId, x, y int) {
 }
 
 func (d *Dispatcher) DeleteNode(id NodeId) {
+	d.removeNode(id)
+	d.frameStats.removeNode(id)
+	d.partitionCuts = d.partitionCuts.RemoveNode(id)
+	delete(d.nodeLossModels, id)
+	for link := range d.linkLossModels {
+		if link.src == id || link.dst == id {
+			delete(d.linkLossModels, link)
+		}
+	}
+}
+
+// ReplaceNode replaces the node by a new Node with the same id, as needed when the OT process of the node
+// is restarted. Settings made for the node id, such as partition cuts, loss models, watch level and failure
+// model, are kept, as are its frame statistics.
+func (d *Dispatcher) ReplaceNode(id NodeId, cfg *NodeConfig) *Node {
+	oldNode := d.nodes[id]
+	logger.AssertNotNil(oldNode)
+	_, isWatched := d.watchingNodes[id]
+	watchLevel := oldNode.logger.CurrentLevel
+
+	d.removeNode(id)
+	node := d.AddNode(id, cfg)
+	node.failureCtrl.SetFailureModel(oldNode.failureCtrl.model, oldNode.failureCtrl.kind)
+	if isWatched {
+		d.WatchNode(id, watchLevel)
+	}
+	return node
+}
+
+func (d *Dispatcher) removeNode(id NodeId) {
 	node := d.nodes[id]
 	logger.AssertNotNil(node)
 
@@ -1278,10 +1568,21 @@ func (d *Dispatcher) DeleteNode(id NodeId) {
 	}
 	d.alarmMgr.DeleteNode(id)
 	d.deletedNodes[id] = struct{}{}
+	if node.host != nil {
+		delete(d.aliveNodes, hostId(id))
+		d.alarmMgr.DeleteNode(hostId(id))
+		d.deletedNodes[hostId(id)] = struct{}{}
+		d.rcpConns.remove(id)
+	}
 	d.energyAnalyser.DeleteNode(id)
 	d.vis.DeleteNode(id)
 	d.radioModel.DeleteNode(id)
 	d.eventQueue.DisableEventsForNode(id)
+	for link := range d.lostFrames {
+		if link.src == id || link.dst == id {
+			delete(d.lostFrames, link)
+		}
+	}
 }
 
 // SetNodeFailed sets the radio of the node to failed (true) or operational (false) state.
@@ -1290,8 +1591,9 @@ func (d *Dispatcher) SetNodeFailed(id NodeId, fail bool) {
 	node := d.nodes[id]
 	logger.AssertNotNil(node)
 
-	// if radio is set to on/off explicitly, failureCtrl should not be used anymore
-	node.SetFailTime(NonFailTime)
+	// if radio is set to on/off explicitly, failureCtrl should not be used anymore. This also restarts the
+	// node process if a reboot-type failure stopped it.
+	node.SetFailureModel(nil, FailureKindRadio)
 
 	if fail {
 		node.Fail()
@@ -1326,17 +1628,165 @@ func (d *Dispatcher) GetSpeed() float64 {
 	return d.speed
 }
 
+// GetAchievedSpeed gets the speed that the simulation actually achieved, as simulated time divided by real time,
+// since the start of the current speed setting or 'go' period.
+func (d *Dispatcher) GetAchievedSpeed() float64 {
+	elapsedTime := int64(d.CurTime - d.speedStartTime)
+	elapsedRealTime := time.Since(d.speedStartRealTime) / time.Microsecond
+	if elapsedRealTime > 0 {
+		return float64(elapsedTime) / float64(elapsedRealTime)
+	}
+	return MaxSimulateSpeed
+}
+
+// GetQueueLengths gets the current lengths of the Dispatcher's internal queues.
+func (d *Dispatcher) GetQueueLengths() QueueLengths {
+	return QueueLengths{
+		SendQueue: d.eventQueue.Len(),
+		Alarms:    d.alarmMgr.PendingCount(),
+		Received:  len(d.eventChan),
+		Tasks:     len(d.taskChan),
+	}
+}
+
+// GetGlobalMessageDropRatio gets the loss ratio of the global loss model, if it is a uniform loss model,
+// or 0 otherwise.
 func (d *Dispatcher) GetGlobalMessageDropRatio() float64 {
-	return d.globalPacketLossRatio
+	if m, ok := d.lossModel.(*uniformLossModel); ok {
+		return m.ratio
+	}
+	return 0
 }
 
+// SetGlobalPacketLossRatio sets a global uniform loss model with the given loss ratio.
 func (d *Dispatcher) SetGlobalPacketLossRatio(plr float64) {
-	if plr > 1 {
-		plr = 1
-	} else if plr < 0 {
-		plr = 0
+	d.SetLossModel(NewUniformLossModel(plr))
+}
+
+// GetLossModel gets the global loss model, or nil if none is set.
+func (d *Dispatcher) GetLossModel() LossModel {
+	return d.lossModel
+}
+
+// SetLossModel sets the global loss model, that applies to all links without a node or link specific
+// loss model. Use nil to remove it.
+func (d *Dispatcher) SetLossModel(model LossModel) {
+	d.lossModel = model
+}
+
+// GetNodeLossModel gets the loss model of a node, or nil if none is set.
+func (d *Dispatcher) GetNodeLossModel(id NodeId) LossModel {
+	return d.nodeLossModels[id]
+}
+
+// SetNodeLossModel sets the loss model for all frames sent or received by a node. Use nil to remove it.
+func (d *Dispatcher) SetNodeLossModel(id NodeId, model LossModel) {
+	if model == nil {
+		delete(d.nodeLossModels, id)
+	} else {
+		d.nodeLossModels[id] = model
 	}
-	d.globalPacketLossRatio = plr
+}
+
+// GetLinkLossModel gets the loss model of the directed link src -> dst, or nil if none is set.
+func (d *Dispatcher) GetLinkLossModel(src NodeId, dst NodeId) LossModel {
+	return d.linkLossModels[linkId{src, dst}]
+}
+
+// SetLinkLossModel sets the loss model for the directed link src -> dst. Use nil to remove it.
+func (d *Dispatcher) SetLinkLossModel(src NodeId, dst NodeId, model LossModel) {
+	if model == nil {
+		delete(d.linkLossModels, linkId{src, dst})
+	} else {
+		d.linkLossModels[linkId{src, dst}] = model
+	}
+}
+
+// getLossModel gets the most specific loss model for the link src -> dst: a link model, then a model
+// of dst, then a model of src, then the global model.
+func (d *Dispatcher) getLossModel(src NodeId, dst NodeId) LossModel {
+	if m, ok := d.linkLossModels[linkId{src, dst}]; ok {
+		return m
+	}
+	if m, ok := d.nodeLossModels[dst]; ok {
+		return m
+	}
+	if m, ok := d.nodeLossModels[src]; ok {
+		return m
+	}
+	return d.lossModel
+}
+
+// isFrameLost applies the loss model to a frame dispatch. The loss decision is made at the start of
+// the frame (EventTypeRadioCommStart) and remembered, so that the end of the frame (EventTypeRadioRxDone)
+// is lost as well.
+func (d *Dispatcher) isFrameLost(evt *Event, srcnode *Node, dstnode *Node) bool {
+	link := linkId{srcnode.Id, dstnode.Id}
+	if evt.Type == EventTypeRadioRxDone {
+		_, isLost := d.lostFrames[link]
+		delete(d.lostFrames, link)
+		return isLost
+	}
+
+	model := d.getLossModel(srcnode.Id, dstnode.Id)
+	if model != nil && model.IsLost(srcnode.Id, dstnode.Id, d.CurTime, len(evt.Data)) {
+		d.lostFrames[link] = struct{}{}
+		return true
+	}
+	delete(d.lostFrames, link)
+	return false
+}
+
+// CutPartition blocks all radio frames between the nodes of groupA and the nodes of groupB, regardless
+// of distance, until HealPartitions is called.
+func (d *Dispatcher) CutPartition(groupA []NodeId, groupB []NodeId) error {
+	pc, err := newPartitionCut(groupA, groupB)
+	if err != nil {
+		return err
+	}
+	d.partitionCuts = append(d.partitionCuts, pc)
+	return nil
+}
+
+// HealPartitions removes all partition cuts.
+func (d *Dispatcher) HealPartitions() {
+	d.partitionCuts = nil
+}
+
+func (d *Dispatcher) GetPartitionCuts() []PartitionCut {
+	cuts := make([]PartitionCut, 0, len(d.partitionCuts))
+	for _, pc := range d.partitionCuts {
+		cuts = append(cuts, pc.export())
+	}
+	return cuts
+}
+
+// Subscribe registers an EventSubscriber to be notified of network events, and returns its id for use with
+// Unsubscribe. Subscribers are notified in order of registration. It may be called from any goroutine.
+func (d *Dispatcher) Subscribe(sub EventSubscriber) SubscriptionId {
+	return d.subscribers.add(sub)
+}
+
+// Unsubscribe removes a registered EventSubscriber. It returns false if the id was not registered. It may be
+// called from any goroutine, including from a subscriber method.
+func (d *Dispatcher) Unsubscribe(id SubscriptionId) bool {
+	return d.subscribers.remove(id)
+}
+
+// GetNodeFrameStats gets the frame statistics of a node.
+func (d *Dispatcher) GetNodeFrameStats(id NodeId) NodeFrameStats {
+	return d.frameStats.getNode(id)
+}
+
+// GetLinkFrameStats gets the frame statistics of all directed links that carried, or dropped, frames,
+// sorted by source and destination node id.
+func (d *Dispatcher) GetLinkFrameStats() []LinkFrameStats {
+	return d.frameStats.getLinks()
+}
+
+// ResetFrameStats clears all node and link frame statistics.
+func (d *Dispatcher) ResetFrameStats() {
+	d.frameStats = newFrameStats()
 }
 
 func (d *Dispatcher) convertNodeMilliTime(node *Node, milliTime uint32) uint64 {
@@ -1376,69 +1826,116 @@ func (d *Dispatcher) SetVisualizationOptions(opts VisualizationOptions) {
 
 func (d *Dispatcher) NotifyCommand(nodeid NodeId) {
 	d.setAlive(nodeid)
+	if node := d.nodes[nodeid]; node != nil && node.host != nil && node.host.conn != nil {
+		d.setAlive(hostId(nodeid))
+	}
 }
 
-func (d *Dispatcher) dumpPacket(item *Event) {
+func (d *Dispatcher) dumpPacket(srcNode *Node, item *Event) {
 	sb := strings.Builder{}
 	_, _ = fmt.Fprintf(&sb, "DUMP:PACKET:%d:%d:", item.Timestamp, item.NodeId)
 	for _, b := range item.Data {
 		_, _ = fmt.Fprintf(&sb, "%02X", b)
 	}
+	_, _ = fmt.Fprintf(&sb, ":%s", d.decrypter.Dissect(item.Data, srcNode.ExtAddr))
 
 	logger.Println(sb.String())
 }
 
 func (d *Dispatcher) setNodeRole(node *Node, role OtDeviceRole) {
+	oldRole := node.Role
 	node.Role = role
 	d.vis.SetNodeRole(node.Id, role)
+	if oldRole != role {
+		d.subscribers.notify(func(sub EventSubscriber) { sub.OnNodeRoleChanged(node.Id, oldRole, role) })
+	}
+}
+
+func (d *Dispatcher) setNodePartitionId(node *Node, parid uint32) {
+	oldParid := node.PartitionId
+	node.PartitionId = parid
+	d.vis.SetNodePartitionId(node.Id, parid)
+	if oldParid != parid {
+		d.subscribers.notify(func(sub EventSubscriber) { sub.OnNodePartitionChanged(node.Id, oldParid, parid) })
+	}
 }
 
-func (d *Dispatcher) handleCoapEvent(node *Node, argsStr string) {
+func (d *Dispatcher) handleCoapEvent(node *Node, argsStr string) error {
 	var err error
 
-	if d.coaps == nil {
-		// Coaps not enabled
-		return
+	if d.coaps == nil && d.subscribers.isEmpty() {
+		// Coaps not enabled, and no subscribers
+		return nil
 	}
 
 	args := strings.Split(argsStr, ",")
-	logger.AssertTrue(len(args) > 0)
 	action := args[0]
 
 	if action == "send" || action == "recv" || action == "send_error" {
 		var messageId, coapType, coapCode, port int
 
-		logger.AssertTrue(len(args) >= 7)
+		if len(args) < 7 {
+			return fmt.Errorf("expected 7 arguments, got %d", len(args))
+		}
 
 		messageId, err = strconv.Atoi(args[1])
-		logger.PanicIfError(err)
+		if err != nil {
+			return err
+		}
 
 		coapType, err = strconv.Atoi(args[2])
-		logger.PanicIfError(err)
+		if err != nil {
+			return err
+		}
 
 		coapCode, err = strconv.Atoi(args[3])
-		logger.PanicIfError(err)
+		if err != nil {
+			return err
+		}
 
 		uri := args[4]
 
 		ip := args[5]
 
 		port, err = strconv.Atoi(args[6])
-		logger.PanicIfError(err)
+		if err != nil {
+			return err
+		}
+
+		// the error, if any, follows the port.
+		threadError := ""
+		if action == "send_error" && len(args) > 7 {
+			threadError = args[7]
+		}
 
+		coapEvt := &CoapEvent{
+			Timestamp: d.CurTime,
+			Node:      node.Id,
+			Action:    action,
+			ID:        messageId,
+			Type:      CoapType(coapType),
+			Code:      CoapCode(coapCode),
+			URI:       uri,
+			PeerAddr:  ip,
+			PeerPort:  port,
+			Error:     threadError,
+		}
+		d.subscribers.notify(func(sub EventSubscriber) { sub.OnCoapMessage(coapEvt) })
+
+		if d.coaps == nil {
+			return nil
+		}
 		if action == "send" {
 			d.coaps.OnSend(d.CurTime, node.Id, messageId, CoapType(coapType), CoapCode(coapCode), uri, ip, port)
 		} else if action == "recv" {
 			d.coaps.OnRecv(d.CurTime, node.Id, messageId, CoapType(coapType), CoapCode(coapCode), uri, ip, port)
 		} else {
-			logger.AssertTrue(len(args) >= 7)
-			threadError := args[6]
-
 			d.coaps.OnSendError(node.Id, messageId, CoapType(coapType), CoapCode(coapCode), uri, ip, port, threadError)
 		}
 	} else {
 		logger.Warnf("unknown coap event: %+v", args)
 	}
+	return nil
 }
 
 func (d *Dispatcher) EnableCoaps() {
@@ -0,0 +1,55 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostAsyncAt(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+	d.cbHandler = mockDispatcherCallback{}
+	d.eventQueue = newSendQueue()
+	d.taskChan = make(chan func(), 10)
+	d.pauseTime = Ever
+
+	// the next node event comes well after the task time, e.g. the restart time of a crashed node.
+	d.alarmMgr.SetTimestamp(node.Id, 100000000)
+	var taskTime uint64
+	d.PostAsyncAt(2000000, func() {
+		taskTime = d.CurTime
+	})
+
+	assert.True(t, d.processNextEvent(MaxSimulateSpeed))
+	d.handleTasks()
+	assert.Equal(t, uint64(2000000), taskTime)
+	assert.Equal(t, uint64(2000000), d.CurTime)
+	assert.Equal(t, 0, len(d.timedTasks))
+	assert.Equal(t, uint64(100000000), d.alarmMgr.NextTimestamp())
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"github.com/openthread/ot-ns/dissectpkt"
+	. "github.com/openthread/ot-ns/types"
+)
+
+const DefaultFrameHistorySize = 1000
+
+// FrameRecord is a frame in the frame history, with its delivery outcome per receiver.
+type FrameRecord struct {
+	Timestamp uint64         `json:"timestamp"` // the time the transmission ended, in us.
+	Channel   ChannelId      `json:"channel"`
+	Src       NodeId         `json:"src"`
+	Dst       NodeId         `json:"dst"` // the addressed node; BroadcastNodeId if broadcast, InvalidNodeId if unknown.
+	Type      string         `json:"type"`
+	Info      string         `json:"info"` // short description of the dissected frame.
+	Seq       uint8          `json:"seq"`
+	Length    int            `json:"length"` // the PSDU length in bytes.
+	PowerDbm  int8           `json:"power_dbm"`
+	Receivers []FrameOutcome `json:"receivers"`
+
+	data       []byte // the frame, if not dissected yet.
+	srcExtAddr uint64
+}
+
+// dissect sets the Type and Info of the frame, from a single dissection. This is done lazily, when the frame
+// is queried, so that frames are not decrypted while dispatching. A frame that can't be decrypted yet is
+// dissected again on the next query, because the network key may become known later.
+func (fr *FrameRecord) dissect(dc *dissectpkt.Decrypter) {
+	if fr.data == nil {
+		return
+	}
+	pktinfo := dc.Dissect(fr.data, fr.srcExtAddr)
+	fr.Type = pktinfo.Type().String()
+	fr.Info = pktinfo.String()
+	if !pktinfo.MacFrame.IsEncrypted() || pktinfo.Decrypted {
+		fr.data = nil
+	}
+}
+
+// FrameOutcome is the delivery outcome of a frame at one receiver node.
+type FrameOutcome struct {
+	Node      NodeId `json:"node"`
+	Delivered bool   `json:"delivered"`
+	Reason    string `json:"reason,omitempty"` // the FrameDropReason, if not delivered.
+	RssiDbm   int8   `json:"rssi_dbm,omitempty"`
+}
+
+// hasReceiver returns true if the frame is addressed to node nodeid, or was dispatched to it.
+func (fr *FrameRecord) hasReceiver(nodeid NodeId) bool {
+	if fr.Dst == nodeid {
+		return true
+	}
+	for _, r := range fr.Receivers {
+		if r.Node == nodeid {
+			return true
+		}
+	}
+	return false
+}
+
+// FrameFilter selects frames from the frame history. Zero values match any frame.
+type FrameFilter struct {
+	Src     NodeId
+	Dst     NodeId // matches the addressed node as well as any receiver.
+	Type    *dissectpkt.WpanFrameType
+	Channel ChannelId
+	From    uint64 // minimum timestamp
+	To      uint64 // maximum timestamp; 0 for no maximum.
+}
+
+func (ff *FrameFilter) matches(fr *FrameRecord) bool {
+	return (ff.Src == 0 || fr.Src == ff.Src) &&
+		(ff.Dst == 0 || fr.hasReceiver(ff.Dst)) &&
+		(ff.Type == nil || fr.Type == ff.Type.String()) &&
+		(ff.Channel == 0 || fr.Channel == ff.Channel) &&
+		fr.Timestamp >= ff.From &&
+		(ff.To == 0 || fr.Timestamp <= ff.To)
+}
+
+// frameHistory is a ring buffer of the most recently dispatched frames.
+type frameHistory struct {
+	records []*FrameRecord
+	next    int
+	count   int
+	current *FrameRecord // the frame being dispatched, if any.
+}
+
+func newFrameHistory(size int) *frameHistory {
+	return &frameHistory{
+		records: make([]*FrameRecord, size),
+	}
+}
+
+// begin starts recording the dispatch of frame fr, which is added to the history.
+func (fh *frameHistory) begin(fr *FrameRecord) {
+	if len(fh.records) == 0 {
+		return
+	}
+	fh.records[fh.next] = fr
+	fh.next = (fh.next + 1) % len(fh.records)
+	if fh.count < len(fh.records) {
+		fh.count++
+	}
+	fh.current = fr
+}
+
+// end ends recording the dispatch of the current frame.
+func (fh *frameHistory) end() {
+	fh.current = nil
+}
+
+func (fh *frameHistory) onDelivered(dst NodeId, rssi int8) {
+	if fh.current != nil {
+		fh.current.Receivers = append(fh.current.Receivers, FrameOutcome{Node: dst, Delivered: true, RssiDbm: rssi})
+	}
+}
+
+func (fh *frameHistory) onDropped(dst NodeId, reason FrameDropReason) {
+	if fh.current != nil {
+		fh.current.Receivers = append(fh.current.Receivers, FrameOutcome{Node: dst, Reason: reason.String()})
+	}
+}
+
+// query returns the last (at most) max frames that match the filter, oldest first. If max is 0, all matching
+// frames are returned. Frames not dissected yet are dissected using dc.
+func (fh *frameHistory) query(filter *FrameFilter, max int, dc *dissectpkt.Decrypter) []*FrameRecord {
+	var result []*FrameRecord
+	size := len(fh.records)
+	for i := 0; i < fh.count && (max == 0 || len(result) < max); i++ {
+		fr := fh.records[(fh.next-1-i+size)%size]
+		fr.dissect(dc)
+		if filter.matches(fr) {
+			result = append(result, fr)
+		}
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+func (fh *frameHistory) clear() {
+	for i := range fh.records {
+		fh.records[i] = nil
+	}
+	fh.next, fh.count, fh.current = 0, 0, nil
+}
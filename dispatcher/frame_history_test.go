@@ -0,0 +1,106 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/dissectpkt"
+	. "github.com/openthread/ot-ns/types"
+)
+
+func TestFrameHistory(t *testing.T) {
+	fh := newFrameHistory(4)
+	for i := 1; i <= 6; i++ {
+		fh.begin(&FrameRecord{Timestamp: uint64(i * 1000), Src: 1 + i%2, Dst: BroadcastNodeId, Type: "MLE", Channel: 11})
+		fh.onDelivered(3, -60)
+		fh.onDropped(4, DropOutOfRange)
+		fh.end()
+	}
+	fh.onDelivered(3, -60) // outside a dispatch: ignored.
+
+	// only the last 4 frames are kept.
+	frames := fh.query(&FrameFilter{}, 0, nil)
+	assert.Equal(t, 4, len(frames))
+	assert.Equal(t, uint64(3000), frames[0].Timestamp)
+	assert.Equal(t, uint64(6000), frames[3].Timestamp)
+	assert.Equal(t, []FrameOutcome{{Node: 3, Delivered: true, RssiDbm: -60}, {Node: 4, Reason: "out_of_range"}},
+		frames[3].Receivers)
+
+	frames = fh.query(&FrameFilter{}, 2, nil)
+	assert.Equal(t, 2, len(frames))
+	assert.Equal(t, uint64(5000), frames[0].Timestamp)
+
+	frames = fh.query(&FrameFilter{Src: 2}, 0, nil)
+	assert.Equal(t, 2, len(frames))
+	assert.Equal(t, uint64(3000), frames[0].Timestamp)
+	assert.Equal(t, uint64(5000), frames[1].Timestamp)
+
+	assert.Equal(t, 4, len(fh.query(&FrameFilter{Dst: 4}, 0, nil)))
+	assert.Equal(t, 0, len(fh.query(&FrameFilter{Dst: 5}, 0, nil)))
+	assert.Equal(t, 0, len(fh.query(&FrameFilter{Channel: 12}, 0, nil)))
+	assert.Equal(t, 2, len(fh.query(&FrameFilter{From: 4000, To: 5000}, 0, nil)))
+	mle, mac := dissectpkt.MLE, dissectpkt.MAC
+	assert.Equal(t, 4, len(fh.query(&FrameFilter{Type: &mle}, 0, nil)))
+	assert.Equal(t, 0, len(fh.query(&FrameFilter{Type: &mac}, 0, nil)))
+
+	fh.clear()
+	assert.Equal(t, 0, len(fh.query(&FrameFilter{}, 0, nil)))
+
+	fh = newFrameHistory(0)
+	fh.begin(&FrameRecord{})
+	fh.end()
+	assert.Equal(t, 0, len(fh.query(&FrameFilter{}, 0, nil)))
+}
+
+func TestFrameHistoryDissect(t *testing.T) {
+	// MLE Discovery Request, broadcast, from extaddr 1122334455667788.
+	data, err := hex.DecodeString("0b" + "41d801cefaffff8877665544332211" + "7f3b02" + "f04d4c4d4c1234" + "ff10" + "0000")
+	assert.Nil(t, err)
+
+	fh := newFrameHistory(4)
+	fh.begin(&FrameRecord{Src: 1, Dst: BroadcastNodeId, data: data, srcExtAddr: 0x1122334455667788})
+	fh.end()
+	fh.begin(&FrameRecord{Src: 2, Dst: 1, data: []byte{0x0b, 0x02, 0x00, 0x07, 0x00, 0x00}}) // ACK
+	fh.end()
+
+	// type and info are only known once queried, and are dissected from the same frame.
+	mle := dissectpkt.MLE
+	frames := fh.query(&FrameFilter{Type: &mle}, 0, dissectpkt.NewDecrypter())
+	assert.Equal(t, 1, len(frames))
+	assert.Equal(t, "MLE", frames[0].Type)
+	assert.Contains(t, frames[0].Info, "Discovery Request")
+	assert.Nil(t, frames[0].data)
+
+	frames = fh.query(&FrameFilter{}, 0, dissectpkt.NewDecrypter())
+	assert.Equal(t, 2, len(frames))
+	assert.Equal(t, "ACK", frames[1].Type)
+	assert.Equal(t, "ACK", frames[1].Info)
+}
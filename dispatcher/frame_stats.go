@@ -0,0 +1,178 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"sort"
+
+	"github.com/openthread/ot-ns/dissectpkt"
+	. "github.com/openthread/ot-ns/types"
+)
+
+// FrameDropReason is the reason why a radio frame was not delivered to a destination node.
+type FrameDropReason int
+
+const (
+	DropSrcFailed    FrameDropReason = iota // the radio of the source node was failed
+	DropDstFailed                           // the radio of the destination node was failed
+	DropPartitionCut                        // a partition cut separates source and destination
+	DropLossModel                           // the packet loss model (global, node or link) dropped the frame
+	DropRadioModel                          // the radio model rejected the frame, or the receiver wasn't listening
+	DropInterference                        // the frame was received with an FCS error due to interference
+	DropOutOfRange                          // the addressed destination was out of radio range
+	NumFrameDropReasons
+)
+
+var frameDropReasonNames = [NumFrameDropReasons]string{
+	"src_failed", "dst_failed", "cut", "loss", "radio", "interference", "out_of_range",
+}
+
+func (r FrameDropReason) String() string {
+	if r < 0 || r >= NumFrameDropReasons {
+		return "unknown"
+	}
+	return frameDropReasonNames[r]
+}
+
+// FrameStats counts frames delivered and frames not delivered, per drop reason.
+type FrameStats struct {
+	Delivered uint64
+	Dropped   [NumFrameDropReasons]uint64
+}
+
+// TotalDropped returns the number of frames not delivered, for any reason.
+func (fs *FrameStats) TotalDropped() uint64 {
+	total := uint64(0)
+	for _, n := range fs.Dropped {
+		total += n
+	}
+	return total
+}
+
+// NodeFrameStats holds the frame statistics of a single node. TxFrames counts the frames transmitted by
+// the node and TxFrameTypes these frames per dissected frame type; Tx counts the per-destination delivery
+// results of these frames and Rx the delivery results of frames addressed to, or in range of, the node.
+type NodeFrameStats struct {
+	TxFrames     uint64
+	TxFrameTypes [dissectpkt.NumWpanFrameTypes]uint64
+	Tx           FrameStats
+	Rx           FrameStats
+}
+
+// LinkFrameStats holds the frame statistics of the directed link Src -> Dst.
+type LinkFrameStats struct {
+	Src NodeId
+	Dst NodeId
+	FrameStats
+}
+
+type frameStats struct {
+	nodes map[NodeId]*NodeFrameStats
+	links map[linkId]*FrameStats
+}
+
+func newFrameStats() *frameStats {
+	return &frameStats{
+		nodes: map[NodeId]*NodeFrameStats{},
+		links: map[linkId]*FrameStats{},
+	}
+}
+
+func (fs *frameStats) node(id NodeId) *NodeFrameStats {
+	ns := fs.nodes[id]
+	if ns == nil {
+		ns = &NodeFrameStats{}
+		fs.nodes[id] = ns
+	}
+	return ns
+}
+
+func (fs *frameStats) link(src NodeId, dst NodeId) *FrameStats {
+	link := linkId{src, dst}
+	ls := fs.links[link]
+	if ls == nil {
+		ls = &FrameStats{}
+		fs.links[link] = ls
+	}
+	return ls
+}
+
+// onTx counts a frame of type frameType transmitted by src.
+func (fs *frameStats) onTx(src NodeId, frameType dissectpkt.WpanFrameType) {
+	ns := fs.node(src)
+	ns.TxFrames++
+	ns.TxFrameTypes[frameType]++
+}
+
+// onSrcFailed counts a frame that src attempted to transmit while its radio was failed.
+func (fs *frameStats) onSrcFailed(src NodeId) {
+	fs.node(src).Tx.Dropped[DropSrcFailed]++
+}
+
+// onDelivered counts a frame delivered on the link src -> dst.
+func (fs *frameStats) onDelivered(src NodeId, dst NodeId) {
+	fs.node(src).Tx.Delivered++
+	fs.node(dst).Rx.Delivered++
+	fs.link(src, dst).Delivered++
+}
+
+// onDropped counts a frame not delivered on the link src -> dst.
+func (fs *frameStats) onDropped(src NodeId, dst NodeId, reason FrameDropReason) {
+	fs.node(src).Tx.Dropped[reason]++
+	fs.node(dst).Rx.Dropped[reason]++
+	fs.link(src, dst).Dropped[reason]++
+}
+
+func (fs *frameStats) getNode(id NodeId) NodeFrameStats {
+	if ns, ok := fs.nodes[id]; ok {
+		return *ns
+	}
+	return NodeFrameStats{}
+}
+
+func (fs *frameStats) getLinks() []LinkFrameStats {
+	links := make([]LinkFrameStats, 0, len(fs.links))
+	for link, ls := range fs.links {
+		links = append(links, LinkFrameStats{Src: link.src, Dst: link.dst, FrameStats: *ls})
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].Src != links[j].Src {
+			return links[i].Src < links[j].Src
+		}
+		return links[i].Dst < links[j].Dst
+	})
+	return links
+}
+
+func (fs *frameStats) removeNode(id NodeId) {
+	delete(fs.nodes, id)
+	for link := range fs.links {
+		if link.src == id || link.dst == id {
+			delete(fs.links, link)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/dissectpkt"
+)
+
+func TestFrameStats(t *testing.T) {
+	fs := newFrameStats()
+	fs.onTx(1, dissectpkt.MLE)
+	fs.onDelivered(1, 2)
+	fs.onDropped(1, 3, DropLossModel)
+	fs.onTx(2, dissectpkt.MAC)
+	fs.onDropped(2, 1, DropInterference)
+	fs.onSrcFailed(3)
+
+	ns := fs.getNode(1)
+	assert.Equal(t, uint64(1), ns.TxFrames)
+	assert.Equal(t, uint64(1), ns.TxFrameTypes[dissectpkt.MLE])
+	assert.Equal(t, uint64(0), ns.TxFrameTypes[dissectpkt.MAC])
+	assert.Equal(t, uint64(1), ns.Tx.Delivered)
+	assert.Equal(t, uint64(1), ns.Tx.Dropped[DropLossModel])
+	assert.Equal(t, uint64(1), ns.Tx.TotalDropped())
+	assert.Equal(t, uint64(1), ns.Rx.Dropped[DropInterference])
+
+	ns = fs.getNode(3)
+	assert.Equal(t, uint64(0), ns.TxFrames)
+	assert.Equal(t, uint64(1), ns.Tx.Dropped[DropSrcFailed])
+	assert.Equal(t, uint64(1), ns.Rx.Dropped[DropLossModel])
+	assert.Equal(t, NodeFrameStats{}, fs.getNode(4))
+
+	links := fs.getLinks()
+	assert.Equal(t, 3, len(links))
+	assert.Equal(t, LinkFrameStats{Src: 1, Dst: 2, FrameStats: FrameStats{Delivered: 1}}, links[0])
+	assert.Equal(t, 3, links[1].Dst)
+	assert.Equal(t, 2, links[2].Src)
+
+	fs.removeNode(1)
+	assert.Equal(t, NodeFrameStats{}, fs.getNode(1))
+	assert.Equal(t, 0, len(fs.getLinks()))
+	assert.Equal(t, uint64(1), fs.getNode(2).Rx.Delivered)
+}
+
+func TestFrameDropReason_String(t *testing.T) {
+	assert.Equal(t, "src_failed", DropSrcFailed.String())
+	assert.Equal(t, "out_of_range", DropOutOfRange.String())
+	assert.Equal(t, "unknown", NumFrameDropReasons.String())
+}
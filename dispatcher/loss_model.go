@@ -0,0 +1,153 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	. "github.com/openthread/ot-ns/types"
+)
+
+// LossModel is a model of random frame loss that is applied by the Dispatcher on top of the radio model.
+// The model decides, once per frame and receiver, whether the frame is lost.
+type LossModel interface {
+	// IsLost returns true if the frame of datalen bytes, sent by src at time ts (us), is lost for dst.
+	IsLost(src NodeId, dst NodeId, ts uint64, datalen int) bool
+
+	// String returns the model and its parameters, in the format used by the 'plr' CLI command.
+	String() string
+}
+
+type linkId struct {
+	src NodeId
+	dst NodeId
+}
+
+// uniformLossModel drops frames independently, with a probability that scales with the frame size.
+// The ratio is the loss probability of a 128-byte frame.
+type uniformLossModel struct {
+	ratio float64
+}
+
+// NewUniformLossModel creates a model where each frame is lost independently with a probability
+// that scales with the frame size. The ratio (0 ~ 1.0) is the loss probability of a 128-byte frame.
+func NewUniformLossModel(ratio float64) LossModel {
+	if ratio > 1 {
+		ratio = 1
+	} else if ratio < 0 {
+		ratio = 0
+	}
+	return &uniformLossModel{ratio: ratio}
+}
+
+func (m *uniformLossModel) IsLost(src NodeId, dst NodeId, ts uint64, datalen int) bool {
+	if m.ratio <= 0 {
+		return false
+	}
+	succRate := math.Pow(1.0-m.ratio, float64(datalen)/128.0)
+	return rand.Float64() >= succRate
+}
+
+func (m *uniformLossModel) String() string {
+	return fmt.Sprintf("%v", m.ratio)
+}
+
+// gilbertElliottLossModel is a two-state Markov model of bursty loss. Each directed link has its own
+// Good/Bad state, which makes one transition per frame sent over the link.
+type gilbertElliottLossModel struct {
+	pGoodBad float64 // probability of a Good -> Bad transition
+	pBadGood float64 // probability of a Bad -> Good transition
+	lossGood float64 // frame loss probability in Good state
+	lossBad  float64 // frame loss probability in Bad state
+	isBad    map[linkId]bool
+}
+
+// NewGilbertElliottLossModel creates a Gilbert-Elliott bursty loss model. All parameters are
+// probabilities in the range 0 ~ 1.0.
+func NewGilbertElliottLossModel(pGoodBad, pBadGood, lossGood, lossBad float64) (LossModel, error) {
+	for _, p := range []float64{pGoodBad, pBadGood, lossGood, lossBad} {
+		if p < 0 || p > 1 {
+			return nil, fmt.Errorf("Gilbert-Elliott model parameter out of range (0 - 1): %v", p)
+		}
+	}
+	return &gilbertElliottLossModel{
+		pGoodBad: pGoodBad,
+		pBadGood: pBadGood,
+		lossGood: lossGood,
+		lossBad:  lossBad,
+		isBad:    map[linkId]bool{},
+	}, nil
+}
+
+func (m *gilbertElliottLossModel) IsLost(src NodeId, dst NodeId, ts uint64, datalen int) bool {
+	link := linkId{src, dst}
+	isBad := m.isBad[link]
+	if isBad && rand.Float64() < m.pBadGood {
+		isBad = false
+	} else if !isBad && rand.Float64() < m.pGoodBad {
+		isBad = true
+	}
+	m.isBad[link] = isBad
+
+	if isBad {
+		return rand.Float64() < m.lossBad
+	}
+	return rand.Float64() < m.lossGood
+}
+
+func (m *gilbertElliottLossModel) String() string {
+	return fmt.Sprintf("ge %v %v %v %v", m.pGoodBad, m.pBadGood, m.lossGood, m.lossBad)
+}
+
+// periodicOutageLossModel drops all frames during an outage window of a fixed duration, that
+// repeats with a fixed period. The first outage starts at time 0.
+type periodicOutageLossModel struct {
+	period   uint64 // unit: us
+	duration uint64 // unit: us
+}
+
+// NewPeriodicOutageLossModel creates a model where all frames are lost during an outage of duration us,
+// repeating every period us.
+func NewPeriodicOutageLossModel(period uint64, duration uint64) (LossModel, error) {
+	if period == 0 || duration > period {
+		return nil, fmt.Errorf("outage duration must be <= outage period, and period > 0")
+	}
+	return &periodicOutageLossModel{
+		period:   period,
+		duration: duration,
+	}, nil
+}
+
+func (m *periodicOutageLossModel) IsLost(src NodeId, dst NodeId, ts uint64, datalen int) bool {
+	return ts%m.period < m.duration
+}
+
+func (m *periodicOutageLossModel) String() string {
+	return fmt.Sprintf("outage %v %v", float64(m.period)/1e6, float64(m.duration)/1e6)
+}
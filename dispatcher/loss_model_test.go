@@ -0,0 +1,105 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/energy"
+	"github.com/openthread/ot-ns/radiomodel"
+	. "github.com/openthread/ot-ns/types"
+)
+
+func TestUniformLossModel(t *testing.T) {
+	assert.False(t, NewUniformLossModel(0).IsLost(1, 2, 0, 127))
+	assert.True(t, NewUniformLossModel(1).IsLost(1, 2, 0, 127))
+	assert.Equal(t, "0.5", NewUniformLossModel(0.5).String())
+	assert.Equal(t, "1", NewUniformLossModel(1.5).String())
+}
+
+func TestGilbertElliottLossModel(t *testing.T) {
+	_, err := NewGilbertElliottLossModel(0.1, 1.1, 0, 1)
+	assert.NotNil(t, err)
+
+	// always moves to Bad state, in which all frames are lost.
+	m, err := NewGilbertElliottLossModel(1, 0, 0, 1)
+	assert.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		assert.True(t, m.IsLost(1, 2, uint64(i), 127))
+	}
+	assert.Equal(t, "ge 1 0 0 1", m.String())
+
+	// stays in Good state, in which no frames are lost.
+	m, _ = NewGilbertElliottLossModel(0, 1, 0, 1)
+	for i := 0; i < 10; i++ {
+		assert.False(t, m.IsLost(1, 2, uint64(i), 127))
+	}
+}
+
+func TestGilbertElliottLossModel_PerLinkState(t *testing.T) {
+	m, _ := NewGilbertElliottLossModel(1, 1, 0, 1)
+
+	// each link toggles its own state per frame: Bad, Good, Bad, ...
+	assert.True(t, m.IsLost(1, 2, 0, 127))
+	assert.True(t, m.IsLost(2, 1, 0, 127))
+	assert.False(t, m.IsLost(1, 2, 0, 127))
+	assert.True(t, m.IsLost(1, 3, 0, 127))
+	assert.True(t, m.IsLost(1, 2, 0, 127))
+}
+
+func TestPeriodicOutageLossModel(t *testing.T) {
+	_, err := NewPeriodicOutageLossModel(10, 20)
+	assert.NotNil(t, err)
+
+	m, err := NewPeriodicOutageLossModel(10000000, 2000000)
+	assert.Nil(t, err)
+	assert.True(t, m.IsLost(1, 2, 0, 127))
+	assert.True(t, m.IsLost(1, 2, 1999999, 127))
+	assert.False(t, m.IsLost(1, 2, 2000000, 127))
+	assert.False(t, m.IsLost(1, 2, 9999999, 127))
+	assert.True(t, m.IsLost(1, 2, 10000000, 127))
+	assert.Equal(t, "outage 10 2", m.String())
+}
+
+func TestDeleteNodeClearsLostFrames(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+	d.deletedNodes = map[NodeId]struct{}{}
+	d.energyAnalyser = energy.NewEnergyAnalyser()
+	d.radioModel = radiomodel.NewRadioModel("Ideal")
+	d.eventQueue = newSendQueue()
+	d.frameStats = newFrameStats()
+	d.lostFrames = map[linkId]struct{}{
+		{node.Id, 2}: {},
+		{2, node.Id}: {},
+		{2, 3}:       {},
+	}
+
+	d.DeleteNode(node.Id)
+	assert.Equal(t, map[linkId]struct{}{{2, 3}: {}}, d.lostFrames)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"fmt"
+	"sort"
+
+	. "github.com/openthread/ot-ns/types"
+)
+
+// PartitionCut describes a forced network partition: all radio frames between any node of GroupA and any
+// node of GroupB are blocked, regardless of the distance between the nodes.
+type PartitionCut struct {
+	GroupA []NodeId
+	GroupB []NodeId
+}
+
+type nodeGroup map[NodeId]struct{}
+
+type partitionCut struct {
+	groupA nodeGroup
+	groupB nodeGroup
+}
+
+type partitionCuts []*partitionCut
+
+func newPartitionCut(groupA []NodeId, groupB []NodeId) (*partitionCut, error) {
+	if len(groupA) == 0 || len(groupB) == 0 {
+		return nil, fmt.Errorf("both node groups of a partition cut must be non-empty")
+	}
+
+	pc := &partitionCut{
+		groupA: nodeGroup{},
+		groupB: nodeGroup{},
+	}
+	for _, id := range groupA {
+		pc.groupA[id] = struct{}{}
+	}
+	for _, id := range groupB {
+		if _, ok := pc.groupA[id]; ok {
+			return nil, fmt.Errorf("node %d can't be in both node groups of a partition cut", id)
+		}
+		pc.groupB[id] = struct{}{}
+	}
+	return pc, nil
+}
+
+// isCut returns true if the link between src and dst (in either direction) is blocked by the cut.
+func (pc *partitionCut) isCut(src NodeId, dst NodeId) bool {
+	_, srcInA := pc.groupA[src]
+	_, srcInB := pc.groupB[src]
+	_, dstInA := pc.groupA[dst]
+	_, dstInB := pc.groupB[dst]
+	return (srcInA && dstInB) || (srcInB && dstInA)
+}
+
+func (pc *partitionCut) removeNode(id NodeId) {
+	delete(pc.groupA, id)
+	delete(pc.groupB, id)
+}
+
+func (pc *partitionCut) isEmpty() bool {
+	return len(pc.groupA) == 0 || len(pc.groupB) == 0
+}
+
+func (pc *partitionCut) export() PartitionCut {
+	return PartitionCut{
+		GroupA: pc.groupA.sortedIds(),
+		GroupB: pc.groupB.sortedIds(),
+	}
+}
+
+func (g nodeGroup) sortedIds() []NodeId {
+	ids := make([]NodeId, 0, len(g))
+	for id := range g {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// IsCut returns true if any of the partition cuts blocks the link between src and dst.
+func (cuts partitionCuts) IsCut(src NodeId, dst NodeId) bool {
+	for _, pc := range cuts {
+		if pc.isCut(src, dst) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveNode removes a (deleted) node from all cuts, dropping cuts that end up with an empty group.
+func (cuts partitionCuts) RemoveNode(id NodeId) partitionCuts {
+	var remaining partitionCuts
+	for _, pc := range cuts {
+		pc.removeNode(id)
+		if !pc.isEmpty() {
+			remaining = append(remaining, pc)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionCut_IsCut(t *testing.T) {
+	_, err := newPartitionCut([]int{1, 2}, []int{})
+	assert.NotNil(t, err)
+	_, err = newPartitionCut([]int{1, 2}, []int{2, 3})
+	assert.NotNil(t, err)
+
+	pc, err := newPartitionCut([]int{1, 2}, []int{3, 4})
+	assert.Nil(t, err)
+	cuts := partitionCuts{pc}
+
+	assert.True(t, cuts.IsCut(1, 3))
+	assert.True(t, cuts.IsCut(4, 2))
+	assert.False(t, cuts.IsCut(1, 2))
+	assert.False(t, cuts.IsCut(3, 4))
+	assert.False(t, cuts.IsCut(1, 5))
+	assert.False(t, cuts.IsCut(5, 4))
+}
+
+func TestPartitionCut_RemoveNode(t *testing.T) {
+	pc1, _ := newPartitionCut([]int{1, 2}, []int{3})
+	pc2, _ := newPartitionCut([]int{4}, []int{5, 6})
+	cuts := partitionCuts{pc1, pc2}
+
+	cuts = cuts.RemoveNode(2)
+	assert.Equal(t, 2, len(cuts))
+	assert.Equal(t, PartitionCut{GroupA: []int{1}, GroupB: []int{3}}, cuts[0].export())
+
+	cuts = cuts.RemoveNode(3)
+	assert.Equal(t, 1, len(cuts))
+	assert.False(t, cuts.IsCut(1, 3))
+	assert.True(t, cuts.IsCut(6, 4))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"github.com/openthread/ot-ns/dissectpkt"
+	. "github.com/openthread/ot-ns/types"
+)
+
+// PcapFilter selects the frames that are captured, in the pcap file and in live streams. Frames are captured
+// while the capture is on, and only if they are transmitted by one of Nodes and are not of one of ExcludeTypes.
+type PcapFilter struct {
+	Nodes        []NodeId                   // the transmitter nodes to capture; all if empty.
+	ExcludeTypes []dissectpkt.WpanFrameType // the frame types not to capture.
+	Off          bool                       // true if the capture is switched off.
+	OffTime      uint64                     // the time at which the capture switches off; Ever if not set.
+
+	Captured uint64 // number of frames captured.
+	Filtered uint64 // number of frames not captured, because of the filter or because the capture was off.
+}
+
+func newPcapFilter() *PcapFilter {
+	return &PcapFilter{
+		OffTime: Ever,
+	}
+}
+
+// SetCapturing switches the capture on or off. If on, duration is the capture window in us, after which the
+// capture switches off again; Ever to capture without time limit.
+func (pf *PcapFilter) SetCapturing(on bool, now uint64, duration uint64) {
+	pf.Off = !on
+	pf.OffTime = Ever
+	if on && duration < Ever {
+		pf.OffTime = now + duration
+	}
+}
+
+// accepts returns true if the frame of type frameType, transmitted by node nodeid at time ts, is captured.
+func (pf *PcapFilter) accepts(nodeid NodeId, frameType dissectpkt.WpanFrameType, ts uint64) bool {
+	if !pf.Off && ts >= pf.OffTime {
+		pf.Off, pf.OffTime = true, Ever
+	}
+	ok := !pf.Off && pf.acceptsNode(nodeid) && !pf.excludesType(frameType)
+	if ok {
+		pf.Captured++
+	} else {
+		pf.Filtered++
+	}
+	return ok
+}
+
+func (pf *PcapFilter) acceptsNode(nodeid NodeId) bool {
+	if len(pf.Nodes) == 0 {
+		return true
+	}
+	for _, id := range pf.Nodes {
+		if id == nodeid {
+			return true
+		}
+	}
+	return false
+}
+
+func (pf *PcapFilter) excludesType(frameType dissectpkt.WpanFrameType) bool {
+	for _, t := range pf.ExcludeTypes {
+		if t == frameType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/dissectpkt"
+	. "github.com/openthread/ot-ns/types"
+)
+
+func TestPcapFilter(t *testing.T) {
+	pf := newPcapFilter()
+	assert.True(t, pf.accepts(1, dissectpkt.ACK, 0))
+
+	pf.Nodes = []NodeId{1, 2}
+	pf.ExcludeTypes = []dissectpkt.WpanFrameType{dissectpkt.ACK}
+	assert.True(t, pf.accepts(2, dissectpkt.MLE, 0))
+	assert.False(t, pf.accepts(3, dissectpkt.MLE, 0))
+	assert.False(t, pf.accepts(1, dissectpkt.ACK, 0))
+
+	// capture window of 1s.
+	pf.SetCapturing(false, 100, Ever)
+	assert.False(t, pf.accepts(1, dissectpkt.MLE, 200))
+	pf.SetCapturing(true, 1000, 1000000)
+	assert.True(t, pf.accepts(1, dissectpkt.MLE, 1000))
+	assert.True(t, pf.accepts(1, dissectpkt.MLE, 1000999))
+	assert.False(t, pf.accepts(1, dissectpkt.MLE, 1001000))
+	assert.True(t, pf.Off)
+	assert.Equal(t, Ever, pf.OffTime)
+
+	assert.Equal(t, uint64(4), pf.Captured)
+	assert.Equal(t, uint64(4), pf.Filtered)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/openthread/ot-ns/event"
+	"github.com/openthread/ot-ns/logger"
+	. "github.com/openthread/ot-ns/types"
+)
+
+// rcpHost is the host process of an RCP node, e.g. an OpenThread POSIX ot-cli or ot-daemon, which runs the
+// Thread stack. The node's own process is the RCP, which runs the radio. The host and RCP exchange Spinel
+// frames through the dispatcher, in virtual time: RadioSpinelWrite events of the host are sent to the RCP as
+// UART data, and UART data of the RCP is sent to the host as RadioSpinelWrite events.
+//
+// The simulator tells the two processes apart by the order in which these connect, see rcpConnections. The host
+// process is tracked by the alarmMgr and aliveNodes under its own id, hostId(node.Id), so that the dispatcher
+// waits for both processes of the node to be asleep.
+type rcpHost struct {
+	conn    net.Conn
+	msgId   uint64
+	curTime uint64
+}
+
+// rcpConnections tells apart the connections of the two processes of RCP nodes. Both processes report the node
+// id in their first event, so the order of connecting is used: the simulation starts the RCP process first, and
+// starts the host process only after the RCP has connected (see WaitRcpConnected). The first connection of an RCP
+// node is then the RCP, and the next one is the host. The connection readers use it concurrently, hence the mutex.
+type rcpConnections struct {
+	mutex sync.Mutex
+	nodes map[NodeId]chan struct{} // per RCP node, a channel that is closed once the RCP process has connected.
+}
+
+// expect registers RCP node nodeid, before its processes are started.
+func (rc *rcpConnections) expect(nodeid NodeId) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	if rc.nodes == nil {
+		rc.nodes = map[NodeId]chan struct{}{}
+	}
+	rc.nodes[nodeid] = make(chan struct{})
+}
+
+func (rc *rcpConnections) remove(nodeid NodeId) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	delete(rc.nodes, nodeid)
+}
+
+// isHostConn is called for each new connection of node nodeid, and returns true if it is the connection of the
+// host process of an RCP node.
+func (rc *rcpConnections) isHostConn(nodeid NodeId) bool {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	rcpConnected, ok := rc.nodes[nodeid]
+	if !ok {
+		return false
+	}
+	select {
+	case <-rcpConnected:
+		return true
+	default:
+		close(rcpConnected)
+		return false
+	}
+}
+
+// rcpConnected returns the channel that is closed once the RCP process of RCP node nodeid has connected, or nil
+// if nodeid is not an RCP node.
+func (rc *rcpConnections) rcpConnected(nodeid NodeId) chan struct{} {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	return rc.nodes[nodeid]
+}
+
+// WaitRcpConnected waits until the RCP process of RCP node nodeid has connected, so that its host process can be
+// started.
+func (d *Dispatcher) WaitRcpConnected(nodeid NodeId) error {
+	rcpConnected := d.rcpConns.rcpConnected(nodeid)
+	if rcpConnected == nil {
+		return fmt.Errorf("node %d is not an RCP node", nodeid)
+	}
+	select {
+	case <-rcpConnected:
+		return nil
+	case <-d.ctx.Done():
+		return d.ctx.Err()
+	case <-time.After(DefaultReadTimeout):
+		return fmt.Errorf("RCP process of node %d did not connect", nodeid)
+	}
+}
+
+// hostId returns the id under which the host process of RCP node nodeid is tracked.
+func hostId(nodeid NodeId) NodeId {
+	return -nodeid
+}
+
+func isHostId(id NodeId) bool {
+	return id < 0
+}
+
+// handleHostEvent handles an event received from the host process of RCP node.
+func (d *Dispatcher) handleHostEvent(node *Node, evt *Event) {
+	host := node.host
+	if host == nil {
+		if evt.Type != EventTypeNodeDisconnected {
+			d.onProtocolError(node, fmt.Errorf("RCP host process connected, but node is not an RCP node"))
+			_ = evt.Conn.Close()
+		}
+		return
+	}
+	if node.quarantined && evt.Type != EventTypeNodeDisconnected {
+		return
+	}
+	if evt.ParseError != nil {
+		d.onProtocolError(node, evt.ParseError)
+		return
+	}
+
+	host.conn = evt.Conn
+	evt.Timestamp = d.CurTime
+
+	delay := evt.Delay
+	if delay >= 2147483647 {
+		delay = Ever
+	}
+
+	switch evt.Type {
+	case EventTypeAlarmFired:
+		d.Counters.AlarmEvents += 1
+		if evt.MsgId == host.msgId {
+			d.setSleeping(hostId(node.Id))
+		}
+		d.alarmMgr.SetTimestamp(hostId(node.Id), d.CurTime+delay)
+	case EventTypeRadioSpinelWrite:
+		d.Counters.SpinelEvents += 1
+		node.sendEvent(&Event{
+			Type:      EventTypeUartWrite,
+			Timestamp: d.CurTime,
+			Data:      evt.Data,
+		})
+	case EventTypeUartWrite:
+		d.Counters.UartWriteEvents += 1
+		d.cbHandler.OnUartWrite(node.Id, evt.Data)
+	case EventTypeStatusPush:
+		d.Counters.StatusPushEvents += 1
+		d.handleStatusPush(node, string(evt.Data))
+	case EventTypeNodeInfo:
+		d.Counters.OtherEvents += 1
+		if err := evt.NodeInfoData.CheckCompatible(); err != nil {
+			node.logger.Errorf("RCP host rejected: %v", err)
+			node.err = err
+			d.quarantineNode(node)
+			return
+		}
+		if evt.NodeInfoData.IsLegacy() {
+			node.logger.Debugf("legacy RCP host, assuming protocol version %s", evt.NodeInfoData.ProtocolVersion)
+			return
+		}
+		node.logger.Debugf("RCP host protocol version %s, capabilities %s", evt.NodeInfoData.ProtocolVersion,
+			evt.NodeInfoData.Capabilities)
+		node.sendHostEvent(&Event{
+			Type:         EventTypeNodeInfo,
+			Timestamp:    d.CurTime,
+			NodeInfoData: NewNodeInfoEventData(node.Id),
+		})
+	case EventTypeNodeDisconnected:
+		d.Counters.OtherEvents += 1
+		logger.Debugf("%s host socket disconnected.", node)
+		d.setSleeping(hostId(node.Id))
+		d.alarmMgr.SetTimestamp(hostId(node.Id), Ever)
+	default:
+		d.onProtocolError(node, fmt.Errorf("received RCP host event type not implemented: %v", evt.Type))
+	}
+}
+
+// onRcpUartWrite forwards UART data, i.e. Spinel frames, of the RCP process to the host process.
+func (d *Dispatcher) onRcpUartWrite(node *Node, data []byte) {
+	d.Counters.SpinelEvents += 1
+	node.sendHostEvent(&Event{
+		Type:      EventTypeRadioSpinelWrite,
+		Timestamp: d.CurTime,
+		Data:      data,
+	})
+}
+
+// advanceHostTime moves the virtual time of the host process of the RCP node to timestamp.
+func (d *Dispatcher) advanceHostTime(node *Node, timestamp uint64, force bool) {
+	if d.cfg.Real || (timestamp <= node.host.curTime && !force) {
+		return
+	}
+	node.sendHostEvent(&Event{
+		Type:      EventTypeAlarmFired,
+		Timestamp: timestamp,
+	})
+}
+
+// advanceProcessTime moves the virtual time of a node process, which is the node itself or, for a host id,
+// the host process of an RCP node.
+func (d *Dispatcher) advanceProcessTime(id NodeId, timestamp uint64, force bool) {
+	if isHostId(id) {
+		if node := d.nodes[-id]; node != nil && node.host != nil {
+			d.advanceHostTime(node, timestamp, force)
+		}
+	} else if node := d.nodes[id]; node != nil {
+		d.advanceNodeTime(node, timestamp, force)
+	}
+}
+
+// sendHostEvent sends Event evt to the host process of the RCP node, like sendEvent does for the node itself.
+func (node *Node) sendHostEvent(evt *Event) {
+	if node.quarantined {
+		return
+	}
+	host := node.host
+	host.msgId += 1
+	evt.NodeId = node.Id
+	evt.MsgId = host.msgId
+	logger.AssertTrue(evt.Timestamp == node.D.CurTime)
+	evt.Delay = evt.Timestamp - host.curTime
+
+	node.D.alarmMgr.SetNotified(hostId(node.Id))
+	node.D.setAlive(hostId(node.Id))
+	host.curTime = evt.Timestamp
+
+	if host.conn == nil {
+		node.err = fmt.Errorf("%s RCP host connection is closed", node)
+		node.logger.Error(node.err)
+		return
+	}
+	msg := evt.Serialize()
+	n, err := host.conn.Write(msg)
+	if err == nil && n != len(msg) {
+		err = fmt.Errorf("failed to write Event to %s RCP host socket", node)
+	}
+	if err != nil {
+		node.logger.Error(err)
+		node.err = err
+	}
+}
+
+// IsRcp returns true if the node runs an RCP process plus a host process.
+func (node *Node) IsRcp() bool {
+	return node.host != nil
+}
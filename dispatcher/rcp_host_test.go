@@ -0,0 +1,183 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/openthread/ot-ns/event"
+	"github.com/openthread/ot-ns/progctx"
+)
+
+// eventConn is a net.Conn that deserializes and stores the events written to it.
+type eventConn struct {
+	net.Conn
+	events []*Event
+}
+
+func (c *eventConn) Write(b []byte) (int, error) {
+	evt := &Event{}
+	if _, err := evt.Deserialize(b); err != nil {
+		return 0, err
+	}
+	c.events = append(c.events, evt)
+	return len(b), nil
+}
+
+func (c *eventConn) Close() error {
+	return nil
+}
+
+func TestRcpHostEvents(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+	rcpConn, hostConn := &eventConn{}, &eventConn{}
+	node.conn = rcpConn
+	node.host = &rcpHost{}
+	d.alarmMgr.AddNode(hostId(node.Id))
+	assert.True(t, node.IsRcp())
+	assert.True(t, isHostId(hostId(node.Id)))
+
+	// the host introduces itself, and gets a NodeInfo reply.
+	info := NewNodeInfoEventData(node.Id)
+	d.handleRecvEvent(&Event{NodeId: hostId(node.Id), Type: EventTypeNodeInfo, NodeInfoData: info, Conn: hostConn})
+	assert.Equal(t, 1, len(hostConn.events))
+	assert.Equal(t, EventTypeNodeInfo, hostConn.events[0].Type)
+	assert.True(t, node.IsConnected())
+
+	// Spinel frames of the host go to the RCP as UART data, and UART data of the RCP goes back to the host.
+	d.handleRecvEvent(&Event{NodeId: hostId(node.Id), Type: EventTypeRadioSpinelWrite, Data: []byte{0x7e, 0x81}, Conn: hostConn})
+	assert.Equal(t, 1, len(rcpConn.events))
+	assert.Equal(t, EventTypeUartWrite, rcpConn.events[0].Type)
+	assert.Equal(t, []byte{0x7e, 0x81}, rcpConn.events[0].Data)
+
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeUartWrite, Data: []byte{0x7e, 0x82}, Conn: rcpConn})
+	assert.Equal(t, 2, len(hostConn.events))
+	assert.Equal(t, EventTypeRadioSpinelWrite, hostConn.events[1].Type)
+	assert.Equal(t, []byte{0x7e, 0x82}, hostConn.events[1].Data)
+	assert.Equal(t, uint64(2), d.Counters.SpinelEvents)
+
+	// CLI input of the node goes to the host.
+	assert.NoError(t, node.SendToUART([]byte("state\n")))
+	assert.Equal(t, 3, len(hostConn.events))
+	assert.Equal(t, EventTypeUartWrite, hostConn.events[2].Type)
+	assert.Equal(t, 1, len(rcpConn.events))
+
+	// the host process sleeps on its own alarm.
+	assert.True(t, d.IsAlive(hostId(node.Id)))
+	d.handleRecvEvent(&Event{NodeId: hostId(node.Id), Type: EventTypeAlarmFired, MsgId: node.host.msgId, Delay: 1000, Conn: hostConn})
+	assert.False(t, d.IsAlive(hostId(node.Id)))
+	assert.Equal(t, uint64(1000), d.alarmMgr.GetTimestamp(hostId(node.Id)))
+	assert.Equal(t, uint64(0), node.GetProtocolErrors())
+}
+
+func TestRcpHostOfNonRcpNode(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+
+	d.handleRecvEvent(&Event{NodeId: hostId(node.Id), Type: EventTypeRadioSpinelWrite, Conn: &eventConn{}})
+	assert.Equal(t, uint64(1), node.GetProtocolErrors())
+}
+
+// testProcess is the process end of a node connection, which is read by the dispatcher using readConn.
+type testProcess struct {
+	conn   net.Conn
+	events chan *Event // events received from the dispatcher.
+}
+
+func newTestProcess(d *Dispatcher) *testProcess {
+	dispatcherConn, processConn := net.Pipe()
+	p := &testProcess{conn: processConn, events: make(chan *Event, 100)}
+	go d.readConn(dispatcherConn)
+	go func() {
+		defer close(p.events)
+		buf := make([]byte, 65536)
+		for {
+			n, err := processConn.Read(buf)
+			if err != nil {
+				return
+			}
+			evt := &Event{}
+			if _, err = evt.Deserialize(buf[:n]); err == nil {
+				p.events <- evt
+			}
+		}
+	}()
+	return p
+}
+
+func (p *testProcess) send(t *testing.T, evt *Event) {
+	_, err := p.conn.Write(evt.Serialize())
+	assert.NoError(t, err)
+}
+
+func TestRcpHostConnections(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+	d.ctx = progctx.New(context.Background())
+	d.eventChan = make(chan *Event, 100)
+	node.host = &rcpHost{}
+	d.alarmMgr.AddNode(hostId(node.Id))
+	d.rcpConns.expect(node.Id)
+	info := NewNodeInfoEventData(node.Id)
+
+	// the RCP process connects first; once it has, the host process is started and connects.
+	rcp := newTestProcess(d)
+	rcp.send(t, &Event{Type: EventTypeNodeInfo, NodeInfoData: info})
+	evt := <-d.eventChan
+	assert.Equal(t, node.Id, evt.NodeId)
+	assert.NoError(t, d.WaitRcpConnected(node.Id))
+	d.handleRecvEvent(evt)
+	assert.Equal(t, EventTypeNodeInfo, (<-rcp.events).Type)
+
+	host := newTestProcess(d)
+	host.send(t, &Event{Type: EventTypeNodeInfo, NodeInfoData: info})
+	evt = <-d.eventChan
+	assert.Equal(t, hostId(node.Id), evt.NodeId)
+	d.handleRecvEvent(evt)
+	assert.Equal(t, EventTypeNodeInfo, (<-host.events).Type)
+	assert.True(t, node.IsConnected())
+
+	// Spinel frames of the host reach the RCP as UART data, and UART data of the RCP reaches the host.
+	host.send(t, &Event{Type: EventTypeRadioSpinelWrite, Data: []byte{0x7e, 0x81}})
+	d.handleRecvEvent(<-d.eventChan)
+	evt = <-rcp.events
+	assert.Equal(t, EventTypeUartWrite, evt.Type)
+	assert.Equal(t, []byte{0x7e, 0x81}, evt.Data)
+
+	rcp.send(t, &Event{Type: EventTypeUartWrite, Data: []byte{0x7e, 0x82}})
+	d.handleRecvEvent(<-d.eventChan)
+	evt = <-host.events
+	assert.Equal(t, EventTypeRadioSpinelWrite, evt.Type)
+	assert.Equal(t, []byte{0x7e, 0x82}, evt.Data)
+	assert.Equal(t, uint64(0), node.GetProtocolErrors())
+
+	// the processes of a node that isn't an RCP node are not paired.
+	assert.False(t, d.rcpConns.isHostConn(node.Id+1))
+	assert.Error(t, d.WaitRcpConnected(node.Id+1))
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openthread/ot-ns/logger"
+	. "github.com/openthread/ot-ns/types"
+)
+
+// StatusPushHandler handles the value of a status-push key (a "key=value" item of a status-push event) sent by
+// a node. It is called from the simulation goroutine.
+type StatusPushHandler func(node *Node, value string) error
+
+// RegisterStatusPushHandler registers a handler for a status-push key, replacing the handler that was
+// registered for the key before, if any. This allows handling of product-specific status keys, or overriding
+// the built-in handling of a key.
+func (d *Dispatcher) RegisterStatusPushHandler(key string, handler StatusPushHandler) {
+	logger.AssertNotNil(handler)
+	d.statusPushHandlers[key] = handler
+}
+
+// UnregisterStatusPushHandler removes the handler of a status-push key. Values of the key are then
+// forwarded as custom status to subscribers and the visualizer.
+func (d *Dispatcher) UnregisterStatusPushHandler(key string) {
+	delete(d.statusPushHandlers, key)
+}
+
+func (d *Dispatcher) registerBuiltinStatusPushHandlers() {
+	d.statusPushHandlers = map[string]StatusPushHandler{
+		"transmit":       d.handleStatusPushTransmit,
+		"role":           d.handleStatusPushRole,
+		"rloc16":         d.handleStatusPushRloc16,
+		"ping_request":   d.handleStatusPushPingRequest,
+		"ping_reply":     d.handleStatusPushPingReply,
+		"coap":           d.handleStatusPushCoap,
+		"parid":          d.handleStatusPushPartitionId,
+		"router_added":   d.handleStatusPushRouterAdded,
+		"router_removed": d.handleStatusPushRouterRemoved,
+		"child_added":    d.handleStatusPushChildAdded,
+		"child_removed":  d.handleStatusPushChildRemoved,
+		"parent":         d.handleStatusPushParent,
+		"joiner_state":   d.handleStatusPushJoinerState,
+		"extaddr":        d.handleStatusPushExtAddr,
+		"mode":           d.handleStatusPushMode,
+	}
+}
+
+func (d *Dispatcher) handleStatusPush(srcnode *Node, data string) {
+	srcnode.logger.Tracef("status push: %#v", data)
+	statuses := strings.Split(data, ";")
+	for _, status := range statuses {
+		sp := strings.Split(status, "=")
+		if len(sp) != 2 {
+			continue
+		}
+		if handler, ok := d.statusPushHandlers[sp[0]]; ok {
+			if err := handler(srcnode, sp[1]); err != nil {
+				d.onProtocolError(srcnode, fmt.Errorf("invalid status push %s=%s: %v", sp[0], sp[1], err))
+			}
+		} else {
+			d.onCustomStatus(srcnode, sp[0], sp[1])
+		}
+	}
+}
+
+// onCustomStatus forwards a status-push key that has no handler to the subscribers and the visualizer.
+func (d *Dispatcher) onCustomStatus(node *Node, key string, value string) {
+	node.logger.Debugf("custom status push: %s=%s", key, value)
+	d.vis.OnCustomStatus(node.Id, key, value)
+	d.subscribers.notify(func(sub EventSubscriber) { sub.OnCustomStatus(node.Id, key, value) })
+}
+
+func (d *Dispatcher) handleStatusPushTransmit(node *Node, value string) error {
+	return d.visStatusPushTransmit(node, value)
+}
+
+func (d *Dispatcher) handleStatusPushRole(node *Node, value string) error {
+	role, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	if role < int(OtDeviceRoleDisabled) || role > int(OtDeviceRoleLeader) {
+		return fmt.Errorf("invalid role %d", role)
+	}
+	d.setNodeRole(node, OtDeviceRole(role))
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushRloc16(node *Node, value string) error {
+	rloc16, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return err
+	}
+	d.setNodeRloc16(node.Id, uint16(rloc16))
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushPingRequest(node *Node, value string) error {
+	// e.x. ping_request=fdde:ad00:beef:0:556:90c8:ffaf:b7a3$0$4026600960
+	args := strings.Split(value, ",")
+	if len(args) < 3 {
+		return fmt.Errorf("expected 3 arguments, got %d", len(args))
+	}
+	dstaddr := args[0]
+	datasize, err := strconv.Atoi(args[1])
+	if err != nil {
+		return err
+	}
+	timestamp, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return err
+	}
+	node.onPingRequest(d.convertNodeMilliTime(node, uint32(timestamp)), dstaddr, datasize)
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushPingReply(node *Node, value string) error {
+	//e.x.ping_reply=fdde:ad00:beef:0:556:90c8:ffaf:b7a3$0$0$64
+	args := strings.Split(value, ",")
+	if len(args) < 4 {
+		return fmt.Errorf("expected 4 arguments, got %d", len(args))
+	}
+	dstaddr := args[0]
+	datasize, err := strconv.Atoi(args[1])
+	if err != nil {
+		return err
+	}
+	timestamp, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return err
+	}
+	hoplimit, err := strconv.Atoi(args[3])
+	if err != nil {
+		return err
+	}
+	node.onPingReply(d.convertNodeMilliTime(node, uint32(timestamp)), dstaddr, datasize, hoplimit)
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushCoap(node *Node, value string) error {
+	return d.handleCoapEvent(node, value)
+}
+
+func (d *Dispatcher) handleStatusPushPartitionId(node *Node, value string) error {
+	parid, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return err
+	}
+	d.setNodePartitionId(node, uint32(parid))
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushRouterAdded(node *Node, value string) error {
+	extaddr, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return err
+	}
+	if d.visOptions.RouterTable {
+		d.vis.AddRouterTable(node.Id, extaddr)
+	}
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushRouterRemoved(node *Node, value string) error {
+	extaddr, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return err
+	}
+	if d.visOptions.RouterTable {
+		d.vis.RemoveRouterTable(node.Id, extaddr)
+	}
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushChildAdded(node *Node, value string) error {
+	extaddr, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return err
+	}
+	if d.visOptions.ChildTable {
+		d.vis.AddChildTable(node.Id, extaddr)
+	}
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushChildRemoved(node *Node, value string) error {
+	extaddr, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return err
+	}
+	if d.visOptions.ChildTable {
+		d.vis.RemoveChildTable(node.Id, extaddr)
+	}
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushParent(node *Node, value string) error {
+	extaddr, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return err
+	}
+	d.vis.SetParent(node.Id, extaddr)
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushJoinerState(node *Node, value string) error {
+	joinerState, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	node.onJoinerState(OtJoinerState(joinerState))
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushExtAddr(node *Node, value string) error {
+	extaddr, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return err
+	}
+	if err = d.validateExtAddr(node, extaddr); err != nil {
+		return err
+	}
+	node.onStatusPushExtAddr(extaddr)
+	return nil
+}
+
+// validateExtAddr checks that a node can take the extended address it reported.
+func (d *Dispatcher) validateExtAddr(node *Node, extaddr uint64) error {
+	if extaddr == InvalidExtAddr {
+		return fmt.Errorf("invalid extaddr %016x", extaddr)
+	}
+	if other := d.extaddrMap[extaddr]; other != nil && other != node {
+		return fmt.Errorf("extaddr %016x already used by %s", extaddr, other)
+	}
+	return nil
+}
+
+func (d *Dispatcher) handleStatusPushMode(node *Node, value string) error {
+	mode := ParseNodeMode(value)
+	d.vis.SetNodeMode(node.Id, mode)
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/openthread/ot-ns/event"
+	"github.com/openthread/ot-ns/logger"
+	"github.com/openthread/ot-ns/threadconst"
+	. "github.com/openthread/ot-ns/types"
+	"github.com/openthread/ot-ns/visualize"
+)
+
+type customStatusSubscriber struct {
+	NopEventSubscriber
+	statuses []string
+}
+
+func (cs *customStatusSubscriber) OnCustomStatus(nodeid NodeId, key string, value string) {
+	cs.statuses = append(cs.statuses, key+"="+value)
+}
+
+func TestStatusPushHandlers(t *testing.T) {
+	d := &Dispatcher{vis: visualize.NewNopVisualizer()}
+	d.registerBuiltinStatusPushHandlers()
+	node := &Node{
+		Id:     1,
+		Role:   OtDeviceRoleDisabled,
+		logger: logger.GetNodeLogger(1, &NodeConfig{ID: 1, NodeLogFile: false}),
+	}
+	sub := &customStatusSubscriber{}
+	d.Subscribe(sub)
+
+	// built-in keys are handled, unknown keys are forwarded as custom status.
+	d.handleStatusPush(node, "role=2;battery=87;parid=1234;fw=1.2.3")
+	assert.Equal(t, OtDeviceRoleChild, node.Role)
+	assert.Equal(t, uint32(0x1234), node.PartitionId)
+	assert.Equal(t, []string{"battery=87", "fw=1.2.3"}, sub.statuses)
+
+	// a registered handler takes over a custom key.
+	var battery []string
+	d.RegisterStatusPushHandler("battery", func(node *Node, value string) error {
+		battery = append(battery, value)
+		return nil
+	})
+	sub.statuses = nil
+	d.handleStatusPush(node, "battery=86")
+	assert.Equal(t, []string{"86"}, battery)
+	assert.Empty(t, sub.statuses)
+
+	// a built-in handler can be replaced, or removed.
+	d.RegisterStatusPushHandler("role", func(node *Node, value string) error {
+		return nil
+	})
+	d.handleStatusPush(node, "role=4")
+	assert.Equal(t, OtDeviceRoleChild, node.Role)
+	d.UnregisterStatusPushHandler("role")
+	d.handleStatusPush(node, "role=4")
+	assert.Equal(t, OtDeviceRoleChild, node.Role)
+	assert.Equal(t, []string{"role=4"}, sub.statuses)
+}
+
+func newProtocolTestDispatcher(maxProtocolErrors int) (*Dispatcher, *Node) {
+	d := &Dispatcher{
+		cfg:        Config{MaxProtocolErrors: maxProtocolErrors},
+		vis:        visualize.NewNopVisualizer(),
+		alarmMgr:   newAlarmMgr(),
+		nodes:      map[NodeId]*Node{},
+		aliveNodes: map[NodeId]struct{}{},
+		extaddrMap: map[uint64]*Node{},
+		rloc16Map:  rloc16Map{},
+	}
+	d.registerBuiltinStatusPushHandlers()
+	node := &Node{
+		D:            d,
+		Id:           1,
+		ExtAddr:      InvalidExtAddr,
+		Rloc16:       threadconst.InvalidRloc16,
+		Role:         OtDeviceRoleDisabled,
+		capabilities: CapabilitiesLegacy,
+		logger:       logger.GetNodeLogger(1, &NodeConfig{ID: 1, NodeLogFile: false}),
+	}
+	d.nodes[node.Id] = node
+	d.alarmMgr.AddNode(node.Id)
+	return d, node
+}
+
+func TestStatusPushProtocolErrors(t *testing.T) {
+	d, node := newProtocolTestDispatcher(3)
+
+	d.handleStatusPush(node, "role=x;role=9;rloc16=1234")
+	assert.Equal(t, uint64(2), node.GetProtocolErrors())
+	assert.Equal(t, uint64(2), d.Counters.ProtocolErrors)
+	assert.Equal(t, OtDeviceRoleDisabled, node.Role)
+	assert.Equal(t, uint16(1234), node.Rloc16)
+	assert.False(t, node.IsQuarantined())
+
+	d.handleStatusPush(node, "ping_reply=fdde:ad00:beef:0:556:90c8:ffaf:b7a3,12")
+	assert.Equal(t, uint64(3), node.GetProtocolErrors())
+	assert.True(t, node.IsQuarantined())
+
+	// events of a quarantined node are discarded.
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeStatusPush, Data: []byte("role=4")})
+	assert.Equal(t, OtDeviceRoleDisabled, node.Role)
+	assert.Equal(t, uint64(3), node.GetProtocolErrors())
+}
+
+func TestRecvEventProtocolErrors(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: 200})
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeExtAddr, Data: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}})
+	d.handleRecvEvent(&Event{NodeId: node.Id, ParseError: assert.AnError})
+	assert.Equal(t, uint64(3), node.GetProtocolErrors())
+	assert.False(t, node.IsQuarantined()) // quarantine is disabled
+
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeExtAddr, Data: []byte{1, 2, 3, 4, 5, 6, 7, 8}})
+	assert.Equal(t, uint64(0x0102030405060708), node.ExtAddr)
+	assert.Equal(t, uint64(3), node.GetProtocolErrors())
+}
+
+func TestRecvEventCapabilities(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+
+	// a RadioState event is a protocol error for a node that doesn't report the radio-state capability.
+	node.capabilities = CapRxSensitivity
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeRadioState})
+	assert.Equal(t, uint64(1), node.GetProtocolErrors())
+	assert.Equal(t, uint64(0), d.Counters.RadioEvents)
+}
+
+func FuzzStatusPush(f *testing.F) {
+	for _, s := range []string{
+		"role=2;rloc16=1024;parid=1234abcd",
+		"extaddr=0102030405060708;mode=rdn",
+		"ping_request=fdde:ad00:beef:0:556:90c8:ffaf:b7a3,4,4026600960",
+		"ping_reply=fdde:ad00:beef:0:556:90c8:ffaf:b7a3,4,4026600960,64",
+		"router_added=0102030405060708;child_removed=0102030405060708;parent=0102030405060708",
+		"joiner_state=1;transmit=11,41d8,3,ffff",
+		"coap=send,1,0,2,a/as,fdde:ad00:beef:0:0:ff:fe00:fc10,5683",
+		"role=;rloc16=99999;extaddr=ffffffffffffffff;custom=1",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, data string) {
+		d, node := newProtocolTestDispatcher(0)
+		d.handleStatusPush(node, data)
+	})
+}
+
+func TestNodeInfoHandshake(t *testing.T) {
+	d, node := newProtocolTestDispatcher(0)
+
+	// a legacy node is accepted without a reply.
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeNodeInfo, NodeInfoData: NodeInfoEventData{
+		NodeId:                  node.Id,
+		ProtocolVersion:         ProtocolVersionLegacy,
+		Capabilities:            CapabilitiesLegacy,
+		EventHeaderLen:          19,
+		RadioCommDataHeaderLen:  11,
+		RadioStateDataHeaderLen: 14,
+	}})
+	assert.False(t, node.IsQuarantined())
+	assert.Equal(t, ProtocolVersionLegacy, node.GetProtocolVersion())
+	assert.True(t, node.HasCapability(CapRadioState))
+	assert.False(t, node.HasCapability(CapRxSensitivity))
+	assert.ErrorContains(t, node.SendRxSensitivityEvent(true, -100), "does not support")
+
+	// a node with a different major protocol version is rejected.
+	info := NewNodeInfoEventData(node.Id)
+	info.ProtocolVersion = 0x0200
+	info.Capabilities = CapRadioState
+	d.handleRecvEvent(&Event{NodeId: node.Id, Type: EventTypeNodeInfo, NodeInfoData: info})
+	assert.True(t, node.IsQuarantined())
+	assert.ErrorContains(t, node.SendRxSensitivityEvent(true, -100), "incompatible")
+	assert.Equal(t, ProtocolVersionLegacy, node.GetProtocolVersion())
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"sync"
+
+	. "github.com/openthread/ot-ns/event"
+	. "github.com/openthread/ot-ns/types"
+)
+
+// SubscriptionId identifies an EventSubscriber registered with Dispatcher.Subscribe.
+type SubscriptionId int
+
+// FrameEvent describes a radio frame transmitted by, or received by, a node.
+type FrameEvent struct {
+	Timestamp uint64
+	SrcNode   NodeId
+	DstNode   NodeId // the receiving node, or InvalidNodeId for a transmitted frame.
+	Channel   ChannelId
+	PowerDbm  int8   // Tx power for a transmitted frame, RSSI for a received frame.
+	Data      []byte // the PSDU, i.e. the 802.15.4 MAC frame including FCS.
+}
+
+// CoapEvent describes a CoAP message sent or received by a node, or a send error.
+type CoapEvent struct {
+	Timestamp uint64
+	Node      NodeId
+	Action    string // "send", "recv" or "send_error"
+	ID        int
+	Type      CoapType
+	Code      CoapCode
+	URI       string
+	PeerAddr  string
+	PeerPort  int
+	Error     string // only for "send_error"
+}
+
+// EventSubscriber receives typed notifications of network events from the Dispatcher. Implementations can
+// embed NopEventSubscriber and implement only the methods of interest. The methods are called from the
+// simulation goroutine, so they must not block and must not keep references to the event data.
+type EventSubscriber interface {
+	// OnFrameTransmitted Notifies that a node started transmitting a radio frame.
+	OnFrameTransmitted(evt *FrameEvent)
+
+	// OnFrameReceived Notifies that a radio frame was successfully received by a node.
+	OnFrameReceived(evt *FrameEvent)
+
+	// OnNodeRoleChanged Notifies that the Thread role of a node changed.
+	OnNodeRoleChanged(nodeid NodeId, oldRole OtDeviceRole, newRole OtDeviceRole)
+
+	// OnNodePartitionChanged Notifies that the Thread partition ID of a node changed.
+	OnNodePartitionChanged(nodeid NodeId, oldPartitionId uint32, newPartitionId uint32)
+
+	// OnNodeFail Notifies that the node's radio went into a simulated "fail" (off) state.
+	OnNodeFail(nodeid NodeId)
+
+	// OnNodeRecover Notifies that the node's radio recovered from a simulated "fail" (off) state.
+	OnNodeRecover(nodeid NodeId)
+
+	// OnPingResult Notifies that a ping sent by a node got a reply, or timed out.
+	OnPingResult(nodeid NodeId, result *PingResult)
+
+	// OnCoapMessage Notifies that a node sent or received a CoAP message, or failed to send one.
+	OnCoapMessage(evt *CoapEvent)
+
+	// OnCustomStatus Notifies that a node pushed a status key that has no registered StatusPushHandler.
+	OnCustomStatus(nodeid NodeId, key string, value string)
+}
+
+// NopEventSubscriber is an EventSubscriber that ignores all events.
+type NopEventSubscriber struct{}
+
+func (ns NopEventSubscriber) OnFrameTransmitted(evt *FrameEvent) {
+}
+
+func (ns NopEventSubscriber) OnFrameReceived(evt *FrameEvent) {
+}
+
+func (ns NopEventSubscriber) OnNodeRoleChanged(nodeid NodeId, oldRole OtDeviceRole, newRole OtDeviceRole) {
+}
+
+func (ns NopEventSubscriber) OnNodePartitionChanged(nodeid NodeId, oldPartitionId uint32, newPartitionId uint32) {
+}
+
+func (ns NopEventSubscriber) OnNodeFail(nodeid NodeId) {
+}
+
+func (ns NopEventSubscriber) OnNodeRecover(nodeid NodeId) {
+}
+
+func (ns NopEventSubscriber) OnPingResult(nodeid NodeId, result *PingResult) {
+}
+
+func (ns NopEventSubscriber) OnCoapMessage(evt *CoapEvent) {
+}
+
+func (ns NopEventSubscriber) OnCustomStatus(nodeid NodeId, key string, value string) {
+}
+
+func newFrameEvent(evt *Event, srcid NodeId, dstid NodeId) *FrameEvent {
+	return &FrameEvent{
+		Timestamp: evt.Timestamp,
+		SrcNode:   srcid,
+		DstNode:   dstid,
+		Channel:   ChannelId(evt.RadioCommData.Channel),
+		PowerDbm:  evt.RadioCommData.PowerDbm,
+		Data:      evt.Data[RadioMessagePsduOffset:],
+	}
+}
+
+type subscription struct {
+	id  SubscriptionId
+	sub EventSubscriber
+}
+
+// subscribers keeps the registered EventSubscribers, in order of registration. Subscribers can be added and
+// removed from any goroutine, including from within a notification.
+type subscribers struct {
+	mutex  sync.Mutex
+	lastId SubscriptionId
+	list   []subscription
+}
+
+func (s *subscribers) add(sub EventSubscriber) SubscriptionId {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastId++
+	s.list = append(s.list, subscription{id: s.lastId, sub: sub})
+	return s.lastId
+}
+
+func (s *subscribers) remove(id SubscriptionId) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i, entry := range s.list {
+		if entry.id == id {
+			// make a new list, so that a notification in progress is not affected.
+			s.list = append(s.list[:i:i], s.list[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *subscribers) isEmpty() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.list) == 0
+}
+
+func (s *subscribers) notify(f func(sub EventSubscriber)) {
+	s.mutex.Lock()
+	list := s.list
+	s.mutex.Unlock()
+	for _, entry := range list {
+		f(entry.sub)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/openthread/ot-ns/types"
+	"github.com/openthread/ot-ns/visualize"
+)
+
+type recordingSubscriber struct {
+	NopEventSubscriber
+	name   string
+	events *[]string
+}
+
+func (rs *recordingSubscriber) OnNodeRoleChanged(nodeid NodeId, oldRole OtDeviceRole, newRole OtDeviceRole) {
+	*rs.events = append(*rs.events, rs.name+":role:"+oldRole.String()+">"+newRole.String())
+}
+
+func (rs *recordingSubscriber) OnNodePartitionChanged(nodeid NodeId, oldPartitionId uint32, newPartitionId uint32) {
+	*rs.events = append(*rs.events, rs.name+":parid")
+}
+
+func TestSubscribers(t *testing.T) {
+	var events []string
+	var subs subscribers
+	assert.True(t, subs.isEmpty())
+
+	id1 := subs.add(&recordingSubscriber{name: "a", events: &events})
+	id2 := subs.add(&recordingSubscriber{name: "b", events: &events})
+	assert.NotEqual(t, id1, id2)
+	assert.False(t, subs.isEmpty())
+
+	subs.notify(func(sub EventSubscriber) { sub.OnNodeRoleChanged(1, OtDeviceRoleDetached, OtDeviceRoleChild) })
+	assert.Equal(t, []string{"a:role:detached>child", "b:role:detached>child"}, events)
+
+	// unsubscribing during a notification doesn't affect the notification in progress.
+	events = nil
+	subs.notify(func(sub EventSubscriber) {
+		subs.remove(id1)
+		sub.OnNodePartitionChanged(1, 0, 1)
+	})
+	assert.Equal(t, []string{"a:parid", "b:parid"}, events)
+	assert.False(t, subs.remove(id1))
+	assert.True(t, subs.remove(id2))
+	assert.True(t, subs.isEmpty())
+}
+
+func TestSubscribeRoleAndPartition(t *testing.T) {
+	var events []string
+	d := &Dispatcher{vis: visualize.NewNopVisualizer()}
+	node := &Node{Id: 1, Role: OtDeviceRoleDisabled}
+	id := d.Subscribe(&recordingSubscriber{name: "a", events: &events})
+
+	d.setNodeRole(node, OtDeviceRoleDetached)
+	d.setNodeRole(node, OtDeviceRoleDetached) // no change, no event
+	d.setNodePartitionId(node, 0x1234)
+	d.setNodePartitionId(node, 0x1234)
+	assert.Equal(t, []string{"a:role:disabled>detached", "a:parid"}, events)
+
+	assert.True(t, d.Unsubscribe(id))
+	d.setNodeRole(node, OtDeviceRoleLeader)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, OtDeviceRoleLeader, node.Role)
+}
+
+type coapSubscriber struct {
+	NopEventSubscriber
+	events []CoapEvent
+}
+
+func (cs *coapSubscriber) OnCoapMessage(evt *CoapEvent) {
+	cs.events = append(cs.events, *evt)
+}
+
+func TestSubscribeCoapMessage(t *testing.T) {
+	d := &Dispatcher{vis: visualize.NewNopVisualizer()}
+	node := &Node{Id: 1}
+	sub := &coapSubscriber{}
+	d.Subscribe(sub)
+
+	assert.Nil(t, d.handleCoapEvent(node, "send,1,0,2,a/as,fdde:ad00:beef:0:0:ff:fe00:fc10,5683"))
+	assert.Nil(t, d.handleCoapEvent(node, "send_error,2,0,2,a/as,fdde:ad00:beef:0:0:ff:fe00:fc10,5683,NoBufs"))
+	assert.Equal(t, 2, len(sub.events))
+	assert.Equal(t, 5683, sub.events[0].PeerPort)
+	assert.Equal(t, "", sub.events[0].Error)
+	assert.Equal(t, "send_error", sub.events[1].Action)
+	assert.Equal(t, 5683, sub.events[1].PeerPort)
+	assert.Equal(t, "NoBufs", sub.events[1].Error)
+}
+
+func TestSubscribeFromSubscriber(t *testing.T) {
+	var events []string
+	d := &Dispatcher{vis: visualize.NewNopVisualizer()}
+	node := &Node{Id: 1, Role: OtDeviceRoleDisabled}
+	var id2 SubscriptionId
+	id1 := d.Subscribe(&funcSubscriber{onRole: func() {
+		// (un)subscribing from within a notification, i.e. on the simulation goroutine, must not block.
+		if id2 == 0 {
+			id2 = d.Subscribe(&recordingSubscriber{name: "b", events: &events})
+		}
+	}})
+
+	d.setNodeRole(node, OtDeviceRoleDetached)
+	assert.Equal(t, 0, len(events)) // added during the notification, so not notified yet.
+	d.setNodeRole(node, OtDeviceRoleChild)
+	assert.Equal(t, []string{"b:role:detached>child"}, events)
+	assert.True(t, d.Unsubscribe(id1))
+	assert.True(t, d.Unsubscribe(id2))
+}
+
+type funcSubscriber struct {
+	NopEventSubscriber
+	onRole func()
+}
+
+func (fs *funcSubscriber) OnNodeRoleChanged(nodeid NodeId, oldRole OtDeviceRole, newRole OtDeviceRole) {
+	fs.onRole()
+}
@@ -31,6 +31,14 @@ const (
 	DefaultDispatcherSpeed float64 = -1.0
 )
 
+// QueueLengths holds the current lengths of the Dispatcher's internal queues.
+type QueueLengths struct {
+	SendQueue int // events scheduled for dispatch to nodes
+	Alarms    int // nodes with an alarm set
+	Received  int // events received from nodes that are not yet processed
+	Tasks     int // tasks posted to the Dispatcher that are not yet executed
+}
+
 func min(t1 uint64, t2 uint64) uint64 {
 	if t1 <= t2 {
 		return t1
@@ -0,0 +1,107 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dissectpkt
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+)
+
+const (
+	ccmBlockSize = 16
+	ccmNonceLen  = 13 // 802.15.4 uses CCM* with a length field of L=2 octets, so a 13-octet nonce.
+)
+
+// ccmDecrypt decrypts ciphertext c with AES-CCM* (802.15.4-2015 Annex B, which extends RFC 3610 with MIC
+// length 0) and verifies its MIC mic over the additional data a and the decrypted message. It returns nil if
+// the MIC does not match.
+func ccmDecrypt(block cipher.Block, nonce []byte, a []byte, c []byte, mic []byte) []byte {
+	m := ccmCtr(block, nonce, c)
+	if len(mic) == 0 {
+		return m
+	}
+	s0 := make([]byte, ccmBlockSize)
+	block.Encrypt(s0, ccmCounterBlock(nonce, 0))
+	tag := make([]byte, len(mic))
+	for i := range mic {
+		tag[i] = mic[i] ^ s0[i]
+	}
+	if !bytes.Equal(tag, ccmMac(block, nonce, a, m, len(mic))) {
+		return nil
+	}
+	return m
+}
+
+// ccmCounterBlock returns the counter block A_i.
+func ccmCounterBlock(nonce []byte, i int) []byte {
+	a := make([]byte, ccmBlockSize)
+	a[0] = 1 // L-1
+	copy(a[1:1+ccmNonceLen], nonce)
+	binary.BigEndian.PutUint16(a[14:], uint16(i))
+	return a
+}
+
+// ccmCtr en- or decrypts data in counter mode, starting with counter block A_1.
+func ccmCtr(block cipher.Block, nonce []byte, data []byte) []byte {
+	out := make([]byte, len(data))
+	s := make([]byte, ccmBlockSize)
+	for i := 0; i < len(data); i += ccmBlockSize {
+		block.Encrypt(s, ccmCounterBlock(nonce, i/ccmBlockSize+1))
+		for j := 0; j < ccmBlockSize && i+j < len(data); j++ {
+			out[i+j] = data[i+j] ^ s[j]
+		}
+	}
+	return out
+}
+
+// ccmMac computes the (unencrypted) CBC-MAC tag of length micLen over additional data a and message m.
+func ccmMac(block cipher.Block, nonce []byte, a []byte, m []byte, micLen int) []byte {
+	x := make([]byte, ccmBlockSize)
+	x[0] = byte((micLen-2)/2)<<3 | 1 // M' and L-1
+	if len(a) > 0 {
+		x[0] |= 0x40
+	}
+	copy(x[1:1+ccmNonceLen], nonce)
+	binary.BigEndian.PutUint16(x[14:], uint16(len(m)))
+	block.Encrypt(x, x)
+
+	mac := func(data []byte) {
+		// each (last) partial block is padded with zeroes, which leaves x unchanged.
+		for i := 0; i < len(data); i += ccmBlockSize {
+			for j := 0; j < ccmBlockSize && i+j < len(data); j++ {
+				x[j] ^= data[i+j]
+			}
+			block.Encrypt(x, x)
+		}
+	}
+	if len(a) > 0 {
+		mac(append([]byte{byte(len(a) >> 8), byte(len(a))}, a...))
+	}
+	mac(m)
+	return x[:micLen]
+}
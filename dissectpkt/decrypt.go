@@ -0,0 +1,264 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dissectpkt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/openthread/ot-ns/dissectpkt/lowpan"
+	"github.com/openthread/ot-ns/dissectpkt/wpan"
+)
+
+const (
+	NetworkKeyLen = 16
+
+	maxCachedKeySequences = 8
+)
+
+// Decrypter decrypts MAC and MLE secured frames of Thread networks, using their network keys. The key
+// sequence of each network is tracked from the frames it decrypts, so key rotation is followed.
+type Decrypter struct {
+	networks []*networkKeys
+}
+
+type networkKeys struct {
+	networkKey  []byte
+	keySequence uint32
+	keys        map[uint32]*threadKeys
+}
+
+// threadKeys are the keys derived from a network key for one key sequence, Thread specification section 7.1.4.
+type threadKeys struct {
+	mac cipher.Block
+	mle cipher.Block
+}
+
+func NewDecrypter() *Decrypter {
+	return &Decrypter{}
+}
+
+// AddNetworkKey adds a network key, with the current key sequence of its network. It returns true if the key
+// was not known yet; for a known key, only the key sequence is updated.
+func (dc *Decrypter) AddNetworkKey(networkKey []byte, keySequence uint32) (bool, error) {
+	if len(networkKey) != NetworkKeyLen {
+		return false, fmt.Errorf("invalid network key length %d", len(networkKey))
+	}
+	for _, nk := range dc.networks {
+		if string(nk.networkKey) == string(networkKey) {
+			nk.keySequence = keySequence
+			return false, nil
+		}
+	}
+	dc.networks = append(dc.networks, &networkKeys{
+		networkKey:  append([]byte{}, networkKey...),
+		keySequence: keySequence,
+		keys:        map[uint32]*threadKeys{},
+	})
+	return true, nil
+}
+
+// NetworkKeys returns the network keys added, in the order added.
+func (dc *Decrypter) NetworkKeys() [][]byte {
+	keys := make([][]byte, 0, len(dc.networks))
+	for _, nk := range dc.networks {
+		keys = append(keys, nk.networkKey)
+	}
+	return keys
+}
+
+// Dissect dissects the frame like the Dissect function, but also decrypts MAC and MLE secured content if a
+// network key matches. srcExtAddr is the extended address of the transmitter, which is needed to decrypt
+// frames that have a short source address.
+func (dc *Decrypter) Dissect(data []byte, srcExtAddr uint64) *PktInfo {
+	return dissect(data, dc, srcExtAddr)
+}
+
+// DecryptFrame returns a copy of the frame with the MAC payload decrypted, the auxiliary security header and
+// MIC removed, the security enabled bit cleared and the FCS recalculated. If the frame is not encrypted or
+// cannot be decrypted, data is returned as is.
+func (dc *Decrypter) DecryptFrame(data []byte, srcExtAddr uint64) []byte {
+	pkt := dc.Dissect(data, srcExtAddr)
+	frame := pkt.MacFrame
+	if !pkt.Decrypted {
+		return data
+	}
+	headerIEs := data[frame.SecurityOffset+frame.Security.Len() : frame.PayloadOffset]
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:frame.SecurityOffset]...)
+	out[1] &^= 0x08 // security enabled bit
+	out = append(out, headerIEs...)
+	out = append(out, frame.Payload...)
+	fcs := crc16(out[1:])
+	return append(out, byte(fcs), byte(fcs>>8))
+}
+
+// decryptMac decrypts the payload of MAC frame frame, which is dissected from data, and returns the decrypted
+// payload or nil if it can't be decrypted.
+func (dc *Decrypter) decryptMac(data []byte, frame *wpan.MacFrame, srcExtAddr uint64) []byte {
+	sec := frame.Security
+	if sec.KeyIdMode() != wpan.KeyIdMode1 || sec.FrameCounterSuppressed() {
+		return nil // Thread uses key ID mode 1 for MAC security.
+	}
+	aEnd := frame.PayloadOffset
+	c := frame.Payload
+	if frame.FrameControl.FrameType() == wpan.FrameTypeCommand {
+		// the command ID is authenticated, but not encrypted.
+		if len(c) < 1 {
+			return nil
+		}
+		aEnd++
+		c = c[1:]
+	}
+	micStart := frame.PayloadOffset + len(frame.Payload)
+	a := data[1:aEnd] // excluding the channel byte.
+	mic := data[micStart : micStart+sec.MicLen()]
+
+	if frame.FrameControl.SourceAddrMode() == wpan.AddrModeExtended {
+		srcExtAddr = frame.SrcAddrExtended
+	}
+	nonce := ccmNonce(srcExtAddr, sec)
+
+	for _, nk := range dc.networks {
+		keySequence := nk.keySequenceOf(sec.KeyIndex)
+		m := ccmDecrypt(nk.get(keySequence).mac, nonce, a, c, mic)
+		if m != nil {
+			nk.onDecrypted(keySequence)
+			if frame.FrameControl.FrameType() == wpan.FrameTypeCommand {
+				m = append([]byte{frame.CommandId}, m...)
+			}
+			return m
+		}
+	}
+	return nil
+}
+
+// decryptMle decrypts the secured MLE message mle, of which the 6LoWPAN header is hdr.
+func (dc *Decrypter) decryptMle(hdr *lowpan.Header, mle *MleMessage) {
+	if len(hdr.Ipv6.SrcAddr) != 16 || len(hdr.Ipv6.DstAddr) != 16 {
+		return // addresses depend on an unknown context.
+	}
+	sec := &wpan.AuxSecurityHeader{}
+	secLen, err := sec.Dissect(hdr.Payload[1:])
+	if err != nil || sec.KeyIdMode() != wpan.KeyIdMode2 || sec.FrameCounterSuppressed() {
+		return // Thread uses key ID mode 2 for MLE security, with the key sequence as key source.
+	}
+	rest := hdr.Payload[1+secLen:]
+	if len(rest) < sec.MicLen() {
+		return
+	}
+	c := rest[:len(rest)-sec.MicLen()]
+	mic := rest[len(rest)-sec.MicLen():]
+
+	a := make([]byte, 0, 32+secLen)
+	a = append(a, hdr.Ipv6.SrcAddr...)
+	a = append(a, hdr.Ipv6.DstAddr...)
+	a = append(a, hdr.Payload[1:1+secLen]...)
+
+	// the nonce uses the extended address of the source, which is derived from its link-local IID.
+	srcExtAddr := binary.BigEndian.Uint64(hdr.Ipv6.SrcAddr[8:]) ^ (0x02 << 56)
+	nonce := ccmNonce(srcExtAddr, sec)
+	keySequence := binary.BigEndian.Uint32(sec.KeySource)
+
+	for _, nk := range dc.networks {
+		m := ccmDecrypt(nk.get(keySequence).mle, nonce, a, c, mic)
+		if len(m) > 0 {
+			nk.onDecrypted(keySequence)
+			mle.Decrypted = true
+			mle.CommandType = m[0]
+			return
+		}
+	}
+}
+
+// keySequenceOf returns the key sequence closest to the current key sequence for MAC key index keyIndex.
+func (nk *networkKeys) keySequenceOf(keyIndex uint8) uint32 {
+	d := (int(keyIndex) - 1 - int(nk.keySequence&0x7f)) & 0x7f
+	if d >= 64 {
+		d -= 128
+	}
+	return uint32(int64(nk.keySequence) + int64(d))
+}
+
+func (nk *networkKeys) onDecrypted(keySequence uint32) {
+	if keySequence > nk.keySequence {
+		nk.keySequence = keySequence
+	}
+}
+
+// get returns the keys for key sequence keySequence, deriving them if needed.
+func (nk *networkKeys) get(keySequence uint32) *threadKeys {
+	if keys, ok := nk.keys[keySequence]; ok {
+		return keys
+	}
+	if len(nk.keys) >= maxCachedKeySequences {
+		nk.keys = map[uint32]*threadKeys{}
+	}
+
+	mac := hmac.New(sha256.New, nk.networkKey)
+	var seq [4]byte
+	binary.BigEndian.PutUint32(seq[:], keySequence)
+	mac.Write(seq[:])
+	mac.Write([]byte("Thread"))
+	hash := mac.Sum(nil)
+
+	mleBlock, _ := aes.NewCipher(hash[:16])
+	macBlock, _ := aes.NewCipher(hash[16:])
+	keys := &threadKeys{mac: macBlock, mle: mleBlock}
+	nk.keys[keySequence] = keys
+	return keys
+}
+
+// ccmNonce returns the CCM* nonce, section 9.3.2.2, 802.15.4-2015.
+func ccmNonce(srcExtAddr uint64, sec *wpan.AuxSecurityHeader) []byte {
+	nonce := make([]byte, ccmNonceLen)
+	binary.BigEndian.PutUint64(nonce, srcExtAddr)
+	binary.BigEndian.PutUint32(nonce[8:], sec.FrameCounter)
+	nonce[12] = sec.SecurityLevel()
+	return nonce
+}
+
+// crc16 returns the 802.15.4 FCS (CRC-16/KERMIT) of data.
+func crc16(data []byte) uint16 {
+	crc := uint16(0)
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = (crc >> 1) ^ 0x8408
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
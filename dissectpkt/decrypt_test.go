@@ -0,0 +1,151 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dissectpkt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/dissectpkt/wpan"
+)
+
+func unhex(t *testing.T, s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// ccmEncrypt returns the ciphertext of m followed by the encrypted MIC.
+func ccmEncrypt(block cipher.Block, nonce []byte, a []byte, m []byte, micLen int) []byte {
+	s0 := make([]byte, ccmBlockSize)
+	block.Encrypt(s0, ccmCounterBlock(nonce, 0))
+	tag := ccmMac(block, nonce, a, m, micLen)
+	for i := range tag {
+		tag[i] ^= s0[i]
+	}
+	return append(ccmCtr(block, nonce, m), tag...)
+}
+
+func testDecrypter(t *testing.T) (*Decrypter, *threadKeys) {
+	dc := NewDecrypter()
+	added, err := dc.AddNetworkKey(unhex(t, "00112233445566778899aabbccddeeff"), 0)
+	assert.Nil(t, err)
+	assert.True(t, added)
+	return dc, dc.networks[0].get(0)
+}
+
+func TestCcmDecrypt(t *testing.T) {
+	// RFC 3610, packet vector #1.
+	block, _ := aes.NewCipher(unhex(t, "c0c1c2c3c4c5c6c7c8c9cacbcccdcecf"))
+	nonce := unhex(t, "00000003020100a0a1a2a3a4a5")
+	a := unhex(t, "0001020304050607")
+	c := unhex(t, "588c979a61c663d2f066d0c2c0f989806d5f6b61dac384")
+	mic := unhex(t, "17e8d12cfdf926e0")
+	m := unhex(t, "08090a0b0c0d0e0f101112131415161718191a1b1c1d1e")
+
+	assert.Equal(t, m, ccmDecrypt(block, nonce, a, c, mic))
+	assert.Equal(t, append(c, mic...), ccmEncrypt(block, nonce, a, m, len(mic)))
+	mic[0] ^= 1
+	assert.Nil(t, ccmDecrypt(block, nonce, a, c, mic))
+}
+
+func TestDecryptMac(t *testing.T) {
+	dc, keys := testDecrypter(t)
+
+	// data frame secured with key ID mode 1, key index 1, frame counter 10, from short address 0x0400;
+	// the payload is a TMF message.
+	header := unhex(t, "49d802cefa0004"+"0004"+"0d"+"0a000000"+"01")
+	header[1] = 0x98 // short source address
+	plain := unhex(t, "7f3b02"+"f0f0bff0bf1234"+"aabbcc")
+	nonce := ccmNonce(0x1122334455667788, &wpan.AuxSecurityHeader{SecurityControl: 0x0d, FrameCounter: 10})
+	data := append([]byte{11}, header...)
+	data = append(data, ccmEncrypt(keys.mac, nonce, header, plain, 4)...)
+	data = append(data, 0, 0)
+
+	pkt := Dissect(data)
+	assert.Equal(t, "MAC Data (encrypted)", pkt.String())
+
+	pkt = dc.Dissect(data, 0x1122334455667788)
+	assert.True(t, pkt.Decrypted)
+	assert.Equal(t, plain, pkt.MacFrame.Payload)
+	assert.Equal(t, UDP, pkt.Type())
+	assert.Equal(t, "TMF CoAP", pkt.String())
+
+	// wrong transmitter extended address: the MIC doesn't match.
+	pkt = dc.Dissect(data, 0x1122334455667789)
+	assert.False(t, pkt.Decrypted)
+	assert.Equal(t, MAC, pkt.Type())
+
+	decrypted := dc.DecryptFrame(data, 0x1122334455667788)
+	assert.Equal(t, uint16(0), crc16(decrypted[1:]))
+	pkt = Dissect(decrypted)
+	assert.Nil(t, pkt.MacFrame.Err)
+	assert.Nil(t, pkt.MacFrame.Security)
+	assert.Equal(t, uint16(0x0400), pkt.MacFrame.SrcAddrShort)
+	assert.Equal(t, plain, pkt.MacFrame.Payload)
+	assert.Equal(t, "TMF CoAP", pkt.String())
+
+	assert.Equal(t, data, dc.DecryptFrame(data, 0))
+}
+
+func TestDecryptMle(t *testing.T) {
+	dc, keys := testDecrypter(t)
+
+	// MLE Parent Request from extaddr 1122334455667788 to ff02::2, secured with key ID mode 2 and key
+	// sequence 0.
+	aux := unhex(t, "15"+"05000000"+"00000000"+"01")
+	a := append(unhex(t, "fe800000000000001322334455667788"+"ff020000000000000000000000000002"), aux...)
+	nonce := ccmNonce(0x1122334455667788, &wpan.AuxSecurityHeader{SecurityControl: 0x15, FrameCounter: 5})
+	data := unhex(t, "0b"+"41d801cefaffff8877665544332211"+"7f3b02"+"f04d4c4d4c1234"+"00")
+	data = append(data, aux...)
+	data = append(data, ccmEncrypt(keys.mle, nonce, a, unhex(t, "09"+"0d0102"), 4)...)
+	data = append(data, 0, 0)
+
+	assert.Equal(t, "MLE", Dissect(data).String())
+	pkt := dc.Dissect(data, 0)
+	assert.False(t, pkt.Decrypted)
+	assert.True(t, pkt.Mle.Decrypted)
+	assert.Equal(t, "MLE Parent Request", pkt.String())
+}
+
+func TestKeySequenceOfKeyIndex(t *testing.T) {
+	nk := &networkKeys{keySequence: 127}
+	assert.Equal(t, uint32(127), nk.keySequenceOf(128))
+	assert.Equal(t, uint32(128), nk.keySequenceOf(1))
+	assert.Equal(t, uint32(100), nk.keySequenceOf(101))
+
+	nk.onDecrypted(128)
+	assert.Equal(t, uint32(128), nk.keySequence)
+	nk.onDecrypted(127)
+	assert.Equal(t, uint32(128), nk.keySequence)
+}
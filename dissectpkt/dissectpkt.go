@@ -27,30 +27,158 @@
 package dissectpkt
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/openthread/ot-ns/dissectpkt/lowpan"
 	"github.com/openthread/ot-ns/dissectpkt/wpan"
 )
 
 type WpanFrameType int
 
 const (
-	MLE WpanFrameType = iota
-	MAC               = iota
+	MLE    WpanFrameType = iota
+	MAC    WpanFrameType = iota // a MAC frame of which the content is unknown, e.g. because it is encrypted.
+	ACK    WpanFrameType = iota
+	BEACON WpanFrameType = iota
+	CMD    WpanFrameType = iota // MAC command
+	UDP    WpanFrameType = iota // unencrypted UDP, other than MLE
+	FRAG   WpanFrameType = iota // subsequent fragment of a 6LoWPAN datagram
+
+	NumWpanFrameTypes = int(iota)
 )
 
+var wpanFrameTypeLabels = map[WpanFrameType]string{
+	MLE:    "MLE",
+	MAC:    "MAC",
+	ACK:    "ACK",
+	BEACON: "BCN",
+	CMD:    "CMD",
+	UDP:    "UDP",
+	FRAG:   "FRG",
+}
+
+// String returns a 3-letter label for the frame type.
+func (t WpanFrameType) String() string {
+	if s, ok := wpanFrameTypeLabels[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("%03d", int(t))
+}
+
+// ParseWpanFrameType parses a frame type label as returned by WpanFrameType.String(), ignoring case.
+func ParseWpanFrameType(s string) (WpanFrameType, error) {
+	for t, label := range wpanFrameTypeLabels {
+		if strings.EqualFold(s, label) {
+			return t, nil
+		}
+	}
+	return MAC, fmt.Errorf("unknown frame type: %s", s)
+}
+
 type PktInfo struct {
-	MacFrame *wpan.MacFrame
+	MacFrame  *wpan.MacFrame
+	Decrypted bool           // true if the MAC payload was decrypted; MacFrame.Payload is then the plaintext.
+	Lowpan    *lowpan.Header // nil if the frame has no (unencrypted) 6LoWPAN payload.
+	Mle       *MleMessage    // nil if the frame is not MLE.
 }
 
+// Dissect dissects the frame, which is preceded by the channel byte. It dissects as far as the frame is not
+// encrypted; it never fails. Use a Decrypter to also dissect encrypted frames.
 func Dissect(data []byte) *PktInfo {
+	return dissect(data, nil, 0)
+}
+
+func dissect(data []byte, dc *Decrypter, srcExtAddr uint64) *PktInfo {
 	macFrame := wpan.Dissect(data)
 
 	pktinfo := &PktInfo{
 		MacFrame: macFrame,
 	}
+	if macFrame.Err != nil {
+		return pktinfo
+	}
+	if macFrame.IsEncrypted() {
+		if dc == nil {
+			return pktinfo
+		}
+		plain := dc.decryptMac(data, macFrame, srcExtAddr)
+		if plain == nil {
+			return pktinfo
+		}
+		macFrame.Payload = plain
+		pktinfo.Decrypted = true
+	}
+
+	if macFrame.FrameControl.FrameType() != wpan.FrameTypeData {
+		return pktinfo
+	}
+	if hdr, err := lowpan.Dissect(macFrame); err == nil {
+		pktinfo.Lowpan = hdr
+		if hdr.Udp != nil && hdr.Udp.DstPort == MlePort && hdr.Udp.SrcPort == MlePort {
+			pktinfo.Mle = dissectMle(hdr.Payload)
+			if pktinfo.Mle != nil && pktinfo.Mle.Secured && dc != nil && hdr.Ipv6 != nil {
+				dc.decryptMle(hdr, pktinfo.Mle)
+			}
+		}
+	}
 
 	return pktinfo
 }
 
+// Type returns the type of the frame, as far as it could be dissected.
+func (pkt *PktInfo) Type() WpanFrameType {
+	switch pkt.MacFrame.FrameControl.FrameType() {
+	case wpan.FrameTypeAck:
+		return ACK
+	case wpan.FrameTypeBeacon:
+		return BEACON
+	case wpan.FrameTypeCommand:
+		return CMD
+	}
+	if pkt.Mle != nil {
+		return MLE
+	}
+	if pkt.Lowpan != nil {
+		if pkt.Lowpan.Frag != nil && !pkt.Lowpan.Frag.First {
+			return FRAG
+		}
+		if pkt.Lowpan.Udp != nil {
+			return UDP
+		}
+	}
+	return MAC
+}
+
+// String returns a short description of the frame, e.g. "MLE Parent Request" or "MAC Data Request".
+func (pkt *PktInfo) String() string {
+	frame := pkt.MacFrame
+	switch pkt.Type() {
+	case ACK:
+		return "ACK"
+	case BEACON:
+		return "Beacon"
+	case CMD:
+		if frame.CommandId != 0 {
+			return "MAC " + wpan.CommandName(frame.CommandId)
+		}
+		return "MAC Command"
+	case MLE:
+		return pkt.Mle.String()
+	case FRAG:
+		return fmt.Sprintf("6LoWPAN Fragment (tag %d, offset %d)", pkt.Lowpan.Frag.Tag, pkt.Lowpan.Frag.Offset)
+	case UDP:
+		if pkt.Lowpan.Udp.DstPort == TmfPort || pkt.Lowpan.Udp.SrcPort == TmfPort {
+			return "TMF CoAP"
+		}
+		return fmt.Sprintf("UDP %d > %d", pkt.Lowpan.Udp.SrcPort, pkt.Lowpan.Udp.DstPort)
+	}
+	if frame.IsEncrypted() && !pkt.Decrypted {
+		return "MAC Data (encrypted)"
+	}
+	return "MAC Data"
+}
+
 func IsAckFrame(pkt *PktInfo) bool {
 	return pkt.MacFrame.FrameControl.FrameType() == wpan.FrameTypeAck
 }
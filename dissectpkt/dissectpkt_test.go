@@ -0,0 +1,122 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dissectpkt
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/dissectpkt/wpan"
+)
+
+// frame returns the hex frame bytes, preceded by the channel byte and followed by a (dummy) FCS.
+func frame(t *testing.T, s string) []byte {
+	data, err := hex.DecodeString("0b" + s + "0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestDissectMle(t *testing.T) {
+	// MLE Discovery Request, broadcast, from extaddr 1122334455667788: IPHC with link-local source derived from
+	// the extaddr, ff02::2 destination, and compressed UDP header.
+	pkt := Dissect(frame(t, "41d801cefaffff8877665544332211"+"7f3b02"+"f04d4c4d4c1234"+"ff10"))
+	assert.Nil(t, pkt.MacFrame.Err)
+	assert.Equal(t, uint8(11), pkt.MacFrame.Channel)
+	assert.Equal(t, uint64(0x1122334455667788), pkt.MacFrame.SrcAddrExtended)
+	assert.Equal(t, "fe80::1322:3344:5566:7788", pkt.Lowpan.Ipv6.SrcAddr.String())
+	assert.Equal(t, "ff02::2", pkt.Lowpan.Ipv6.DstAddr.String())
+	assert.Equal(t, uint8(255), pkt.Lowpan.Ipv6.HopLimit)
+	assert.Equal(t, uint16(MlePort), pkt.Lowpan.Udp.DstPort)
+	assert.Equal(t, MLE, pkt.Type())
+	assert.Equal(t, "MLE Discovery Request", pkt.String())
+
+	// MLE secured at the MLE layer: the command type is not known.
+	pkt = Dissect(frame(t, "41d801cefaffff8877665544332211"+"7f3b02"+"f04d4c4d4c1234"+"0015"))
+	assert.Equal(t, MLE, pkt.Type())
+	assert.True(t, pkt.Mle.Secured)
+	assert.Equal(t, "MLE", pkt.String())
+}
+
+func TestDissectSecuredFrames(t *testing.T) {
+	// data frame secured with security level 5, key ID mode 1.
+	pkt := Dissect(frame(t, "49d802cefa0004"+"8877665544332211"+"0d"+"0a000000"+"01"+"a1a2a3a4a5"+"11223344"))
+	mac := pkt.MacFrame
+	assert.Nil(t, mac.Err)
+	assert.True(t, mac.IsEncrypted())
+	assert.Equal(t, uint8(wpan.SecurityLevelEncMic32), mac.Security.SecurityLevel())
+	assert.Equal(t, uint8(wpan.KeyIdMode1), mac.Security.KeyIdMode())
+	assert.Equal(t, uint32(10), mac.Security.FrameCounter)
+	assert.Equal(t, uint8(1), mac.Security.KeyIndex)
+	assert.Equal(t, []byte{0xa1, 0xa2, 0xa3, 0xa4, 0xa5}, mac.Payload)
+	assert.Nil(t, pkt.Lowpan)
+	assert.Equal(t, MAC, pkt.Type())
+	assert.Equal(t, "MAC Data (encrypted)", pkt.String())
+
+	// secured MAC Data Request command: the command ID is not encrypted.
+	pkt = Dissect(frame(t, "6bd803cefa0004"+"8877665544332211"+"0d"+"0b000000"+"01"+"04"+"11223344"))
+	assert.Nil(t, pkt.MacFrame.Err)
+	assert.Equal(t, CMD, pkt.Type())
+	assert.Equal(t, uint8(wpan.CommandDataRequest), pkt.MacFrame.CommandId)
+	assert.Equal(t, "MAC Data Request", pkt.String())
+}
+
+func TestDissectIEsAndFragments(t *testing.T) {
+	// 2015 frame with a CSL header IE and header termination 2, carrying a subsequent 6LoWPAN fragment.
+	pkt := Dissect(frame(t, "41aa05cefa00040008"+"040d01020304"+"803f"+"e0c8123400"+"0c"+"aabb"))
+	mac := pkt.MacFrame
+	assert.Nil(t, mac.Err)
+	assert.Equal(t, 1, len(mac.HeaderIEs))
+	assert.Equal(t, uint16(wpan.HeaderIeCsl), mac.HeaderIEs[0].Id)
+	assert.Equal(t, []byte{1, 2, 3, 4}, mac.HeaderIEs[0].Content)
+	assert.Equal(t, FRAG, pkt.Type())
+	assert.Equal(t, uint16(200), pkt.Lowpan.Frag.Size)
+	assert.Equal(t, uint16(0x1234), pkt.Lowpan.Frag.Tag)
+	assert.Equal(t, "6LoWPAN Fragment (tag 4660, offset 0)", pkt.String())
+
+	assert.Equal(t, ACK, Dissect(frame(t, "020007")).Type())
+}
+
+func TestDissectTruncated(t *testing.T) {
+	full := frame(t, "6bd803cefa0004"+"8877665544332211"+"0d"+"0b000000"+"01"+"04"+"11223344")
+	for n := 0; n < len(full)-1; n++ {
+		pkt := Dissect(full[:n])
+		assert.NotNil(t, pkt.MacFrame.Err, "length %d", n)
+	}
+}
+
+func FuzzDissect(f *testing.F) {
+	f.Add([]byte{11, 0x41, 0xd8, 0x01, 0xce, 0xfa, 0xff, 0xff, 0x88, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11,
+		0x7f, 0x3b, 0x02, 0xf0, 0x4d, 0x4c, 0x4d, 0x4c, 0x12, 0x34, 0xff, 0x10, 0, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		pkt := Dissect(data)
+		_ = pkt.String()
+	})
+}
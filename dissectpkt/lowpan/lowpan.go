@@ -0,0 +1,441 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+// Package lowpan dissects 6LoWPAN headers (RFC 4944, RFC 6282) of IEEE 802.15.4 frame payloads.
+package lowpan
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/openthread/ot-ns/dissectpkt/wpan"
+)
+
+const (
+	IpProtoHopByHop = 0
+	IpProtoUdp      = 17
+	IpProtoIpv6     = 41
+	IpProtoIcmpv6   = 58
+
+	ipv6HeaderLen = 40
+	udpHeaderLen  = 8
+)
+
+// MeshHeader is the mesh addressing header, RFC 4944 section 5.2.
+type MeshHeader struct {
+	HopsLeft        uint8
+	OriginatorShort uint16 // if not OriginatorExt
+	OriginatorExt   uint64
+	FinalShort      uint16 // if not FinalExt
+	FinalExt        uint64
+}
+
+// FragHeader is the fragmentation header, RFC 4944 section 5.3.
+type FragHeader struct {
+	Size   uint16 // datagram size
+	Tag    uint16 // datagram tag
+	Offset uint16 // datagram offset in bytes; 0 for the first fragment.
+	First  bool   // true for the first fragment (FRAG1), which carries the IPv6 header.
+}
+
+// IphcHeader is the IPv6 header, compressed (IPHC, RFC 6282 section 3) or not.
+type IphcHeader struct {
+	Compressed bool
+	Iphc       uint16 // the IPHC encoding bits, if compressed.
+	ContextIds uint8  // source (high nibble) and destination (low nibble) context IDs.
+	NextHeader uint8  // the upper layer protocol, after any extension headers.
+	HopLimit   uint8
+	SrcAddr    net.IP // nil if it depends on a context prefix.
+	DstAddr    net.IP // nil if it depends on a context prefix.
+}
+
+// UdpHeader is a UDP header, compressed (RFC 6282 section 4.3) or not.
+type UdpHeader struct {
+	SrcPort uint16
+	DstPort uint16
+}
+
+// Header is the 6LoWPAN header of a frame payload.
+type Header struct {
+	Mesh    *MeshHeader
+	Frag    *FragHeader
+	Ipv6    *IphcHeader // nil for a subsequent fragment.
+	Udp     *UdpHeader  // nil if not UDP.
+	Payload []byte      // the remaining payload: the UDP payload if UDP, else the upper layer data or fragment.
+}
+
+// Dissect parses the 6LoWPAN headers of the payload of MAC data frame frame. The MAC addresses of the frame are
+// used to derive the IPv6 addresses that are elided.
+func Dissect(frame *wpan.MacFrame) (*Header, error) {
+	data := frame.Payload
+	hdr := &Header{}
+	n := 0
+	for {
+		if len(data) <= n {
+			return nil, errTruncated("dispatch")
+		}
+		dispatch := data[n]
+		switch {
+		case dispatch&0xc0 == 0x80: // mesh header
+			mesh, meshLen, err := dissectMesh(data[n:])
+			if err != nil {
+				return nil, err
+			}
+			hdr.Mesh = mesh
+			n += meshLen
+			continue
+		case dispatch&0xf8 == 0xc0 || dispatch&0xf8 == 0xe0: // FRAG1 or FRAGN
+			first := dispatch&0xf8 == 0xc0
+			fragLen := 4
+			if !first {
+				fragLen = 5
+			}
+			if len(data)-n < fragLen {
+				return nil, errTruncated("fragment header")
+			}
+			hdr.Frag = &FragHeader{
+				Size:  binary.BigEndian.Uint16(data[n:n+2]) & 0x07ff,
+				Tag:   binary.BigEndian.Uint16(data[n+2 : n+4]),
+				First: first,
+			}
+			if !first {
+				hdr.Frag.Offset = uint16(data[n+4]) * 8
+			}
+			n += fragLen
+			if !first {
+				hdr.Payload = data[n:]
+				return hdr, nil
+			}
+			continue
+		case dispatch&0xe0 == 0x60: // IPHC
+			return hdr, hdr.dissectIphc(data[n:], frame)
+		case dispatch == 0x41: // uncompressed IPv6
+			return hdr, hdr.dissectIpv6(data[n+1:])
+		default:
+			return nil, fmt.Errorf("unsupported 6LoWPAN dispatch 0x%02x", dispatch)
+		}
+	}
+}
+
+func dissectMesh(data []byte) (*MeshHeader, int, error) {
+	dispatch := data[0]
+	mesh := &MeshHeader{HopsLeft: dispatch & 0x0f}
+	n := 1
+	if mesh.HopsLeft == 0x0f {
+		if len(data) < 2 {
+			return nil, 0, errTruncated("mesh header")
+		}
+		mesh.HopsLeft = data[1]
+		n++
+	}
+	for i, isShort := range []bool{dispatch&0x20 != 0, dispatch&0x10 != 0} {
+		addrLen := 8
+		if isShort {
+			addrLen = 2
+		}
+		if len(data)-n < addrLen {
+			return nil, 0, errTruncated("mesh header")
+		}
+		var short uint16
+		var ext uint64
+		if isShort {
+			short = binary.BigEndian.Uint16(data[n : n+2])
+		} else {
+			ext = binary.BigEndian.Uint64(data[n : n+8])
+		}
+		if i == 0 {
+			mesh.OriginatorShort, mesh.OriginatorExt = short, ext
+		} else {
+			mesh.FinalShort, mesh.FinalExt = short, ext
+		}
+		n += addrLen
+	}
+	return mesh, n, nil
+}
+
+func (hdr *Header) dissectIpv6(data []byte) error {
+	if len(data) < ipv6HeaderLen {
+		return errTruncated("IPv6 header")
+	}
+	hdr.Ipv6 = &IphcHeader{
+		NextHeader: data[6],
+		HopLimit:   data[7],
+		SrcAddr:    net.IP(data[8:24]),
+		DstAddr:    net.IP(data[24:40]),
+	}
+	return hdr.dissectNextHeader(data[ipv6HeaderLen:], hdr.Ipv6.NextHeader)
+}
+
+func (hdr *Header) dissectIphc(data []byte, frame *wpan.MacFrame) error {
+	if len(data) < 2 {
+		return errTruncated("IPHC header")
+	}
+	iphc := binary.BigEndian.Uint16(data[0:2])
+	ip := &IphcHeader{Compressed: true, Iphc: iphc}
+	hdr.Ipv6 = ip
+	n := 2
+	need := func(size int, field string) error {
+		if len(data)-n < size {
+			return errTruncated(field)
+		}
+		return nil
+	}
+
+	tf := (iphc >> 11) & 0x03
+	nh := (iphc >> 10) & 0x01
+	hlim := (iphc >> 8) & 0x03
+	cid := (iphc >> 7) & 0x01
+	sac := (iphc >> 6) & 0x01
+	sam := (iphc >> 4) & 0x03
+	multicast := (iphc >> 3) & 0x01
+	dac := (iphc >> 2) & 0x01
+	dam := iphc & 0x03
+
+	if cid == 1 {
+		if err := need(1, "context identifier"); err != nil {
+			return err
+		}
+		ip.ContextIds = data[n]
+		n++
+	}
+	tfLen := [4]int{4, 3, 1, 0}[tf]
+	if err := need(tfLen, "traffic class"); err != nil {
+		return err
+	}
+	n += tfLen
+	if nh == 0 {
+		if err := need(1, "next header"); err != nil {
+			return err
+		}
+		ip.NextHeader = data[n]
+		n++
+	}
+	switch hlim {
+	case 0:
+		if err := need(1, "hop limit"); err != nil {
+			return err
+		}
+		ip.HopLimit = data[n]
+		n++
+	case 1:
+		ip.HopLimit = 1
+	case 2:
+		ip.HopLimit = 64
+	case 3:
+		ip.HopLimit = 255
+	}
+
+	// source address
+	var err error
+	srcLen := [4]int{16, 8, 2, 0}[sam]
+	if sac == 1 && sam == 0 {
+		srcLen = 0 // the unspecified address
+	}
+	if err = need(srcLen, "source address"); err != nil {
+		return err
+	}
+	if sac == 0 {
+		ip.SrcAddr = linkLocalAddr(data[n:n+srcLen], frame.FrameControl.SourceAddrMode(), frame.SrcAddrShort,
+			frame.SrcAddrExtended)
+	} else if sam == 0 {
+		ip.SrcAddr = net.IPv6unspecified
+	}
+	n += srcLen
+
+	// destination address
+	var dstLen int
+	if multicast == 0 {
+		dstLen = [4]int{16, 8, 2, 0}[dam]
+		if dac == 1 && dam == 0 {
+			return fmt.Errorf("reserved IPHC destination address mode")
+		}
+	} else if dac == 0 {
+		dstLen = [4]int{16, 6, 4, 1}[dam]
+	} else if dam == 0 {
+		dstLen = 6
+	} else {
+		return fmt.Errorf("reserved IPHC destination address mode")
+	}
+	if err = need(dstLen, "destination address"); err != nil {
+		return err
+	}
+	addr := data[n : n+dstLen]
+	if multicast == 0 && dac == 0 {
+		ip.DstAddr = linkLocalAddr(addr, frame.FrameControl.DestAddrMode(), frame.DstAddrShort, frame.DstAddrExtended)
+	} else if multicast == 1 && dac == 0 {
+		ip.DstAddr = multicastAddr(addr)
+	}
+	n += dstLen
+
+	if nh == 0 {
+		return hdr.dissectNextHeader(data[n:], ip.NextHeader)
+	}
+	return hdr.dissectNhc(data[n:])
+}
+
+// dissectNhc parses the next header compression (NHC) encoded headers, RFC 6282 section 4.
+func (hdr *Header) dissectNhc(data []byte) error {
+	n := 0
+	for {
+		if len(data) <= n {
+			return errTruncated("NHC header")
+		}
+		nhc := data[n]
+		switch {
+		case nhc&0xf8 == 0xf0: // UDP
+			hdr.Ipv6.NextHeader = IpProtoUdp
+			return hdr.dissectNhcUdp(data[n:])
+		case nhc&0xf0 == 0xe0: // IPv6 extension header
+			eid := (nhc >> 1) & 0x07
+			if eid == 7 {
+				hdr.Ipv6.NextHeader = IpProtoIpv6 // an encapsulated IPv6 packet follows.
+				hdr.Payload = data[n+1:]
+				return nil
+			}
+			n++
+			isNextCompressed := nhc&0x01 != 0
+			nextHeader := uint8(0)
+			if !isNextCompressed {
+				if len(data) <= n {
+					return errTruncated("extension header")
+				}
+				nextHeader = data[n]
+				n++
+			}
+			if len(data) <= n || len(data)-n-1 < int(data[n]) {
+				return errTruncated("extension header")
+			}
+			n += 1 + int(data[n])
+			if !isNextCompressed {
+				hdr.Ipv6.NextHeader = nextHeader
+				return hdr.dissectNextHeader(data[n:], nextHeader)
+			}
+		default:
+			return fmt.Errorf("unsupported NHC encoding 0x%02x", nhc)
+		}
+	}
+}
+
+func (hdr *Header) dissectNhcUdp(data []byte) error {
+	nhc := data[0]
+	portsLen := [4]int{4, 3, 3, 1}[nhc&0x03]
+	checksumLen := 2
+	if nhc&0x04 != 0 {
+		checksumLen = 0
+	}
+	if len(data) < 1+portsLen+checksumLen {
+		return errTruncated("UDP header")
+	}
+	p := data[1:]
+	udp := &UdpHeader{}
+	switch nhc & 0x03 {
+	case 0:
+		udp.SrcPort = binary.BigEndian.Uint16(p[0:2])
+		udp.DstPort = binary.BigEndian.Uint16(p[2:4])
+	case 1:
+		udp.SrcPort = binary.BigEndian.Uint16(p[0:2])
+		udp.DstPort = 0xf000 | uint16(p[2])
+	case 2:
+		udp.SrcPort = 0xf000 | uint16(p[0])
+		udp.DstPort = binary.BigEndian.Uint16(p[1:3])
+	case 3:
+		udp.SrcPort = 0xf0b0 | uint16(p[0]>>4)
+		udp.DstPort = 0xf0b0 | uint16(p[0]&0x0f)
+	}
+	hdr.Udp = udp
+	hdr.Payload = data[1+portsLen+checksumLen:]
+	return nil
+}
+
+// dissectNextHeader parses the inline, i.e. uncompressed, upper layer header.
+func (hdr *Header) dissectNextHeader(data []byte, nextHeader uint8) error {
+	if nextHeader != IpProtoUdp {
+		hdr.Payload = data
+		return nil
+	}
+	if len(data) < udpHeaderLen {
+		return errTruncated("UDP header")
+	}
+	hdr.Udp = &UdpHeader{
+		SrcPort: binary.BigEndian.Uint16(data[0:2]),
+		DstPort: binary.BigEndian.Uint16(data[2:4]),
+	}
+	hdr.Payload = data[udpHeaderLen:]
+	return nil
+}
+
+// linkLocalAddr returns the link-local or inline address of the (possibly partly) inline address bytes, with
+// the interface identifier derived from the MAC address if it is elided.
+func linkLocalAddr(inline []byte, macAddrMode uint16, macShort uint16, macExt uint64) net.IP {
+	if len(inline) == 16 {
+		return net.IP(inline)
+	}
+	addr := make(net.IP, 16)
+	addr[0], addr[1] = 0xfe, 0x80
+	switch len(inline) {
+	case 8:
+		copy(addr[8:], inline)
+	case 2:
+		copy(addr[11:13], []byte{0xff, 0xfe})
+		copy(addr[14:], inline)
+	case 0:
+		switch macAddrMode {
+		case wpan.AddrModeExtended:
+			binary.BigEndian.PutUint64(addr[8:], macExt)
+			addr[8] ^= 0x02
+		case wpan.AddrModeShort:
+			copy(addr[11:13], []byte{0xff, 0xfe})
+			binary.BigEndian.PutUint16(addr[14:], macShort)
+		default:
+			return nil
+		}
+	}
+	return addr
+}
+
+// multicastAddr returns the multicast address of the compressed (16, 6, 4 or 1 byte) inline bytes.
+func multicastAddr(inline []byte) net.IP {
+	addr := make(net.IP, 16)
+	switch len(inline) {
+	case 16:
+		copy(addr, inline)
+	case 6:
+		addr[0], addr[1] = 0xff, inline[0]
+		copy(addr[11:], inline[1:])
+	case 4:
+		addr[0], addr[1] = 0xff, inline[0]
+		copy(addr[13:], inline[1:])
+	case 1:
+		addr[0], addr[1] = 0xff, 0x02
+		addr[15] = inline[0]
+	}
+	return addr
+}
+
+func errTruncated(field string) error {
+	return fmt.Errorf("6LoWPAN frame truncated in %s", field)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package lowpan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/dissectpkt/wpan"
+)
+
+func macFrame(srcShort, dstShort uint16, payload []byte) *wpan.MacFrame {
+	frame := &wpan.MacFrame{
+		SrcAddrShort: srcShort,
+		DstAddrShort: dstShort,
+		Payload:      payload,
+	}
+	frame.FrameControl.Dissect([]byte{0x41, 0x88}) // data frame, short addresses, PAN ID compression.
+	return frame
+}
+
+func TestDissectFrag1Iphc(t *testing.T) {
+	// FRAG1 of a 300 byte datagram; IPHC with addresses derived from the MAC short addresses, inline
+	// next header ICMPv6 and hop limit 64.
+	hdr, err := Dissect(macFrame(0x0400, 0x6c00, []byte{0xc1, 0x2c, 0xab, 0xcd, 0x7a, 0x33, 0x3a, 0x80, 0x00}))
+	assert.Nil(t, err)
+	assert.Equal(t, &FragHeader{Size: 300, Tag: 0xabcd, First: true}, hdr.Frag)
+	assert.True(t, hdr.Ipv6.Compressed)
+	assert.Equal(t, uint8(IpProtoIcmpv6), hdr.Ipv6.NextHeader)
+	assert.Equal(t, uint8(64), hdr.Ipv6.HopLimit)
+	assert.Equal(t, "fe80::ff:fe00:400", hdr.Ipv6.SrcAddr.String())
+	assert.Equal(t, "fe80::ff:fe00:6c00", hdr.Ipv6.DstAddr.String())
+	assert.Nil(t, hdr.Udp)
+	assert.Equal(t, []byte{0x80, 0x00}, hdr.Payload)
+}
+
+func TestDissectMeshFragN(t *testing.T) {
+	// mesh header (hops left 5, short originator and final addresses) followed by a FRAGN.
+	hdr, err := Dissect(macFrame(0x0400, 0x6c00, []byte{0xb5, 0x04, 0x01, 0x6c, 0x02, 0xe1, 0x2c, 0xab, 0xcd, 0x0c, 0x01}))
+	assert.Nil(t, err)
+	assert.Equal(t, &MeshHeader{HopsLeft: 5, OriginatorShort: 0x0401, FinalShort: 0x6c02}, hdr.Mesh)
+	assert.Equal(t, &FragHeader{Size: 300, Tag: 0xabcd, Offset: 96}, hdr.Frag)
+	assert.Nil(t, hdr.Ipv6)
+	assert.Equal(t, []byte{0x01}, hdr.Payload)
+}
+
+func TestDissectErrors(t *testing.T) {
+	_, err := Dissect(macFrame(0x0400, 0x6c00, nil))
+	assert.NotNil(t, err)
+	_, err = Dissect(macFrame(0x0400, 0x6c00, []byte{0xc1, 0x2c}))
+	assert.NotNil(t, err)
+	_, err = Dissect(macFrame(0x0400, 0x6c00, []byte{0x7a, 0x33}))
+	assert.NotNil(t, err)
+	_, err = Dissect(macFrame(0x0400, 0x6c00, []byte{0x01, 0x02}))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package dissectpkt
+
+import (
+	"fmt"
+)
+
+const (
+	MlePort = 19788
+	TmfPort = 61631 // Thread Management Framework (CoAP)
+
+	mleSecuritySuite154  = 0   // MLE message secured with 802.15.4 security
+	mleSecuritySuiteNone = 255 // MLE message not secured
+)
+
+// MLE command types, Thread specification section 4.4.
+const (
+	MleLinkRequest          = 0
+	MleLinkAccept           = 1
+	MleLinkAcceptAndRequest = 2
+	MleLinkReject           = 3
+	MleAdvertisement        = 4
+	MleUpdate               = 5
+	MleUpdateRequest        = 6
+	MleDataRequest          = 7
+	MleDataResponse         = 8
+	MleParentRequest        = 9
+	MleParentResponse       = 10
+	MleChildIdRequest       = 11
+	MleChildIdResponse      = 12
+	MleChildUpdateRequest   = 13
+	MleChildUpdateResponse  = 14
+	MleAnnounce             = 15
+	MleDiscoveryRequest     = 16
+	MleDiscoveryResponse    = 17
+	MleLinkMetricsMgmtReq   = 18
+	MleLinkMetricsMgmtRsp   = 19
+	MleLinkProbe            = 20
+)
+
+var mleCommandNames = map[uint8]string{
+	MleLinkRequest:          "Link Request",
+	MleLinkAccept:           "Link Accept",
+	MleLinkAcceptAndRequest: "Link Accept and Request",
+	MleLinkReject:           "Link Reject",
+	MleAdvertisement:        "Advertisement",
+	MleUpdate:               "Update",
+	MleUpdateRequest:        "Update Request",
+	MleDataRequest:          "Data Request",
+	MleDataResponse:         "Data Response",
+	MleParentRequest:        "Parent Request",
+	MleParentResponse:       "Parent Response",
+	MleChildIdRequest:       "Child ID Request",
+	MleChildIdResponse:      "Child ID Response",
+	MleChildUpdateRequest:   "Child Update Request",
+	MleChildUpdateResponse:  "Child Update Response",
+	MleAnnounce:             "Announce",
+	MleDiscoveryRequest:     "Discovery Request",
+	MleDiscoveryResponse:    "Discovery Response",
+	MleLinkMetricsMgmtReq:   "Link Metrics Management Request",
+	MleLinkMetricsMgmtRsp:   "Link Metrics Management Response",
+	MleLinkProbe:            "Link Probe",
+}
+
+// MleCommandName returns the name of MLE command type cmd.
+func MleCommandName(cmd uint8) string {
+	if name, ok := mleCommandNames[cmd]; ok {
+		return name
+	}
+	return fmt.Sprintf("Command %d", cmd)
+}
+
+// MleMessage is an MLE message. Its command type is only known if the message is not secured, which is the
+// case for discovery messages, or if it was decrypted; other MLE messages are secured at the MLE layer.
+type MleMessage struct {
+	Secured     bool
+	Decrypted   bool
+	CommandType uint8 // valid if not Secured, or Decrypted
+}
+
+func (m *MleMessage) String() string {
+	if m.Secured && !m.Decrypted {
+		return "MLE"
+	}
+	return "MLE " + MleCommandName(m.CommandType)
+}
+
+func dissectMle(payload []byte) *MleMessage {
+	if len(payload) < 1 {
+		return nil
+	}
+	switch payload[0] {
+	case mleSecuritySuite154:
+		return &MleMessage{Secured: true}
+	case mleSecuritySuiteNone:
+		if len(payload) < 2 {
+			return nil
+		}
+		return &MleMessage{CommandType: payload[1]}
+	default:
+		return nil
+	}
+}
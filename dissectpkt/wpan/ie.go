@@ -0,0 +1,97 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package wpan
+
+import (
+	"encoding/binary"
+)
+
+// Header IE element IDs, Table 7-7, 802.15.4-2015.
+const (
+	HeaderIeCsl          = 0x1a
+	HeaderIeVendor       = 0x00
+	HeaderIeTermination1 = 0x7e // header termination, followed by payload IEs
+	HeaderIeTermination2 = 0x7f // header termination, followed by payload
+)
+
+// Payload IE group IDs, Table 7-15, 802.15.4-2015.
+const (
+	PayloadIeMlme        = 0x1
+	PayloadIeVendor      = 0x2
+	PayloadIeTermination = 0xf
+)
+
+// IE is a header or payload information element.
+type IE struct {
+	Id      uint16 // the element ID of a header IE, or the group ID of a payload IE
+	Content []byte
+}
+
+// dissectHeaderIEs parses header IEs. It returns the IEs, their length, and whether payload IEs follow.
+func dissectHeaderIEs(data []byte) ([]IE, int, bool, error) {
+	var ies []IE
+	n := 0
+	for len(data)-n >= 2 {
+		desc := binary.LittleEndian.Uint16(data[n : n+2])
+		ieLen := int(desc & 0x7f)
+		id := (desc >> 7) & 0xff
+		n += 2
+		if len(data)-n < ieLen {
+			return ies, n, false, errTruncated("header IE")
+		}
+		switch id {
+		case HeaderIeTermination1:
+			return ies, n, true, nil
+		case HeaderIeTermination2:
+			return ies, n, false, nil
+		}
+		ies = append(ies, IE{Id: id, Content: data[n : n+ieLen]})
+		n += ieLen
+	}
+	return ies, n, false, nil
+}
+
+// dissectPayloadIEs parses payload IEs, and returns the IEs and their length.
+func dissectPayloadIEs(data []byte) ([]IE, int, error) {
+	var ies []IE
+	n := 0
+	for len(data)-n >= 2 {
+		desc := binary.LittleEndian.Uint16(data[n : n+2])
+		ieLen := int(desc & 0x7ff)
+		id := (desc >> 11) & 0x0f
+		n += 2
+		if len(data)-n < ieLen {
+			return ies, n, errTruncated("payload IE")
+		}
+		if id == PayloadIeTermination {
+			break
+		}
+		ies = append(ies, IE{Id: id, Content: data[n : n+ieLen]})
+		n += ieLen
+	}
+	return ies, n, nil
+}
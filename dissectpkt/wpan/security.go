@@ -0,0 +1,147 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package wpan
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Security levels, Table 9-6, 802.15.4-2015.
+const (
+	SecurityLevelNone     = 0
+	SecurityLevelEncMic32 = 5
+)
+
+// Key identifier modes, Table 9-7, 802.15.4-2015.
+const (
+	KeyIdMode0 = 0 // key determined implicitly
+	KeyIdMode1 = 1 // key index
+	KeyIdMode2 = 2 // 4-octet key source and key index
+	KeyIdMode3 = 3 // 8-octet key source and key index
+)
+
+// AuxSecurityHeader is the auxiliary security header of a secured frame, section 9.4, 802.15.4-2015.
+type AuxSecurityHeader struct {
+	SecurityControl uint8
+	FrameCounter    uint32 // not present if FrameCounterSuppressed()
+	KeySource       []byte // 0, 4 or 8 bytes, depending on KeyIdMode()
+	KeyIndex        uint8  // not present for KeyIdMode0
+}
+
+func (h *AuxSecurityHeader) SecurityLevel() uint8 {
+	return h.SecurityControl & 0x07
+}
+
+func (h *AuxSecurityHeader) KeyIdMode() uint8 {
+	return (h.SecurityControl >> 3) & 0x03
+}
+
+func (h *AuxSecurityHeader) FrameCounterSuppressed() bool {
+	return (h.SecurityControl & 0x20) != 0
+}
+
+func (h *AuxSecurityHeader) AsnInNonce() bool {
+	return (h.SecurityControl & 0x40) != 0
+}
+
+// IsEncrypted returns true if the frame payload is encrypted, i.e. security levels 4 to 7.
+func (h *AuxSecurityHeader) IsEncrypted() bool {
+	return (h.SecurityLevel() & 0x04) != 0
+}
+
+// MicLen returns the length of the MIC at the end of the frame payload.
+func (h *AuxSecurityHeader) MicLen() int {
+	switch h.SecurityLevel() & 0x03 {
+	case 1:
+		return 4
+	case 2:
+		return 8
+	case 3:
+		return 16
+	default:
+		return 0
+	}
+}
+
+// KeyIdLen returns the length of the key identifier field.
+func (h *AuxSecurityHeader) KeyIdLen() int {
+	switch h.KeyIdMode() {
+	case KeyIdMode1:
+		return 1
+	case KeyIdMode2:
+		return 5
+	case KeyIdMode3:
+		return 9
+	default:
+		return 0
+	}
+}
+
+// Len returns the length of the auxiliary security header.
+func (h *AuxSecurityHeader) Len() int {
+	n := 1 + h.KeyIdLen()
+	if !h.FrameCounterSuppressed() {
+		n += 4
+	}
+	return n
+}
+
+// Dissect parses the auxiliary security header and returns its length.
+func (h *AuxSecurityHeader) Dissect(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, errTruncated("auxiliary security header")
+	}
+	h.SecurityControl = data[0]
+	n := 1
+	if !h.FrameCounterSuppressed() {
+		if len(data) < n+4 {
+			return 0, errTruncated("frame counter")
+		}
+		h.FrameCounter = binary.LittleEndian.Uint32(data[n : n+4])
+		n += 4
+	}
+	keyIdLen := h.KeyIdLen()
+	if len(data) < n+keyIdLen {
+		return 0, errTruncated("key identifier")
+	}
+	if keyIdLen > 0 {
+		h.KeySource = data[n : n+keyIdLen-1]
+		h.KeyIndex = data[n+keyIdLen-1]
+		n += keyIdLen
+	}
+	return n, nil
+}
+
+func (h *AuxSecurityHeader) String() string {
+	return fmt.Sprintf("SecLvl:%d,KeyIdMode:%d,KeyIdx:%d,FC:%d", h.SecurityLevel(), h.KeyIdMode(), h.KeyIndex,
+		h.FrameCounter)
+}
+
+func errTruncated(field string) error {
+	return fmt.Errorf("frame truncated in %s", field)
+}
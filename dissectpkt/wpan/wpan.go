@@ -139,6 +139,39 @@ func (fc *FrameControl) HasSourcePanIdField() bool {
 	return !pc
 }
 
+// MAC command frame identifiers, Table 7-49, 802.15.4-2015.
+const (
+	CommandAssociationRequest  = 0x01
+	CommandAssociationResponse = 0x02
+	CommandDisassociation      = 0x03
+	CommandDataRequest         = 0x04
+	CommandPanIdConflict       = 0x05
+	CommandOrphanNotification  = 0x06
+	CommandBeaconRequest       = 0x07
+	CommandCoordRealignment    = 0x08
+)
+
+var commandNames = map[uint8]string{
+	CommandAssociationRequest:  "Association Request",
+	CommandAssociationResponse: "Association Response",
+	CommandDisassociation:      "Disassociation Notification",
+	CommandDataRequest:         "Data Request",
+	CommandPanIdConflict:       "PAN ID Conflict Notification",
+	CommandOrphanNotification:  "Orphan Notification",
+	CommandBeaconRequest:       "Beacon Request",
+	CommandCoordRealignment:    "Coordinator Realignment",
+}
+
+// CommandName returns the name of MAC command frame identifier id.
+func CommandName(id uint8) string {
+	if name, ok := commandNames[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("Command 0x%02x", id)
+}
+
+const fcsLen = 2
+
 type MacFrame struct {
 	Channel         uint8
 	FrameControl    FrameControl
@@ -149,6 +182,21 @@ type MacFrame struct {
 	SrcAddrShort    uint16
 	DstAddrExtended uint64
 	SrcAddrExtended uint64
+
+	Security   *AuxSecurityHeader // nil if security is not enabled.
+	HeaderIEs  []IE
+	PayloadIEs []IE   // only parsed if not encrypted.
+	CommandId  uint8  // for MAC command frames, if known; 0 otherwise.
+	Payload    []byte // the MAC payload, excluding payload IEs and MIC; encrypted if Security.IsEncrypted().
+	Err        error  // set if the frame could not be fully dissected, e.g. because it is truncated.
+
+	SecurityOffset int // offset of the auxiliary security header in the frame data, if Security is set.
+	PayloadOffset  int // offset of Payload in the frame data.
+}
+
+// IsEncrypted returns true if the payload of the frame is encrypted.
+func (f *MacFrame) IsEncrypted() bool {
+	return f.Security != nil && f.Security.IsEncrypted()
 }
 
 func (f *MacFrame) String() string {
@@ -169,29 +217,56 @@ func (f *MacFrame) String() string {
 	return fmt.Sprintf("MAC,FC:%s,Seq:%d,Dst:%s", f.FrameControl, f.Seq, dstAddrS)
 }
 
+// Dissect parses the frame, which is preceded by the channel byte and includes the FCS. It never fails: if
+// the frame is malformed, the fields up to that point are set, and Err describes the problem.
 func Dissect(data []byte) *MacFrame {
 	frame := &MacFrame{}
+	if len(data) < 3+fcsLen {
+		frame.Err = errTruncated("frame control")
+		return frame
+	}
 	frame.Channel = data[0] // not part of 802.15.4, but part of our custom frame format.
 	frame.FrameControl.Dissect(data[1:3])
 	if frame.FrameControl.FrameType() > FrameTypeCommand {
 		return frame // for unsupported frame types.
 	}
 
+	end := len(data) - fcsLen
 	n := 3
+	need := func(size int, field string) bool {
+		if end-n < size {
+			frame.Err = errTruncated(field)
+			return false
+		}
+		return true
+	}
+
 	if !frame.FrameControl.SequenceNumberSuppression() {
+		if !need(1, "sequence number") {
+			return frame
+		}
 		frame.Seq = data[n]
 		n += 1
 	}
 	if frame.FrameControl.HasDestPanIdField() {
+		if !need(2, "destination PAN ID") {
+			return frame
+		}
 		frame.DstPanId = binary.LittleEndian.Uint16(data[n : n+2])
 		n += 2
 	}
 
 	switch frame.FrameControl.DestAddrMode() {
 	case AddrModeExtended:
+		if !need(8, "destination address") {
+			return frame
+		}
 		frame.DstAddrExtended = binary.LittleEndian.Uint64(data[n : n+8])
 		n += 8
 	case AddrModeShort:
+		if !need(2, "destination address") {
+			return frame
+		}
 		frame.DstAddrShort = binary.LittleEndian.Uint16(data[n : n+2])
 		n += 2
 	default:
@@ -199,18 +274,84 @@ func Dissect(data []byte) *MacFrame {
 	}
 
 	if frame.FrameControl.HasSourcePanIdField() {
+		if !need(2, "source PAN ID") {
+			return frame
+		}
 		frame.SrcPanId = binary.LittleEndian.Uint16(data[n : n+2])
 		n += 2
 	}
 
 	switch frame.FrameControl.SourceAddrMode() {
 	case AddrModeExtended:
+		if !need(8, "source address") {
+			return frame
+		}
 		frame.SrcAddrExtended = binary.LittleEndian.Uint64(data[n : n+8])
+		n += 8
 	case AddrModeShort:
+		if !need(2, "source address") {
+			return frame
+		}
 		frame.SrcAddrShort = binary.LittleEndian.Uint16(data[n : n+2])
+		n += 2
 	default:
 		break
 	}
 
+	if frame.FrameControl.FrameType() == FrameTypeAck && frame.FrameControl.FrameVersion() < 2 {
+		return frame // an Imm-Ack has no further fields.
+	}
+
+	if frame.FrameControl.SecurityEnabled() {
+		frame.Security = &AuxSecurityHeader{}
+		frame.SecurityOffset = n
+		secLen, err := frame.Security.Dissect(data[n:end])
+		if err != nil {
+			frame.Err = err
+			return frame
+		}
+		n += secLen
+		if !need(frame.Security.MicLen(), "MIC") {
+			return frame
+		}
+		end -= frame.Security.MicLen()
+	}
+
+	payloadIEsPresent := false
+	if frame.FrameControl.IEPresent() {
+		ies, ieLen, hasPayloadIEs, err := dissectHeaderIEs(data[n:end])
+		frame.HeaderIEs = ies
+		n += ieLen
+		if err != nil {
+			frame.Err = err
+			return frame
+		}
+		payloadIEsPresent = hasPayloadIEs
+	}
+	if payloadIEsPresent {
+		if frame.IsEncrypted() {
+			// payload IEs are encrypted along with the payload.
+			frame.Payload = data[n:end]
+			frame.PayloadOffset = n
+			return frame
+		}
+		ies, ieLen, err := dissectPayloadIEs(data[n:end])
+		frame.PayloadIEs = ies
+		n += ieLen
+		if err != nil {
+			frame.Err = err
+			return frame
+		}
+	}
+
+	frame.Payload = data[n:end]
+	frame.PayloadOffset = n
+	if frame.FrameControl.FrameType() == FrameTypeCommand {
+		// the command ID is not encrypted, section 9.3.5, 802.15.4-2015.
+		if !need(1, "command ID") {
+			return frame
+		}
+		frame.CommandId = data[n]
+	}
 	return frame
 }
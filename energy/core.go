@@ -81,15 +81,7 @@ func (e *EnergyAnalyser) StoreNetworkEnergy(timestamp uint64) {
 
 	netSize := float64(len(e.nodes))
 	for _, node := range e.nodes {
-		node.ComputeRadioState(timestamp)
-
-		e := &pb.NodeEnergy{
-			NodeId:   int32(node.nodeId),
-			Disabled: float64(node.radio.SpentDisabled) * RadioDisabledConsumption,
-			Sleep:    float64(node.radio.SpentSleep) * RadioSleepConsumption,
-			Tx:       float64(node.radio.SpentTx) * RadioTxConsumption,
-			Rx:       float64(node.radio.SpentRx) * RadioRxConsumption,
-		}
+		e := node.GetEnergy(timestamp)
 
 		networkSnapshot.EnergyConsDisabled += e.Disabled / netSize
 		networkSnapshot.EnergyConsSleep += e.Sleep / netSize
@@ -102,6 +94,19 @@ func (e *EnergyAnalyser) StoreNetworkEnergy(timestamp uint64) {
 	e.energyHistoryByNodes = append(e.energyHistoryByNodes, nodesEnergySnapshot)
 }
 
+// GetTotalEnergy gets the energy (mJ) consumed by all nodes together up to the timestamp, per radio state.
+func (e *EnergyAnalyser) GetTotalEnergy(timestamp uint64) *pb.NodeEnergy {
+	total := &pb.NodeEnergy{NodeId: -1}
+	for _, node := range e.nodes {
+		ne := node.GetEnergy(timestamp)
+		total.Disabled += ne.Disabled
+		total.Sleep += ne.Sleep
+		total.Tx += ne.Tx
+		total.Rx += ne.Rx
+	}
+	return total
+}
+
 func (e *EnergyAnalyser) SaveEnergyDataToFile(name string, timestamp uint64) {
 	if name == "" {
 		if e.title == "" {
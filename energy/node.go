@@ -29,6 +29,7 @@ package energy
 import (
 	"github.com/openthread/ot-ns/logger"
 	. "github.com/openthread/ot-ns/types"
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
 )
 
 type NodeEnergy struct {
@@ -61,6 +62,18 @@ func (node *NodeEnergy) SetRadioState(state RadioStates, timestamp uint64) {
 	node.radio.State = state
 }
 
+// GetEnergy gets the energy (mJ) consumed by the node up to the timestamp, per radio state.
+func (node *NodeEnergy) GetEnergy(timestamp uint64) *pb.NodeEnergy {
+	node.ComputeRadioState(timestamp)
+	return &pb.NodeEnergy{
+		NodeId:   int32(node.nodeId),
+		Disabled: float64(node.radio.SpentDisabled) * RadioDisabledConsumption,
+		Sleep:    float64(node.radio.SpentSleep) * RadioSleepConsumption,
+		Tx:       float64(node.radio.SpentTx) * RadioTxConsumption,
+		Rx:       float64(node.radio.SpentRx) * RadioRxConsumption,
+	}
+}
+
 func newNode(nodeID int, timestamp uint64) *NodeEnergy {
 	node := &NodeEnergy{
 		nodeId: nodeID,
@@ -78,6 +78,7 @@ type Event struct {
 	Timestamp    uint64
 	MustDispatch bool
 	Conn         net.Conn
+	ParseError   error // set if the Event data received from the node was malformed.
 
 	// supplementary payload data stored in Event.Data, depends on the event type.
 	RadioCommData  RadioCommEventData
@@ -106,9 +107,20 @@ type RadioStateEventData struct {
 	RadioTime   uint64
 }
 
+const extAddrEventDataLen = 8
+
+// NodeInfo event data is either the legacy format, with only the node ID, or the extended format
+// used for the protocol handshake. See CheckCompatible().
 const nodeInfoEventDataHeaderLen = 4 // from OT-RFSIM platform, otSimSendNodeInfoEvent()
+const nodeInfoEventDataExtHeaderLen = 13
+
 type NodeInfoEventData struct {
-	NodeId types.NodeId
+	NodeId                  types.NodeId
+	ProtocolVersion         ProtocolVersion
+	Capabilities            Capabilities
+	EventHeaderLen          uint8
+	RadioCommDataHeaderLen  uint8
+	RadioStateDataHeaderLen uint8
 }
 
 /*
@@ -139,6 +151,15 @@ func (e *Event) Serialize() []byte {
 		extraFields = []byte{e.RadioCommData.Channel, byte(e.RadioCommData.PowerDbm), e.RadioCommData.Error,
 			0, 0, 0, 0, 0, 0, 0, 0}
 		binary.LittleEndian.PutUint64(extraFields[3:], e.RadioCommData.Duration)
+	case EventTypeNodeInfo:
+		s := &e.NodeInfoData
+		extraFields = make([]byte, nodeInfoEventDataExtHeaderLen)
+		binary.LittleEndian.PutUint32(extraFields[0:4], uint32(s.NodeId))
+		binary.LittleEndian.PutUint16(extraFields[4:6], uint16(s.ProtocolVersion))
+		binary.LittleEndian.PutUint32(extraFields[6:10], uint32(s.Capabilities))
+		extraFields[10] = s.EventHeaderLen
+		extraFields[11] = s.RadioCommDataHeaderLen
+		extraFields[12] = s.RadioStateDataHeaderLen
 	default:
 		break
 	}
@@ -157,55 +178,89 @@ func (e *Event) Serialize() []byte {
 
 // Deserialize deserializes []byte Event fields (as received from OpenThread node) into the Event object e.
 // It returns the number of bytes used from `data` for the Deserialize operation, or 0 if the data buffer
-// is incomplete i.e. does not contain one entire serialized Event.
-func (e *Event) Deserialize(data []byte) int {
+// is incomplete i.e. does not contain one entire serialized Event. If the Event is complete but its data
+// is malformed, an error is returned along with the number of bytes used, so that the caller can skip it.
+func (e *Event) Deserialize(data []byte) (int, error) {
 	n := len(data)
 	if n < eventMsgHeaderLen {
-		return 0
+		return 0, nil
 	}
 	e.Delay = binary.LittleEndian.Uint64(data[:8])
 	e.Type = data[8]
 	e.MsgId = binary.LittleEndian.Uint64(data[9:17])
-	datalen := binary.LittleEndian.Uint16(data[17:19])
-	var payloadOffset uint16 = 0
-	if datalen > uint16(n-eventMsgHeaderLen) {
-		return 0
+	datalen := int(binary.LittleEndian.Uint16(data[17:19]))
+	if datalen > n-eventMsgHeaderLen {
+		return 0, nil
 	}
-	e.Data = data[eventMsgHeaderLen : eventMsgHeaderLen+datalen]
+	evtLen := eventMsgHeaderLen + datalen
+	e.Data = data[eventMsgHeaderLen:evtLen]
+
+	// e.Timestamp is not in the event, so set to invalid initially.
+	e.Timestamp = InvalidTimestamp
 
 	// Detect composite event types
+	payloadOffset := 0
 	switch e.Type {
 	case EventTypeRadioChannelSample:
+		if datalen < radioCommEventDataHeaderLen {
+			return evtLen, e.dataLenError(radioCommEventDataHeaderLen)
+		}
 		e.RadioCommData = deserializeRadioCommData(e.Data)
 		payloadOffset += radioCommEventDataHeaderLen
 	case EventTypeRadioRxDone:
 		fallthrough
 	case EventTypeRadioCommStart:
+		if datalen < radioCommEventDataHeaderLen+1 {
+			return evtLen, e.dataLenError(radioCommEventDataHeaderLen + 1)
+		}
 		e.RadioCommData = deserializeRadioCommData(e.Data)
 		payloadOffset += radioCommEventDataHeaderLen
-		logger.AssertEqual(e.RadioCommData.Channel, e.Data[payloadOffset]) // channel is stored twice.
+		if e.RadioCommData.Channel != e.Data[payloadOffset] { // channel is stored twice.
+			return evtLen, fmt.Errorf("event type %d has mismatching channels %d and %d", e.Type,
+				e.RadioCommData.Channel, e.Data[payloadOffset])
+		}
 	case EventTypeRadioState:
+		if datalen < radioStateEventDataHeaderLen {
+			return evtLen, e.dataLenError(radioStateEventDataHeaderLen)
+		}
 		e.RadioStateData = deserializeRadioStateData(e.Data)
 		payloadOffset += radioStateEventDataHeaderLen
 	case EventTypeNodeInfo:
+		if datalen < nodeInfoEventDataHeaderLen {
+			return evtLen, e.dataLenError(nodeInfoEventDataHeaderLen)
+		}
+		if datalen > nodeInfoEventDataHeaderLen && datalen < nodeInfoEventDataExtHeaderLen {
+			return evtLen, e.dataLenError(nodeInfoEventDataExtHeaderLen)
+		}
 		e.NodeInfoData = deserializeNodeInfoData(e.Data)
-		payloadOffset += nodeInfoEventDataHeaderLen
+		if datalen < nodeInfoEventDataExtHeaderLen {
+			payloadOffset += nodeInfoEventDataHeaderLen
+		} else {
+			payloadOffset += nodeInfoEventDataExtHeaderLen
+		}
+		if e.NodeInfoData.NodeId <= 0 {
+			return evtLen, fmt.Errorf("event type %d has invalid node ID %d", e.Type, e.NodeInfoData.NodeId)
+		}
+	case EventTypeExtAddr:
+		if datalen < extAddrEventDataLen {
+			return evtLen, e.dataLenError(extAddrEventDataLen)
+		}
 	default:
 		break
 	}
 
 	data2 := make([]byte, datalen-payloadOffset)
-	copy(data2, e.Data[payloadOffset:payloadOffset+datalen])
+	copy(data2, e.Data[payloadOffset:])
 	e.Data = data2
 
-	// e.Timestamp is not in the event, so set to invalid initially.
-	e.Timestamp = InvalidTimestamp
+	return evtLen, nil
+}
 
-	return int(eventMsgHeaderLen + datalen)
+func (e *Event) dataLenError(minLen int) error {
+	return fmt.Errorf("event type %d has data length %d, expected at least %d", e.Type, len(e.Data), minLen)
 }
 
 func deserializeRadioCommData(data []byte) RadioCommEventData {
-	logger.AssertTrue(len(data) >= radioCommEventDataHeaderLen)
 	s := RadioCommEventData{
 		Channel:  data[0],
 		PowerDbm: int8(data[1]),
@@ -216,7 +271,6 @@ func deserializeRadioCommData(data []byte) RadioCommEventData {
 }
 
 func deserializeRadioStateData(data []byte) RadioStateEventData {
-	logger.AssertTrue(len(data) >= radioStateEventDataHeaderLen)
 	s := RadioStateEventData{
 		Channel:     data[0],
 		PowerDbm:    int8(data[1]),
@@ -230,9 +284,24 @@ func deserializeRadioStateData(data []byte) RadioStateEventData {
 }
 
 func deserializeNodeInfoData(data []byte) NodeInfoEventData {
-	logger.AssertTrue(len(data) >= nodeInfoEventDataHeaderLen)
+	if len(data) < nodeInfoEventDataExtHeaderLen {
+		// legacy node: assume it matches this OT-NS.
+		return NodeInfoEventData{
+			NodeId:                  types.NodeId(binary.LittleEndian.Uint32(data[0:4])),
+			ProtocolVersion:         ProtocolVersionLegacy,
+			Capabilities:            CapabilitiesLegacy,
+			EventHeaderLen:          eventMsgHeaderLen,
+			RadioCommDataHeaderLen:  radioCommEventDataHeaderLen,
+			RadioStateDataHeaderLen: radioStateEventDataHeaderLen,
+		}
+	}
 	s := NodeInfoEventData{
-		NodeId: types.NodeId(binary.LittleEndian.Uint32(data[0:4])),
+		NodeId:                  types.NodeId(binary.LittleEndian.Uint32(data[0:4])),
+		ProtocolVersion:         ProtocolVersion(binary.LittleEndian.Uint16(data[4:6])),
+		Capabilities:            Capabilities(binary.LittleEndian.Uint32(data[6:10])),
+		EventHeaderLen:          data[10],
+		RadioCommDataHeaderLen:  data[11],
+		RadioStateDataHeaderLen: data[12],
 	}
 	return s
 }
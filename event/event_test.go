@@ -37,7 +37,8 @@ import (
 func TestDeserializeAlarmEvent(t *testing.T) {
 	data, _ := hex.DecodeString("12120000000000000021222300000000000000")
 	var ev Event
-	n := ev.Deserialize(data)
+	n, err := ev.Deserialize(data)
+	assert.NoError(t, err)
 	assert.True(t, 4626 == ev.Delay)
 	assert.Equal(t, EventTypeAlarmFired, ev.Type)
 	assert.Equal(t, uint64(2302497), ev.MsgId)
@@ -55,7 +56,8 @@ func TestSerializeAlarmEvent(t *testing.T) {
 func TestDeserializeRadioCommEvent(t *testing.T) {
 	data, _ := hex.DecodeString("040302010000000006040000000000000011000cf6112a000000000000000c1020304050")
 	var ev Event
-	n := ev.Deserialize(data)
+	n, err := ev.Deserialize(data)
+	assert.NoError(t, err)
 	assert.True(t, 16909060 == ev.Delay)
 	assert.Equal(t, EventTypeRadioCommStart, ev.Type)
 	assert.Equal(t, uint64(4), ev.MsgId)
@@ -70,7 +72,8 @@ func TestDeserializeRadioCommEvent(t *testing.T) {
 func TestDeserializeRadioStateEvent(t *testing.T) {
 	data, _ := hex.DecodeString("0403020100000000090a000000000000000e000d05ab030b0240e2010000000000")
 	var ev Event
-	n := ev.Deserialize(data)
+	n, err := ev.Deserialize(data)
+	assert.NoError(t, err)
 	assert.Equal(t, uint64(16909060), ev.Delay)
 	assert.Equal(t, EventTypeRadioState, ev.Type)
 	assert.Equal(t, uint64(10), ev.MsgId)
@@ -92,7 +95,8 @@ func TestDeserializeMultiple(t *testing.T) {
 	data = append(data, data3...)
 
 	var ev Event
-	n1 := ev.Deserialize(data)
+	n1, err := ev.Deserialize(data)
+	assert.NoError(t, err)
 	assert.Equal(t, uint64(16909060), ev.Delay)
 	assert.Equal(t, EventTypeRadioState, ev.Type)
 	assert.Equal(t, uint64(10), ev.MsgId)
@@ -105,11 +109,13 @@ func TestDeserializeMultiple(t *testing.T) {
 	assert.Equal(t, uint64(123456), ev.RadioStateData.RadioTime)
 	assert.Equal(t, len(data1), n1)
 
-	n2 := ev.Deserialize(data[n1:])
+	n2, err := ev.Deserialize(data[n1:])
+	assert.NoError(t, err)
 	assert.Equal(t, EventTypeRadioCommStart, ev.Type)
 	assert.Equal(t, len(data2), n2)
 
-	n3 := ev.Deserialize(data[n1+n2:])
+	n3, err := ev.Deserialize(data[n1+n2:])
+	assert.NoError(t, err)
 	assert.Equal(t, 0, n3)
 }
 
@@ -174,7 +180,8 @@ func TestSerializeRadioRxDoneEvent(t *testing.T) {
 func TestDeserializeNodeInfoEvent(t *testing.T) {
 	data, _ := hex.DecodeString("00000000000000000c00000000000000fe040020000000")
 	var ev Event
-	n := ev.Deserialize(data)
+	n, err := ev.Deserialize(data)
+	assert.NoError(t, err)
 	assert.True(t, 0 == ev.Delay)
 	assert.Equal(t, EventTypeNodeInfo, ev.Type)
 	assert.Equal(t, uint64(18302628885633695744), ev.MsgId)
@@ -182,7 +189,8 @@ func TestDeserializeNodeInfoEvent(t *testing.T) {
 	assert.Equal(t, len(data), n)
 
 	data, _ = hex.DecodeString("00000000000000000cfe00000000000000040081800a00")
-	n = ev.Deserialize(data)
+	n, err = ev.Deserialize(data)
+	assert.NoError(t, err)
 	assert.True(t, 0 == ev.Delay)
 	assert.Equal(t, EventTypeNodeInfo, ev.Type)
 	assert.Equal(t, uint64(254), ev.MsgId)
@@ -214,3 +222,74 @@ func TestEventCopy(t *testing.T) {
 	assert.Equal(t, uint8(types.OT_ERROR_FCS), evCopy.RadioCommData.Error)
 	assert.Equal(t, uint64(11234), evCopy.MsgId)
 }
+
+func TestDeserializeMalformedEvent(t *testing.T) {
+	var ev Event
+
+	// RadioCommStart event with a too short RadioCommEventData
+	data, _ := hex.DecodeString("040302010000000006040000000000000004000cf61100")
+	n, err := ev.Deserialize(data)
+	assert.Error(t, err)
+	assert.Equal(t, len(data), n)
+
+	// RadioCommStart event with mismatching channels
+	data, _ = hex.DecodeString("040302010000000006040000000000000011000cf6112a000000000000000b1020304050")
+	n, err = ev.Deserialize(data)
+	assert.Error(t, err)
+	assert.Equal(t, len(data), n)
+
+	// NodeInfo event with invalid node ID
+	data, _ = hex.DecodeString("00000000000000000c00000000000000fe040000000000")
+	n, err = ev.Deserialize(data)
+	assert.Error(t, err)
+	assert.Equal(t, len(data), n)
+
+	// ExtAddr event without extended address
+	data, _ = hex.DecodeString("00000000000000000b010000000000000002000012")
+	n, err = ev.Deserialize(data)
+	assert.Error(t, err)
+	assert.Equal(t, len(data), n)
+
+	// a malformed event can be skipped to continue with the next one.
+	data2, _ := hex.DecodeString("12120000000000000021222300000000000000")
+	n, err = ev.Deserialize(append(data, data2...))
+	assert.Error(t, err)
+	n, err = ev.Deserialize(append(data, data2...)[n:])
+	assert.NoError(t, err)
+	assert.Equal(t, len(data2), n)
+	assert.Equal(t, EventTypeAlarmFired, ev.Type)
+}
+
+func FuzzDeserialize(f *testing.F) {
+	for _, s := range []string{
+		"12120000000000000021222300000000000000",
+		"040302010000000006040000000000000011000cf6112a000000000000000c1020304050",
+		"0403020100000000090a000000000000000e000d05ab030b0240e2010000000000",
+		"00000000000000000c00000000000000fe040020000000",
+		"00000000000000000b01000000000000000800123456789abcdef0",
+		"00000000000000000501000000000000000600726f6c653d32",
+	} {
+		data, _ := hex.DecodeString(s)
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ev Event
+		n, err := ev.Deserialize(data)
+		assert.True(t, n >= 0 && n <= len(data))
+		if n == 0 {
+			assert.NoError(t, err)
+			return
+		}
+		assert.True(t, n >= eventMsgHeaderLen)
+		if err != nil {
+			return
+		}
+		assert.True(t, len(ev.Data) <= n-eventMsgHeaderLen)
+
+		// a valid event serializes back into the same bytes, for types with serialized composite data.
+		if ev.Type == EventTypeAlarmFired || ev.Type == EventTypeRadioCommStart || ev.Type == EventTypeRadioRxDone {
+			assert.Equal(t, data[:n], ev.Serialize())
+		}
+	})
+}
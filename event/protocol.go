@@ -0,0 +1,132 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package event
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProtocolVersion is the version of the event protocol between OT-NS and OT nodes, encoded as major << 8 | minor.
+// Nodes and OT-NS are compatible if their major versions are equal. Minor versions add optional features,
+// which are negotiated using Capabilities.
+//
+// Note: the node side of the handshake is not yet implemented in OT-RFSIM, whose nodes send the legacy NodeInfo
+// event. Until it is, all nodes are handled as legacy nodes, and the handshake is inert.
+type ProtocolVersion uint16
+
+const (
+	// ProtocolVersionLegacy is assumed for nodes that don't report a protocol version in their NodeInfo event.
+	ProtocolVersionLegacy ProtocolVersion = 0x0100
+	// ProtocolVersionCurrent is the protocol version implemented by this OT-NS.
+	ProtocolVersionCurrent ProtocolVersion = 0x0101
+)
+
+func (v ProtocolVersion) Major() uint8 {
+	return uint8(v >> 8)
+}
+
+func (v ProtocolVersion) Minor() uint8 {
+	return uint8(v)
+}
+
+func (v ProtocolVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+}
+
+// Capabilities is a bit set of optional protocol features supported by a node or by OT-NS.
+type Capabilities uint32
+
+const (
+	// CapRadioState indicates that the node sends RadioState events, for RF and energy simulation.
+	CapRadioState Capabilities = 1 << iota
+	// CapRxSensitivity indicates that the node handles RadioSetRxSensitivity events.
+	CapRxSensitivity
+
+	// CapabilitiesLegacy are the capabilities assumed for nodes that don't report their capabilities, i.e. OT-RFSIM
+	// builds from before the handshake. Not all of these handle RadioSetRxSensitivity events, so only the RadioState
+	// events, which all of them send, are assumed.
+	CapabilitiesLegacy = CapRadioState
+	// CapabilitiesCurrent are the capabilities implemented by this OT-NS.
+	CapabilitiesCurrent = CapRadioState | CapRxSensitivity
+)
+
+var capabilityNames = []string{"radio-state", "rx-sensitivity"}
+
+func (c Capabilities) Has(cap Capabilities) bool {
+	return c&cap == cap
+}
+
+func (c Capabilities) String() string {
+	var names []string
+	for i, name := range capabilityNames {
+		if c.Has(1 << i) {
+			names = append(names, name)
+		}
+	}
+	if unknown := c &^ (1<<len(capabilityNames) - 1); unknown != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(unknown)))
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
+
+// NewNodeInfoEventData returns the NodeInfo data that OT-NS sends to a node, in reply to the node's NodeInfo event,
+// to complete the protocol handshake.
+func NewNodeInfoEventData(nodeid int) NodeInfoEventData {
+	return NodeInfoEventData{
+		NodeId:                  nodeid,
+		ProtocolVersion:         ProtocolVersionCurrent,
+		Capabilities:            CapabilitiesCurrent,
+		EventHeaderLen:          eventMsgHeaderLen,
+		RadioCommDataHeaderLen:  radioCommEventDataHeaderLen,
+		RadioStateDataHeaderLen: radioStateEventDataHeaderLen,
+	}
+}
+
+// IsLegacy returns true if the NodeInfo data was sent by a node that doesn't support the protocol handshake.
+func (s *NodeInfoEventData) IsLegacy() bool {
+	return s.ProtocolVersion == ProtocolVersionLegacy
+}
+
+// CheckCompatible checks that a node, which sent the NodeInfo data, can be simulated by this OT-NS.
+func (s *NodeInfoEventData) CheckCompatible() error {
+	if s.ProtocolVersion.Major() != ProtocolVersionCurrent.Major() {
+		return fmt.Errorf("node protocol version %s is incompatible with OT-NS protocol version %s - "+
+			"rebuild the node binary (ot-rfsim) from the OT-NS version in use", s.ProtocolVersion, ProtocolVersionCurrent)
+	}
+	if s.EventHeaderLen != eventMsgHeaderLen || s.RadioCommDataHeaderLen != radioCommEventDataHeaderLen ||
+		s.RadioStateDataHeaderLen != radioStateEventDataHeaderLen {
+		return fmt.Errorf("node event header lengths (%d,%d,%d) differ from OT-NS header lengths (%d,%d,%d) - "+
+			"rebuild the node binary (ot-rfsim) from the OT-NS version in use",
+			s.EventHeaderLen, s.RadioCommDataHeaderLen, s.RadioStateDataHeaderLen,
+			eventMsgHeaderLen, radioCommEventDataHeaderLen, radioStateEventDataHeaderLen)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeInfoHandshake(t *testing.T) {
+	ev := &Event{
+		Type:         EventTypeNodeInfo,
+		NodeInfoData: NewNodeInfoEventData(12),
+	}
+	data := ev.Serialize()
+
+	var ev2 Event
+	n, err := ev2.Deserialize(data)
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), n)
+	assert.Equal(t, ev.NodeInfoData, ev2.NodeInfoData)
+	assert.Empty(t, ev2.Data)
+	assert.False(t, ev2.NodeInfoData.IsLegacy())
+	assert.NoError(t, ev2.NodeInfoData.CheckCompatible())
+	assert.True(t, ev2.NodeInfoData.Capabilities.Has(CapRadioState|CapRxSensitivity))
+
+	// newer minor versions are compatible, newer major versions are not.
+	info := NewNodeInfoEventData(12)
+	info.ProtocolVersion = ProtocolVersionCurrent + 1
+	assert.NoError(t, info.CheckCompatible())
+	info.ProtocolVersion = 0x0201
+	assert.ErrorContains(t, info.CheckCompatible(), "protocol version 2.1 is incompatible")
+	info = NewNodeInfoEventData(12)
+	info.RadioCommDataHeaderLen = 12
+	assert.ErrorContains(t, info.CheckCompatible(), "header lengths (19,12,14)")
+
+	// truncated extended NodeInfo
+	data[17] = 8
+	n, err = ev2.Deserialize(data[:eventMsgHeaderLen+8])
+	assert.Error(t, err)
+	assert.Equal(t, eventMsgHeaderLen+8, n)
+}
+
+func TestNodeInfoLegacy(t *testing.T) {
+	data := []byte{0, 0, 0, 0, 0, 0, 0, 0, EventTypeNodeInfo, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 7, 0, 0, 0}
+	var ev Event
+	n, err := ev.Deserialize(data)
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), n)
+	assert.Equal(t, 7, ev.NodeInfoData.NodeId)
+	assert.True(t, ev.NodeInfoData.IsLegacy())
+	assert.Equal(t, CapabilitiesLegacy, ev.NodeInfoData.Capabilities)
+	assert.NoError(t, ev.NodeInfoData.CheckCompatible())
+}
+
+func TestCapabilitiesString(t *testing.T) {
+	assert.Equal(t, "none", Capabilities(0).String())
+	assert.Equal(t, "radio-state,rx-sensitivity", (CapRadioState | CapRxSensitivity).String())
+	assert.Equal(t, "rx-sensitivity,0x80", (CapRxSensitivity | 0x80).String())
+	assert.Equal(t, "radio-state,rx-sensitivity", CapabilitiesCurrent.String())
+	assert.Equal(t, "1.1", ProtocolVersionCurrent.String())
+}
@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"os"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -63,18 +64,27 @@ type logEntry struct {
 	NodeId NodeId
 	Level  Level
 	Msg    string
+	IsUart bool
 }
 
 type StdoutCallback interface {
 	OnStdout()
 }
 
+// NodeLogCallback is called for each displayed node log entry and each line of UART output read from a node.
+type NodeLogCallback interface {
+	OnNodeLog(ts uint64, nodeid NodeId, level Level, isUart bool, msg string)
+}
+
 var (
 	cfg             zap.Config
 	zaplogger       *zap.Logger
 	currentLevel    Level
 	isLogToTerminal bool
 	cbStdout        StdoutCallback
+	cbNodeLog       NodeLogCallback
+	cbNodeLogLevel  = OffLevel
+	cbNodeLogMutex  = sync.RWMutex{}
 	zapLevels       = []zapcore.Level{zapcore.FatalLevel + 1, zapcore.FatalLevel, zapcore.PanicLevel,
 		zapcore.ErrorLevel, zapcore.WarnLevel, zapcore.InfoLevel, zapcore.InfoLevel, zapcore.DebugLevel,
 		zapcore.DebugLevel, zapcore.DebugLevel}
@@ -128,6 +138,26 @@ func SetStdoutCallback(cb StdoutCallback) {
 	cbStdout = cb
 }
 
+// SetNodeLogCallback sets a callback, that the logger will call for node log entries up to the given level, even if
+// these are not displayed. UART output lines are passed at TraceLevel. A nil callback disables it.
+// It may be called from any goroutine.
+func SetNodeLogCallback(cb NodeLogCallback, level Level) {
+	if cb == nil {
+		level = OffLevel
+	}
+	cbNodeLogMutex.Lock()
+	cbNodeLog = cb
+	cbNodeLogLevel = level
+	cbNodeLogMutex.Unlock()
+}
+
+// getNodeLogCallback returns the current node log callback and its level.
+func getNodeLogCallback() (NodeLogCallback, Level) {
+	cbNodeLogMutex.RLock()
+	defer cbNodeLogMutex.RUnlock()
+	return cbNodeLog, cbNodeLogLevel
+}
+
 // TraceError prints the stack and error
 func TraceError(format string, args ...interface{}) {
 	Error(string(debug.Stack()))
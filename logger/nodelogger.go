@@ -29,6 +29,7 @@ package logger
 import (
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -124,52 +125,86 @@ func (nl *NodeLogger) writeLogFileHeader() {
 // NodeLogf logs a formatted log message for the specific nodeid; correct NodeLogger object will be auto-found.
 func NodeLogf(nodeid NodeId, level Level, format string, args ...interface{}) {
 	log := nodeLogs[nodeid]
-	if level > log.CurrentLevel && !log.isFileEnabled {
+	if !log.isLogged(level) {
 		return
 	}
-	msg := getMessage(format, args)
-	entry := logEntry{
+	log.addEntry(logEntry{
 		NodeId: nodeid,
 		Level:  level,
-		Msg:    msg,
+		Msg:    getMessage(format, args),
+	})
+}
+
+// isLogged checks if an entry of the given level is displayed, written to the node log file, or passed to the
+// node log callback.
+func (nl *NodeLogger) isLogged(level Level) bool {
+	if level <= nl.CurrentLevel || nl.isFileEnabled {
+		return true
+	}
+	_, cbLevel := getNodeLogCallback()
+	return level <= cbLevel
+}
+
+// isTraceLogged checks if a TraceLevel entry is displayed or passed to the node log callback.
+func (nl *NodeLogger) isTraceLogged() bool {
+	if TraceLevel <= nl.CurrentLevel {
+		return true
 	}
+	_, cbLevel := getNodeLogCallback()
+	return TraceLevel <= cbLevel
+}
+
+func (nl *NodeLogger) addEntry(entry logEntry) {
 	select {
-	case log.entries <- entry:
+	case nl.entries <- entry:
 		break
 	default:
-		log.DisplayPendingLogEntries(log.timestampUs)
-		log.entries <- entry
+		nl.DisplayPendingLogEntries(nl.timestampUs)
+		nl.entries <- entry
 	}
 }
 
 func (nl *NodeLogger) Log(level Level, msg string) {
-	if level > nl.CurrentLevel && !nl.isFileEnabled {
+	if !nl.isLogged(level) {
 		return
 	}
 	NodeLogf(nl.Id, level, msg)
 }
 
 func (nl *NodeLogger) Logf(level Level, format string, args []interface{}) {
-	if level > nl.CurrentLevel && !nl.isFileEnabled {
+	if !nl.isLogged(level) {
 		return
 	}
 	NodeLogf(nl.Id, level, format, args)
 }
 
 func (nl *NodeLogger) Trace(args ...interface{}) {
-	if TraceLevel > nl.CurrentLevel {
+	if !nl.isTraceLogged() {
 		return
 	}
 	NodeLogf(nl.Id, TraceLevel, "", args...)
 }
 
 func (nl *NodeLogger) Tracef(format string, args ...interface{}) {
-	if TraceLevel > nl.CurrentLevel {
+	if !nl.isTraceLogged() {
 		return
 	}
 	NodeLogf(nl.Id, TraceLevel, format, args...)
 }
 
+// Uart logs a line of UART output read from the node, at TraceLevel.
+func (nl *NodeLogger) Uart(line string) {
+	if !nl.isTraceLogged() {
+		return
+	}
+	nl.addEntry(logEntry{
+		NodeId: nl.Id,
+		Level:  TraceLevel,
+		Msg:    line,
+		IsUart: true,
+	})
+}
+
 func (nl *NodeLogger) Debugf(format string, args ...interface{}) {
 	NodeLogf(nl.Id, DebugLevel, format, args...)
 }
@@ -223,20 +258,25 @@ func (nl *NodeLogger) DisplayPendingLogEntries(ts uint64) {
 	nl.timestampUs = ts
 	tsStr := fmt.Sprintf("%11d ", ts)
 	nodeStr := GetNodeName(nl.Id)
+	cb, cbLevel := getNodeLogCallback()
 	for {
 		select {
 		case ent := <-nl.entries:
+			msg := strings.TrimSuffix(ent.Msg, "\n") // remove duplicate newline chars
 			isDisplayEntry := nl.CurrentLevel >= ent.Level
 			if ent.Level <= DebugLevel || isDisplayEntry {
-				logStr := tsStr + ent.Msg
-				if logStr[len(logStr)-1:] == "\n" { // remove duplicate newline chars
-					logStr = logStr[:len(logStr)-1]
+				logStr := tsStr + msg
+				if ent.IsUart {
+					logStr = tsStr + "UART: " + msg
 				}
 				_ = nl.writeToLogFile(logStr)
 				if isDisplayEntry {
 					logAlways(ent.Level, nodeStr+logStr)
 				}
 			}
+			if cb != nil && ent.Level <= cbLevel {
+				cb.OnNodeLog(ts, nl.Id, ent.Level, ent.IsUart, msg)
+			}
 			break
 		default:
 			return
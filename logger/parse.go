@@ -73,6 +73,10 @@ func GetLevelString(level Level) string {
 		return "warn"
 	case ErrorLevel:
 		return "crit"
+	case PanicLevel:
+		return "panic"
+	case FatalLevel:
+		return "fatal"
 	case OffLevel:
 		return "off"
 	default:
@@ -0,0 +1,68 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package metrics
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	metrics := []*metric{
+		newMetric("otns_test_time_seconds", gauge, "Test time.").add(1.5),
+		newMetric("otns_test_total", counter, "Test counter with a \\ and\nnewline.").
+			add(3, "node", "1", "role", "leader").
+			add(math.Inf(1), "node", "2", "role", "a\"b"),
+	}
+	var sb strings.Builder
+	assert.Nil(t, writeMetrics(&sb, metrics))
+	assert.Equal(t, `# HELP otns_test_time_seconds Test time.
+# TYPE otns_test_time_seconds gauge
+otns_test_time_seconds 1.5
+# HELP otns_test_total Test counter with a \\ and\nnewline.
+# TYPE otns_test_total counter
+otns_test_total{node="1",role="leader"} 3
+otns_test_total{node="2",role="a\"b"} +Inf
+`, sb.String())
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "0", formatValue(0))
+	assert.Equal(t, "12345678", formatValue(12345678))
+	assert.Equal(t, "1e-06", formatValue(0.000001))
+	assert.Equal(t, "NaN", formatValue(math.NaN()))
+	assert.Equal(t, "-Inf", formatValue(math.Inf(-1)))
+}
+
+func TestSnakeCase(t *testing.T) {
+	assert.Equal(t, "alarm_events", snakeCase("AlarmEvents"))
+	assert.Equal(t, "dispatch_by_ext_addr_succ", snakeCase("DispatchByExtAddrSucc"))
+	assert.Equal(t, "events", snakeCase("events"))
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+// Package metrics serves metrics of a running simulation in the Prometheus text exposition format, so that
+// long-running simulations can be monitored by dashboards.
+package metrics
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/openthread/ot-ns/logger"
+	"github.com/openthread/ot-ns/simulation"
+	. "github.com/openthread/ot-ns/types"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var nodeRoles = []OtDeviceRole{
+	OtDeviceRoleDisabled, OtDeviceRoleDetached, OtDeviceRoleChild, OtDeviceRoleRouter, OtDeviceRoleLeader,
+}
+
+type handler struct {
+	sim *simulation.Simulation
+}
+
+// NewHandler creates an HTTP handler that serves the metrics of the simulation.
+func NewHandler(sim *simulation.Simulation) http.Handler {
+	return &handler{sim: sim}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var metrics []*metric
+	done := make(chan struct{})
+	if !h.sim.PostAsync(func() {
+		defer close(done)
+		metrics = collect(h.sim)
+	}) {
+		http.Error(w, simulation.CommandInterruptedError.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	select {
+	case <-done:
+	case <-r.Context().Done():
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	if err := writeMetrics(w, metrics); err != nil {
+		logger.Debugf("metrics write failed: %v", err)
+	}
+}
+
+// collect gets all metrics of the simulation. It must be called from the simulation goroutine.
+func collect(sim *simulation.Simulation) []*metric {
+	d := sim.Dispatcher()
+	metrics := []*metric{
+		newMetric("otns_simulation_time_seconds", gauge, "Current simulation time.").
+			add(float64(d.CurTime) / 1e6),
+		newMetric("otns_simulation_speed", gauge, "Configured simulation speed.").
+			add(d.GetSpeed()),
+		newMetric("otns_simulation_achieved_speed", gauge,
+			"Achieved simulation speed (simulated time / real time) since the last speed change or 'go' period.").
+			add(d.GetAchievedSpeed()),
+	}
+
+	// Dispatcher.Counters, one counter metric per field.
+	countersVal := reflect.ValueOf(d.Counters)
+	countersTyp := countersVal.Type()
+	for i := 0; i < countersVal.NumField(); i++ {
+		fname := countersTyp.Field(i).Name
+		metrics = append(metrics, newMetric("otns_dispatcher_"+snakeCase(fname)+"_total", counter,
+			"Dispatcher counter "+fname+".").add(float64(countersVal.Field(i).Uint())))
+	}
+
+	ql := d.GetQueueLengths()
+	metrics = append(metrics, newMetric("otns_dispatcher_queue_length", gauge, "Current length of Dispatcher queues.").
+		add(float64(ql.SendQueue), "queue", "send").
+		add(float64(ql.Alarms), "queue", "alarm").
+		add(float64(ql.Received), "queue", "received").
+		add(float64(ql.Tasks), "queue", "task"))
+
+	nodeRole := newMetric("otns_node_role", gauge, "Current Thread role of a node (1 for the current role).")
+	nodeFailed := newMetric("otns_node_failed", gauge, "Whether the radio of a node is failed (1) or not (0).")
+	nodeProtocolErrors := newMetric("otns_node_protocol_errors_total", counter,
+		"Malformed or unexpected events received from a node.")
+	roleCount := map[OtDeviceRole]int{}
+	partitions := map[uint32]struct{}{}
+	for _, nodeid := range sim.GetNodes() {
+		dnode := d.GetNode(nodeid)
+		if dnode == nil {
+			continue
+		}
+		id := strconv.Itoa(nodeid)
+		nodeRole.add(1, "node", id, "role", dnode.Role.String())
+		nodeFailed.add(boolValue(dnode.IsFailed()), "node", id)
+		nodeProtocolErrors.add(float64(dnode.GetProtocolErrors()), "node", id)
+		roleCount[dnode.Role]++
+		if dnode.Role >= OtDeviceRoleChild {
+			partitions[dnode.PartitionId] = struct{}{}
+		}
+	}
+	nodes := newMetric("otns_nodes", gauge, "Number of nodes per Thread role.")
+	for _, role := range nodeRoles {
+		nodes.add(float64(roleCount[role]), "role", role.String())
+	}
+	metrics = append(metrics, nodes, nodeRole, nodeFailed, nodeProtocolErrors,
+		newMetric("otns_partitions", gauge, "Number of Thread partitions formed by the attached nodes.").
+			add(float64(len(partitions))))
+
+	if ea := sim.GetEnergyAnalyser(); ea != nil {
+		total := ea.GetTotalEnergy(d.CurTime)
+		metrics = append(metrics, newMetric("otns_energy_consumed_millijoules_total", counter,
+			"Energy consumed by the radios of all nodes, per radio state.").
+			add(total.Disabled, "state", "disabled").
+			add(total.Sleep, "state", "sleep").
+			add(total.Tx, "state", "tx").
+			add(total.Rx, "state", "rx"))
+	}
+	return metrics
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -30,6 +30,7 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
@@ -42,7 +43,10 @@ import (
 	"github.com/openthread/ot-ns/cli"
 	"github.com/openthread/ot-ns/dispatcher"
 	"github.com/openthread/ot-ns/logger"
+	"github.com/openthread/ot-ns/metrics"
+	"github.com/openthread/ot-ns/pcap"
 	"github.com/openthread/ot-ns/progctx"
+	"github.com/openthread/ot-ns/radiomodel"
 	"github.com/openthread/ot-ns/simulation"
 	. "github.com/openthread/ot-ns/types"
 	"github.com/openthread/ot-ns/visualize"
@@ -69,8 +73,13 @@ type MainArgs struct {
 	DispatcherPort int
 	DumpPackets    bool
 	NoPcap         bool
+	PcapFormat     string
+	PcapChannels   string
+	PcapDecrypt    bool
+	PcapKeys       bool
 	NoReplay       bool
 	NoLogFile      bool
+	MaxProtoErrors int
 }
 
 var (
@@ -103,8 +112,13 @@ func parseArgs() {
 	flag.StringVar(&args.ListenAddr, "listen", fmt.Sprintf("localhost:%d", InitialDispatcherPort), "specify UDP listen address and port")
 	flag.BoolVar(&args.DumpPackets, "dump-packets", false, "dump packets")
 	flag.BoolVar(&args.NoPcap, "no-pcap", false, "do not generate PCAP file (named \"current.pcap\")")
+	flag.StringVar(&args.PcapFormat, "pcap", pcap.FormatPcap.String(), "set PCAP file format: pcap, pcapng, pcapng-tap (pcapng with IEEE 802.15.4 TAP link type)")
+	flag.StringVar(&args.PcapChannels, "pcap-channels", "", "comma-separated list of channels to capture in the PCAP file (default: the simulation channel)")
+	flag.BoolVar(&args.PcapDecrypt, "pcap-decrypt", false, "write MAC secured frames decrypted to the PCAP file, using the nodes' network keys")
+	flag.BoolVar(&args.PcapKeys, "pcap-keys", false, "write the nodes' network keys to a Wireshark key file next to the PCAP file (\"current.ieee802154_keys\")")
 	flag.BoolVar(&args.NoReplay, "no-replay", false, "do not generate Replay file (named \"otns_?.replay\")")
 	flag.BoolVar(&args.NoLogFile, "no-logfile", false, "do not generate node log files (named \"tmp/?_?.log\")")
+	flag.IntVar(&args.MaxProtoErrors, "max-protocol-errors", dispatcher.DefaultConfig().MaxProtocolErrors, "quarantine a node after this many malformed events (0 means never)")
 
 	flag.Parse()
 }
@@ -137,6 +151,23 @@ func parseListenAddr() {
 	}
 }
 
+// parsePcapChannels parses the comma-separated list of channels to capture. If empty, defaultChannel is used.
+func parsePcapChannels(s string, defaultChannel ChannelId) (map[ChannelId]struct{}, error) {
+	channels := map[ChannelId]struct{}{}
+	if len(s) == 0 {
+		channels[defaultChannel] = struct{}{}
+		return channels, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		ch, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || ch < radiomodel.MinChannelNumber || ch > radiomodel.MaxChannelNumber {
+			return nil, errors.Errorf("invalid pcap channel: %s", part)
+		}
+		channels[ch] = struct{}{}
+	}
+	return channels, nil
+}
+
 func Main(ctx *progctx.ProgCtx, visualizerCreator func(ctx *progctx.ProgCtx, args *MainArgs) visualize.Visualizer, cliOptions *cli.CliOptions) {
 	handleSignals(ctx)
 	parseArgs()
@@ -179,6 +210,7 @@ func Main(ctx *progctx.ProgCtx, visualizerCreator func(ctx *progctx.ProgCtx, arg
 	sim := createSimulation(ctx)
 	rt := cli.NewCmdRunner(ctx, sim)
 	sim.SetVisualizer(vis)
+	http.Handle("/metrics", metrics.NewHandler(sim)) // served by the webserver, in Prometheus format
 
 	ctx.WaitAdd("cli", 1)
 	go func() {
@@ -280,10 +312,21 @@ func createSimulation(ctx *progctx.ProgCtx) *simulation.Simulation {
 	dispatcherCfg := dispatcher.DefaultConfig()
 	dispatcherCfg.SimulationId = simcfg.Id
 	if !args.NoPcap {
-		dispatcherCfg.PcapChannels[simcfg.Channel] = struct{}{}
+		dispatcherCfg.PcapFormat, err = pcap.ParseFormat(args.PcapFormat)
+		if err != nil {
+			logger.Error(err)
+			return nil
+		}
+		if dispatcherCfg.PcapChannels, err = parsePcapChannels(args.PcapChannels, simcfg.Channel); err != nil {
+			logger.Error(err)
+			return nil
+		}
+		dispatcherCfg.PcapDecrypt = args.PcapDecrypt
+		dispatcherCfg.PcapKeys = args.PcapKeys
 	}
 	dispatcherCfg.DefaultWatchLevel = args.WatchLevel
 	dispatcherCfg.DefaultWatchOn = logger.ParseLevelString(args.WatchLevel) != logger.OffLevel
+	dispatcherCfg.MaxProtocolErrors = args.MaxProtoErrors
 
 	sim, err := simulation.NewSimulation(ctx, simcfg, dispatcherCfg)
 	logger.FatalIfError(err)
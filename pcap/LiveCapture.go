@@ -0,0 +1,400 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+const (
+	// LiveClientQueueSize is the number of frames queued per live capture client. Frames are dropped for a
+	// client that doesn't keep up, so that the simulation never waits for it.
+	LiveClientQueueSize = 10000
+)
+
+// LiveCapture streams captured frames live to clients, e.g. Wireshark, that connect to one of its endpoints: a
+// local TCP socket or a named pipe. Each client gets its own capture stream, with a channel filter that is set
+// by the endpoint and can be changed by a TCP client by sending a line "channels <ch> ..." or "channels all".
+type LiveCapture struct {
+	format    Format
+	channels  []int
+	mutex     sync.Mutex
+	endpoints map[int]*LiveEndpoint
+	nextId    int
+}
+
+// LiveEndpoint is a TCP socket or named pipe on which live capture clients are served.
+type LiveEndpoint struct {
+	Id      int
+	Kind    string // "tcp" or "pipe"
+	Addr    string // the TCP address or pipe path
+	Filter  []int  // channels streamed to new clients; all channels if empty.
+	lc      *LiveCapture
+	ln      net.Listener
+	clients map[*liveClient]struct{}
+	closed  bool
+	isFifo  bool // true if the pipe was created by the endpoint.
+}
+
+type liveClient struct {
+	name    string
+	conn    io.WriteCloser
+	frames  chan *Frame
+	filter  map[int]struct{} // nil means all channels.
+	dropped uint64
+	quit    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+// LiveClientInfo describes a connected live capture client.
+type LiveClientInfo struct {
+	Name    string
+	Filter  []int
+	Dropped uint64
+}
+
+// NewLiveCapture creates a LiveCapture that streams the given channels in the given format.
+func NewLiveCapture(format Format, channels []int) *LiveCapture {
+	return &LiveCapture{
+		format:    format,
+		channels:  channels,
+		endpoints: map[int]*LiveEndpoint{},
+		nextId:    1,
+	}
+}
+
+// ListenTcp starts serving live capture clients on TCP address addr.
+func (lc *LiveCapture) ListenTcp(addr string, filter []int) (*LiveEndpoint, error) {
+	if err := lc.checkFilter(filter); err != nil {
+		return nil, err
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	ep := lc.addEndpoint("tcp", ln.Addr().String(), filter)
+	ep.ln = ln
+	go ep.acceptTcp()
+	return ep, nil
+}
+
+// OpenPipe starts serving live capture clients on the named pipe at path, which is created if needed. One client
+// at a time can read from the pipe.
+func (lc *LiveCapture) OpenPipe(path string, filter []int) (*LiveEndpoint, error) {
+	if err := lc.checkFilter(filter); err != nil {
+		return nil, err
+	}
+	isFifo := false
+	if info, err := os.Stat(path); err == nil {
+		if info.Mode()&os.ModeNamedPipe == 0 {
+			return nil, fmt.Errorf("%s exists and is not a named pipe", path)
+		}
+	} else if err = syscall.Mkfifo(path, 0644); err != nil {
+		return nil, err
+	} else {
+		isFifo = true
+	}
+	ep := lc.addEndpoint("pipe", path, filter)
+	ep.isFifo = isFifo
+	go ep.servePipe()
+	return ep, nil
+}
+
+// Endpoints returns the endpoints, sorted by id.
+func (lc *LiveCapture) Endpoints() []*LiveEndpoint {
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+	eps := make([]*LiveEndpoint, 0, len(lc.endpoints))
+	for _, ep := range lc.endpoints {
+		eps = append(eps, ep)
+	}
+	sort.Slice(eps, func(i, j int) bool { return eps[i].Id < eps[j].Id })
+	return eps
+}
+
+// CloseEndpoint stops the endpoint with given id, and disconnects its clients.
+func (lc *LiveCapture) CloseEndpoint(id int) error {
+	lc.mutex.Lock()
+	ep := lc.endpoints[id]
+	lc.mutex.Unlock()
+	if ep == nil {
+		return fmt.Errorf("live capture endpoint %d not found", id)
+	}
+	ep.close()
+	return nil
+}
+
+// Close stops all endpoints.
+func (lc *LiveCapture) Close() {
+	for _, ep := range lc.Endpoints() {
+		ep.close()
+	}
+}
+
+// WriteFrame queues the frame for all clients that capture its channel. It never blocks.
+func (lc *LiveCapture) WriteFrame(frame *Frame) {
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+	for _, ep := range lc.endpoints {
+		for c := range ep.clients {
+			if c.filter != nil {
+				if _, ok := c.filter[frame.Channel]; !ok {
+					continue
+				}
+			}
+			select {
+			case c.frames <- frame:
+			default:
+				c.dropped++
+			}
+		}
+	}
+}
+
+// Clients returns information on the clients connected to the endpoint.
+func (ep *LiveEndpoint) Clients() []LiveClientInfo {
+	ep.lc.mutex.Lock()
+	defer ep.lc.mutex.Unlock()
+	var infos []LiveClientInfo
+	for c := range ep.clients {
+		infos = append(infos, LiveClientInfo{Name: c.name, Filter: filterChannels(c.filter), Dropped: c.dropped})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+func (ep *LiveEndpoint) String() string {
+	return fmt.Sprintf("%s %s", ep.Kind, ep.Addr)
+}
+
+func (lc *LiveCapture) checkFilter(filter []int) error {
+	for _, ch := range filter {
+		found := false
+		for _, capCh := range lc.channels {
+			found = found || ch == capCh
+		}
+		if !found {
+			return fmt.Errorf("channel %d is not captured", ch)
+		}
+	}
+	return nil
+}
+
+func (lc *LiveCapture) addEndpoint(kind string, addr string, filter []int) *LiveEndpoint {
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+	ep := &LiveEndpoint{
+		Id:      lc.nextId,
+		Kind:    kind,
+		Addr:    addr,
+		Filter:  filter,
+		lc:      lc,
+		clients: map[*liveClient]struct{}{},
+	}
+	lc.nextId++
+	lc.endpoints[ep.Id] = ep
+	return ep
+}
+
+func (ep *LiveEndpoint) close() {
+	ep.lc.mutex.Lock()
+	if ep.closed {
+		ep.lc.mutex.Unlock()
+		return
+	}
+	ep.closed = true
+	delete(ep.lc.endpoints, ep.Id)
+	clients := make([]*liveClient, 0, len(ep.clients))
+	for c := range ep.clients {
+		clients = append(clients, c)
+	}
+	ep.lc.mutex.Unlock()
+
+	if ep.ln != nil {
+		_ = ep.ln.Close()
+	}
+	if ep.Kind == "pipe" {
+		// a pending open of the pipe for writing only returns when there's a reader.
+		if f, err := os.OpenFile(ep.Addr, os.O_RDONLY|syscall.O_NONBLOCK, 0); err == nil {
+			_ = f.Close()
+		}
+	}
+	for _, c := range clients {
+		c.stop()
+		<-c.done
+	}
+	if ep.isFifo {
+		_ = os.Remove(ep.Addr)
+	}
+}
+
+func (ep *LiveEndpoint) isClosed() bool {
+	ep.lc.mutex.Lock()
+	defer ep.lc.mutex.Unlock()
+	return ep.closed
+}
+
+func (ep *LiveEndpoint) acceptTcp() {
+	for {
+		conn, err := ep.ln.Accept()
+		if err != nil {
+			return // listener closed.
+		}
+		c := ep.addClient(conn.RemoteAddr().String(), conn)
+		if c == nil {
+			return
+		}
+		go ep.readTcpControl(c, conn)
+		go ep.serveClient(c)
+	}
+}
+
+func (ep *LiveEndpoint) servePipe() {
+	for !ep.isClosed() {
+		f, err := os.OpenFile(ep.Addr, os.O_WRONLY, 0) // blocks until a reader opens the pipe.
+		if err != nil {
+			return
+		}
+		c := ep.addClient(ep.Addr, f)
+		if c == nil {
+			_ = f.Close()
+			return
+		}
+		ep.serveClient(c)
+	}
+}
+
+// addClient adds a client to the endpoint, or returns nil if the endpoint is closed.
+func (ep *LiveEndpoint) addClient(name string, conn io.WriteCloser) *liveClient {
+	ep.lc.mutex.Lock()
+	defer ep.lc.mutex.Unlock()
+	if ep.closed {
+		_ = conn.Close()
+		return nil
+	}
+	c := &liveClient{
+		name:   name,
+		conn:   conn,
+		frames: make(chan *Frame, LiveClientQueueSize),
+		filter: channelFilter(ep.Filter),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	ep.clients[c] = struct{}{}
+	return c
+}
+
+// serveClient writes the capture stream to the client, until it disconnects.
+func (ep *LiveEndpoint) serveClient(c *liveClient) {
+	defer close(c.done)
+	defer func() {
+		ep.lc.mutex.Lock()
+		delete(ep.clients, c)
+		ep.lc.mutex.Unlock()
+		c.stop()
+	}()
+
+	w, err := NewStreamWriter(ep.lc.format, c.conn, ep.lc.channels)
+	if err != nil {
+		return
+	}
+	for {
+		select {
+		case frame := <-c.frames:
+			if err = w.WriteFrame(frame); err != nil {
+				return
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// readTcpControl reads channel filter commands sent by a TCP client, until it disconnects.
+func (ep *LiveEndpoint) readTcpControl(c *liveClient, conn net.Conn) {
+	defer c.stop()
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "channels" {
+			continue
+		}
+		var filter []int
+		if fields[1] != "all" {
+			for _, f := range fields[1:] {
+				if ch, err := strconv.Atoi(f); err == nil {
+					filter = append(filter, ch)
+				}
+			}
+			if ep.lc.checkFilter(filter) != nil {
+				continue
+			}
+		}
+		ep.lc.mutex.Lock()
+		c.filter = channelFilter(filter)
+		ep.lc.mutex.Unlock()
+	}
+}
+
+// stop makes the client stop serving, and closes its connection.
+func (c *liveClient) stop() {
+	c.once.Do(func() {
+		close(c.quit)
+		_ = c.conn.Close()
+	})
+}
+
+// channelFilter returns the filter for the channels, or nil (all channels) if none are given.
+func channelFilter(channels []int) map[int]struct{} {
+	if len(channels) == 0 {
+		return nil
+	}
+	filter := make(map[int]struct{}, len(channels))
+	for _, ch := range channels {
+		filter[ch] = struct{}{}
+	}
+	return filter
+}
+
+func filterChannels(filter map[int]struct{}) []int {
+	var channels []int
+	for ch := range filter {
+		channels = append(channels, ch)
+	}
+	sort.Ints(channels)
+	return channels
+}
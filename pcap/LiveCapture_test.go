@@ -0,0 +1,149 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readNgBlock reads the next pcapng block from r.
+func readNgBlock(t *testing.T, r io.Reader) ngBlock {
+	var hdr [8]byte
+	_, err := io.ReadFull(r, hdr[:])
+	assert.NoError(t, err)
+	body := make([]byte, binary.LittleEndian.Uint32(hdr[4:8])-8)
+	_, err = io.ReadFull(r, body)
+	assert.NoError(t, err)
+	return ngBlock{binary.LittleEndian.Uint32(hdr[0:4]), body[:len(body)-4]}
+}
+
+// waitClients waits until the endpoint has n clients.
+func waitClients(t *testing.T, ep *LiveEndpoint, n int) {
+	for i := 0; i < 100 && len(ep.Clients()) != n; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, n, len(ep.Clients()))
+}
+
+func TestLiveCaptureTcp(t *testing.T) {
+	lc := NewLiveCapture(FormatPcapNg, []int{11, 15})
+	defer lc.Close()
+
+	_, err := lc.ListenTcp("127.0.0.1:0", []int{12})
+	assert.Error(t, err)
+	ep, err := lc.ListenTcp("127.0.0.1:0", []int{15})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(lc.Endpoints()))
+
+	conn, err := net.Dial("tcp", ep.Addr)
+	assert.NoError(t, err)
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	waitClients(t, ep, 1)
+	assert.Equal(t, []int{15}, ep.Clients()[0].Filter)
+
+	assert.Equal(t, uint32(ngBlockTypeSectionHeader), readNgBlock(t, conn).Type)
+	assert.Equal(t, uint32(ngBlockTypeInterfaceDescription), readNgBlock(t, conn).Type)
+	assert.Equal(t, uint32(ngBlockTypeInterfaceDescription), readNgBlock(t, conn).Type)
+
+	// the endpoint filter only passes channel 15.
+	lc.WriteFrame(&Frame{Ustime: 1, Data: []byte{1}, Channel: 11})
+	lc.WriteFrame(&Frame{Ustime: 2, Data: []byte{2}, Channel: 15})
+	epb := readNgBlock(t, conn)
+	assert.Equal(t, uint32(ngBlockTypeEnhancedPacket), epb.Type)
+	assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(epb.Body[8:12]))
+
+	// the client changes its filter to channel 11.
+	_, err = conn.Write([]byte("channels 11\n"))
+	assert.NoError(t, err)
+	for i := 0; i < 100 && !assert.ObjectsAreEqual([]int{11}, ep.Clients()[0].Filter); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	lc.WriteFrame(&Frame{Ustime: 3, Data: []byte{3}, Channel: 15})
+	lc.WriteFrame(&Frame{Ustime: 4, Data: []byte{4}, Channel: 11})
+	epb = readNgBlock(t, conn)
+	assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(epb.Body[8:12]))
+
+	assert.NoError(t, lc.CloseEndpoint(ep.Id))
+	assert.Error(t, lc.CloseEndpoint(ep.Id))
+	assert.Equal(t, 0, len(lc.Endpoints()))
+	_, err = conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+}
+
+func TestLiveCapturePipe(t *testing.T) {
+	lc := NewLiveCapture(FormatPcap, []int{11})
+	path := filepath.Join(t.TempDir(), "otns.pipe")
+	ep, err := lc.OpenPipe(path, nil)
+	assert.NoError(t, err)
+
+	f, err := os.OpenFile(path, os.O_RDONLY, 0)
+	assert.NoError(t, err)
+	waitClients(t, ep, 1)
+	lc.WriteFrame(&Frame{Ustime: 1000001, Data: []byte{1, 2}, Channel: 11})
+
+	buf := make([]byte, pcapFileHeaderSize+pcapFrameHeaderSize+2)
+	_, err = io.ReadFull(f, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(pcapMagicNumber), binary.LittleEndian.Uint32(buf[0:4]))
+	assert.Equal(t, uint32(1), binary.LittleEndian.Uint32(buf[pcapFileHeaderSize:]))
+	assert.Equal(t, []byte{1, 2}, buf[pcapFileHeaderSize+pcapFrameHeaderSize:])
+
+	lc.Close()
+	_ = f.Close()
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLiveCaptureDropsFrames(t *testing.T) {
+	lc := NewLiveCapture(FormatPcap, []int{11})
+	ep := lc.addEndpoint("test", "test", nil)
+	c := ep.addClient("client", nopWriteCloser{})
+	for i := 0; i < LiveClientQueueSize+5; i++ {
+		lc.WriteFrame(&Frame{Channel: 11})
+	}
+	assert.Equal(t, uint64(5), ep.Clients()[0].Dropped)
+	assert.Equal(t, LiveClientQueueSize, len(c.frames))
+}
+
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
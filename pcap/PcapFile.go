@@ -28,6 +28,7 @@ package pcap
 
 import (
 	"encoding/binary"
+	"io"
 	"os"
 )
 
@@ -42,7 +43,8 @@ const (
 )
 
 type File struct {
-	fd *os.File
+	w  io.Writer
+	fd *os.File // nil if not writing to a file.
 }
 
 func NewFile(filename string) (*File, error) {
@@ -52,6 +54,7 @@ func NewFile(filename string) (*File, error) {
 	}
 
 	pf := &File{
+		w:  fd,
 		fd: fd,
 	}
 
@@ -74,21 +77,32 @@ func (pf *File) AppendFrame(ustime uint64, frame []byte) error {
 
 	var err error
 
-	_, err = pf.fd.Write(header[:])
+	_, err = pf.w.Write(header[:])
 	if err != nil {
 		return err
 	}
 
-	_, err = pf.fd.Write(frame)
+	_, err = pf.w.Write(frame)
 	return err
 }
 
+// WriteFrame appends the frame; the classic pcap format can't store its metadata.
+func (pf *File) WriteFrame(frame *Frame) error {
+	return pf.AppendFrame(frame.Ustime, frame.Data)
+}
+
 func (pf *File) Sync() error {
+	if pf.fd == nil {
+		return nil
+	}
 	return pf.fd.Sync()
 }
 
 func (pf *File) Close() error {
-	return pf.fd.Close()
+	if c, ok := pf.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
 }
 
 func (pf *File) writeHeader() error {
@@ -100,8 +114,8 @@ func (pf *File) writeHeader() error {
 	binary.LittleEndian.PutUint32(header[12:16], 0)
 	binary.LittleEndian.PutUint32(header[16:20], 256)
 	binary.LittleEndian.PutUint32(header[20:24], dltIeee802154)
-	if _, err := pf.fd.Write(header[:]); err != nil {
+	if _, err := pf.w.Write(header[:]); err != nil {
 		return err
 	}
-	return pf.fd.Sync()
+	return pf.Sync()
 }
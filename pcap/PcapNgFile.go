@@ -0,0 +1,232 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+)
+
+const (
+	dltIeee802154Tap = 283
+
+	ngBlockTypeSectionHeader        = 0x0A0D0D0A
+	ngBlockTypeInterfaceDescription = 0x00000001
+	ngBlockTypeEnhancedPacket       = 0x00000006
+	ngByteOrderMagic                = 0x1A2B3C4D
+	ngVersionMajor                  = 1
+	ngVersionMinor                  = 0
+
+	ngOptEndOfOpt      = 0
+	ngOptComment       = 1
+	ngOptShbUserAppl   = 4
+	ngOptIfName        = 2
+	ngOptIfDescription = 3
+
+	ngSnapLen = 256
+
+	tapTlvFcsType = 0
+	tapTlvChannel = 3
+	tapFcsType16  = 1 // 16-bit CRC
+	tapHeaderLen  = 4
+)
+
+// NgFile is a pcapng capture file. Each captured channel has its own interface, named after the channel, so
+// that frames of different channels can be told apart in Wireshark.
+type NgFile struct {
+	w          io.Writer
+	fd         *os.File // nil if not writing to a file.
+	useTap     bool
+	interfaces map[int]uint32 // channel to interface id
+}
+
+// NewNgFile creates a pcapng capture file for the given channels. If useTap is true, the IEEE 802.15.4 TAP link
+// type is used, which stores the channel of each frame in a TAP header. Frames are captured at the transmitter,
+// so the TAP header has no RSS or LQI of a receiver.
+func NewNgFile(filename string, channels []int, useTap bool) (*NgFile, error) {
+	fd, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	pf, err := newNgWriter(fd, channels, useTap)
+	if err != nil {
+		_ = fd.Close()
+		return nil, err
+	}
+	pf.fd = fd
+	return pf, pf.Sync()
+}
+
+func newNgWriter(w io.Writer, channels []int, useTap bool) (*NgFile, error) {
+	pf := &NgFile{
+		w:          w,
+		useTap:     useTap,
+		interfaces: make(map[int]uint32, len(channels)),
+	}
+	if err := pf.writeHeader(channels); err != nil {
+		return nil, err
+	}
+	return pf, nil
+}
+
+func (pf *NgFile) WriteFrame(frame *Frame) error {
+	ifId, ok := pf.interfaces[frame.Channel]
+	if !ok {
+		return fmt.Errorf("channel %d is not captured", frame.Channel)
+	}
+
+	data := frame.Data
+	if pf.useTap {
+		data = append(pf.tapHeader(frame), data...)
+	}
+
+	body := make([]byte, 20, 20+pad4(len(data)))
+	binary.LittleEndian.PutUint32(body[0:4], ifId)
+	binary.LittleEndian.PutUint32(body[4:8], uint32(frame.Ustime>>32))
+	binary.LittleEndian.PutUint32(body[8:12], uint32(frame.Ustime))
+	binary.LittleEndian.PutUint32(body[12:16], uint32(len(data)))
+	binary.LittleEndian.PutUint32(body[16:20], uint32(len(data)))
+	body = appendPadded(body, data)
+	body = appendOption(body, ngOptComment, []byte(frameComment(frame)))
+	body = appendOption(body, ngOptEndOfOpt, nil)
+
+	return pf.writeBlock(ngBlockTypeEnhancedPacket, body)
+}
+
+func (pf *NgFile) Sync() error {
+	if pf.fd == nil {
+		return nil
+	}
+	return pf.fd.Sync()
+}
+
+func (pf *NgFile) Close() error {
+	if c, ok := pf.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (pf *NgFile) writeHeader(channels []int) error {
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb[0:4], ngByteOrderMagic)
+	binary.LittleEndian.PutUint16(shb[4:6], ngVersionMajor)
+	binary.LittleEndian.PutUint16(shb[6:8], ngVersionMinor)
+	binary.LittleEndian.PutUint64(shb[8:16], math.MaxUint64) // section length not specified.
+	shb = appendOption(shb, ngOptShbUserAppl, []byte("OTNS"))
+	shb = appendOption(shb, ngOptEndOfOpt, nil)
+	if err := pf.writeBlock(ngBlockTypeSectionHeader, shb); err != nil {
+		return err
+	}
+
+	linkType := uint16(dltIeee802154)
+	if pf.useTap {
+		linkType = dltIeee802154Tap
+	}
+	sorted := append([]int{}, channels...)
+	sort.Ints(sorted)
+	for _, ch := range sorted {
+		if _, ok := pf.interfaces[ch]; ok {
+			continue
+		}
+		idb := make([]byte, 8)
+		binary.LittleEndian.PutUint16(idb[0:2], linkType)
+		binary.LittleEndian.PutUint32(idb[4:8], ngSnapLen)
+		idb = appendOption(idb, ngOptIfName, []byte(fmt.Sprintf("ch%d", ch)))
+		idb = appendOption(idb, ngOptIfDescription, []byte(fmt.Sprintf("OTNS IEEE 802.15.4 channel %d", ch)))
+		idb = appendOption(idb, ngOptEndOfOpt, nil)
+		if err := pf.writeBlock(ngBlockTypeInterfaceDescription, idb); err != nil {
+			return err
+		}
+		pf.interfaces[ch] = uint32(len(pf.interfaces))
+	}
+	return nil
+}
+
+// writeBlock writes a pcapng block with the given body, which must be padded to 32 bits.
+func (pf *NgFile) writeBlock(blockType uint32, body []byte) error {
+	blockLen := uint32(len(body) + 12)
+	block := make([]byte, 8, blockLen)
+	binary.LittleEndian.PutUint32(block[0:4], blockType)
+	binary.LittleEndian.PutUint32(block[4:8], blockLen)
+	block = append(block, body...)
+	block = appendUint32(block, blockLen)
+	_, err := pf.w.Write(block)
+	return err
+}
+
+// tapHeader returns the IEEE 802.15.4 TAP header with the metadata of the frame.
+func (pf *NgFile) tapHeader(frame *Frame) []byte {
+	hdr := make([]byte, tapHeaderLen)
+	hdr = appendTlv(hdr, tapTlvFcsType, []byte{tapFcsType16})
+	channel := appendUint16(nil, uint16(frame.Channel))
+	hdr = appendTlv(hdr, tapTlvChannel, append(channel, 0))   // channel page 0
+	binary.LittleEndian.PutUint16(hdr[2:4], uint16(len(hdr))) // version and reserved bytes are 0.
+	return hdr
+}
+
+func frameComment(frame *Frame) string {
+	s := fmt.Sprintf("node %d, channel %d, tx power %d dBm", frame.NodeId, frame.Channel, frame.TxPowerDbm)
+	if len(frame.Comment) > 0 {
+		s += ": " + frame.Comment
+	}
+	return s
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
+
+func appendPadded(b []byte, data []byte) []byte {
+	b = append(b, data...)
+	return append(b, make([]byte, pad4(len(data))-len(data))...)
+}
+
+// appendOption appends a pcapng option. Both pcapng options and TAP TLVs have a 16-bit type and length,
+// followed by the value padded to 32 bits.
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = appendUint16(b, code)
+	b = appendUint16(b, uint16(len(value)))
+	return appendPadded(b, value)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v), byte(v>>8))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendTlv(b []byte, tlvType uint16, value []byte) []byte {
+	return appendOption(b, tlvType, value)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ngBlock struct {
+	Type uint32
+	Body []byte
+}
+
+func readNgBlocks(t *testing.T, fp string) []ngBlock {
+	data, err := os.ReadFile(fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks []ngBlock
+	for len(data) > 0 {
+		assert.True(t, len(data) >= 12)
+		blockLen := binary.LittleEndian.Uint32(data[4:8])
+		assert.Equal(t, uint32(0), blockLen%4)
+		assert.Equal(t, blockLen, binary.LittleEndian.Uint32(data[blockLen-4:blockLen]))
+		blocks = append(blocks, ngBlock{binary.LittleEndian.Uint32(data[0:4]), data[8 : blockLen-4]})
+		data = data[blockLen:]
+	}
+	return blocks
+}
+
+// readNgOptions reads pcapng options, or TAP TLVs if isTap is true; those have no end marker.
+func readNgOptions(b []byte, isTap bool) map[uint16][]byte {
+	opts := map[uint16][]byte{}
+	for len(b) >= 4 {
+		code := binary.LittleEndian.Uint16(b[0:2])
+		optLen := int(binary.LittleEndian.Uint16(b[2:4]))
+		if code == ngOptEndOfOpt && !isTap {
+			break
+		}
+		opts[code] = b[4 : 4+optLen]
+		b = b[4+pad4(optLen):]
+	}
+	return opts
+}
+
+func TestPcapNgFile(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "test.pcapng")
+	pf, err := NewNgFile(fp, []int{15, 11}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, pf.WriteFrame(&Frame{Ustime: 5000001, Data: []byte{1, 2, 3}, Channel: 15, TxPowerDbm: -60, NodeId: 3}))
+	assert.Error(t, pf.WriteFrame(&Frame{Data: []byte{1}, Channel: 20}))
+	assert.NoError(t, pf.Close())
+
+	blocks := readNgBlocks(t, fp)
+	assert.Equal(t, 4, len(blocks))
+	assert.Equal(t, uint32(ngBlockTypeSectionHeader), blocks[0].Type)
+	assert.Equal(t, uint32(ngByteOrderMagic), binary.LittleEndian.Uint32(blocks[0].Body[0:4]))
+
+	// one interface per channel, in channel order.
+	for i, ch := range []string{"ch11", "ch15"} {
+		idb := blocks[1+i]
+		assert.Equal(t, uint32(ngBlockTypeInterfaceDescription), idb.Type)
+		assert.Equal(t, uint16(dltIeee802154), binary.LittleEndian.Uint16(idb.Body[0:2]))
+		assert.Equal(t, ch, string(readNgOptions(idb.Body[8:], false)[ngOptIfName]))
+	}
+
+	epb := blocks[3]
+	assert.Equal(t, uint32(ngBlockTypeEnhancedPacket), epb.Type)
+	assert.Equal(t, uint32(1), binary.LittleEndian.Uint32(epb.Body[0:4]))
+	assert.Equal(t, uint32(0), binary.LittleEndian.Uint32(epb.Body[4:8]))
+	assert.Equal(t, uint32(5000001), binary.LittleEndian.Uint32(epb.Body[8:12]))
+	assert.Equal(t, uint32(3), binary.LittleEndian.Uint32(epb.Body[12:16]))
+	assert.Equal(t, []byte{1, 2, 3}, epb.Body[20:23])
+	assert.Equal(t, "node 3, channel 15, tx power -60 dBm", string(readNgOptions(epb.Body[24:], false)[ngOptComment]))
+}
+
+func TestPcapNgFileTap(t *testing.T) {
+	fp := filepath.Join(t.TempDir(), "test.pcapng")
+	pf, err := NewWriter(FormatPcapNgTap, fp, []int{11})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := &Frame{Data: []byte{1, 2, 3, 4}, Channel: 11, TxPowerDbm: -70, NodeId: 2, Comment: "Beacon"}
+	assert.NoError(t, pf.WriteFrame(frame))
+	assert.NoError(t, pf.Close())
+
+	blocks := readNgBlocks(t, fp)
+	assert.Equal(t, 3, len(blocks))
+	assert.Equal(t, uint16(dltIeee802154Tap), binary.LittleEndian.Uint16(blocks[1].Body[0:2]))
+
+	epb := blocks[2].Body
+	capLen := int(binary.LittleEndian.Uint32(epb[12:16]))
+	data := epb[20 : 20+capLen]
+	tapLen := int(binary.LittleEndian.Uint16(data[2:4]))
+	assert.Equal(t, byte(0), data[0])
+	assert.Equal(t, capLen, tapLen+4)
+	assert.Equal(t, []byte{1, 2, 3, 4}, data[tapLen:])
+
+	tlvs := readNgOptions(data[tapHeaderLen:tapLen], true)
+	assert.Equal(t, []byte{tapFcsType16}, tlvs[tapTlvFcsType])
+	assert.Equal(t, 2, len(tlvs))
+	assert.Equal(t, []byte{11, 0, 0}, tlvs[tapTlvChannel])
+	assert.Equal(t, "node 2, channel 11, tx power -70 dBm: Beacon",
+		string(readNgOptions(epb[20+pad4(capLen):], false)[ngOptComment]))
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range []Format{FormatPcap, FormatPcapNg, FormatPcapNgTap} {
+		parsed, err := ParseFormat(f.String())
+		assert.NoError(t, err)
+		assert.Equal(t, f, parsed)
+	}
+	_, err := ParseFormat("pcapx")
+	assert.Error(t, err)
+	assert.Equal(t, "pcap", FormatPcap.FileExt())
+	assert.Equal(t, "pcapng", FormatPcapNgTap.FileExt())
+}
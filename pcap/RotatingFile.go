@@ -0,0 +1,185 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RotatingFile is a capture file that is rotated once it exceeds a maximum size: the file is renamed with a
+// sequence number, e.g. "current.pcap" to "current.1.pcap", and a new capture file is started. Optionally, only
+// the most recent rotated files are kept. Rotation is configured with SetRotation and may be changed at any time.
+type RotatingFile struct {
+	mutex     sync.Mutex
+	format    Format
+	filename  string
+	channels  []int
+	fd        *os.File
+	w         Writer
+	size      int64 // bytes written to the current file.
+	maxSize   int64 // 0 if not rotating.
+	keep      int   // number of rotated files to keep; 0 keeps all.
+	rotations int   // sequence number of the last rotated file.
+}
+
+// NewRotatingFile creates a capture file in the given format; see NewWriter.
+func NewRotatingFile(format Format, filename string, channels []int) (*RotatingFile, error) {
+	rf := &RotatingFile{
+		format:   format,
+		filename: filename,
+		channels: channels,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+// SetRotation sets the maximum file size in bytes after which the file is rotated (0 for no rotation), and the
+// number of rotated files to keep (0 to keep all).
+func (rf *RotatingFile) SetRotation(maxSize int64, keep int) {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	rf.maxSize, rf.keep = maxSize, keep
+}
+
+// Rotation returns the maximum file size and number of rotated files to keep, as set by SetRotation.
+func (rf *RotatingFile) Rotation() (maxSize int64, keep int) {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	return rf.maxSize, rf.keep
+}
+
+// Size returns the size of the current file in bytes.
+func (rf *RotatingFile) Size() int64 {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	return rf.size
+}
+
+// Rotations returns the number of times the file was rotated.
+func (rf *RotatingFile) Rotations() int {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	return rf.rotations
+}
+
+func (rf *RotatingFile) Filename() string {
+	return rf.filename
+}
+
+func (rf *RotatingFile) WriteFrame(frame *Frame) error {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	if rf.w == nil {
+		return fmt.Errorf("capture file %s is closed", rf.filename)
+	}
+	if rf.maxSize > 0 && rf.size >= rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return err
+		}
+	}
+	return rf.w.WriteFrame(frame)
+}
+
+func (rf *RotatingFile) Sync() error {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	if rf.fd == nil {
+		return nil
+	}
+	return rf.fd.Sync()
+}
+
+func (rf *RotatingFile) Close() error {
+	rf.mutex.Lock()
+	defer rf.mutex.Unlock()
+	return rf.close()
+}
+
+// RotatedFilename returns the name of the rotated file with sequence number seq.
+func (rf *RotatingFile) RotatedFilename(seq int) string {
+	ext := filepath.Ext(rf.filename)
+	return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(rf.filename, ext), seq, ext)
+}
+
+func (rf *RotatingFile) open() error {
+	fd, err := os.OpenFile(rf.filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	rf.fd = fd
+	rf.size = 0
+	if rf.w, err = NewStreamWriter(rf.format, &sizeCountingWriter{w: fd, size: &rf.size}, rf.channels); err != nil {
+		_ = rf.close()
+		return err
+	}
+	return nil
+}
+
+func (rf *RotatingFile) close() error {
+	if rf.fd == nil {
+		return nil
+	}
+	err := rf.fd.Close()
+	rf.fd, rf.w = nil, nil
+	return err
+}
+
+func (rf *RotatingFile) rotate() error {
+	if err := rf.close(); err != nil {
+		return err
+	}
+	rf.rotations++
+	if err := os.Rename(rf.filename, rf.RotatedFilename(rf.rotations)); err != nil {
+		return err
+	}
+	if rf.keep > 0 {
+		for seq := rf.rotations - rf.keep; seq > 0; seq-- {
+			if err := os.Remove(rf.RotatedFilename(seq)); err != nil {
+				break // older files were removed before.
+			}
+		}
+	}
+	return rf.open()
+}
+
+type sizeCountingWriter struct {
+	w    io.Writer
+	size *int64
+}
+
+func (scw *sizeCountingWriter) Write(p []byte) (int, error) {
+	n, err := scw.w.Write(p)
+	*scw.size += int64(n)
+	return n, err
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingFile(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "current.pcap")
+	rf, err := NewRotatingFile(FormatPcap, fn, []int{11})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = rf.Close()
+	}()
+	assert.Equal(t, int64(pcapFileHeaderSize), rf.Size())
+	assert.Equal(t, filepath.Join(filepath.Dir(fn), "current.3.pcap"), rf.RotatedFilename(3))
+
+	frame := &Frame{Data: make([]byte, 84), Channel: 11}
+	frameSize := int64(pcapFrameHeaderSize + len(frame.Data))
+
+	// no rotation by default.
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, rf.WriteFrame(frame))
+	}
+	assert.Equal(t, pcapFileHeaderSize+10*frameSize, rf.Size())
+	assert.Equal(t, 0, rf.Rotations())
+
+	// rotate once the file reaches 3 frames; keep 2 rotated files.
+	rf.SetRotation(pcapFileHeaderSize+3*frameSize, 2)
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, rf.WriteFrame(frame))
+	}
+	assert.Nil(t, rf.Sync())
+	assert.Equal(t, 4, rf.Rotations())
+	assert.Equal(t, pcapFileHeaderSize+frameSize, int64(getFileSize(t, fn)))
+	assert.Equal(t, pcapFileHeaderSize+3*frameSize, int64(getFileSize(t, rf.RotatedFilename(4))))
+	assert.Equal(t, pcapFileHeaderSize+3*frameSize, int64(getFileSize(t, rf.RotatedFilename(3))))
+	for _, seq := range []int{1, 2} {
+		_, err = os.Stat(rf.RotatedFilename(seq))
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	assert.Nil(t, rf.Close())
+	assert.NotNil(t, rf.WriteFrame(frame))
+}
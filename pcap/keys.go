@@ -0,0 +1,48 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// WiresharkKeysFileName is the name of the file in a Wireshark profile directory that holds the IEEE 802.15.4
+// decryption keys.
+const WiresharkKeysFileName = "ieee802154_keys"
+
+// WriteWiresharkKeys writes Thread network keys to filename, in the format of the Wireshark IEEE 802.15.4
+// decryption keys table. With the file copied to a Wireshark profile directory as WiresharkKeysFileName,
+// Wireshark decrypts the MAC and MLE secured frames of the capture.
+func WriteWiresharkKeys(filename string, networkKeys [][]byte) error {
+	var sb strings.Builder
+	for _, key := range networkKeys {
+		sb.WriteString(fmt.Sprintf("\"%x\",\"1\",\"Thread hash\"\n", key))
+	}
+	return os.WriteFile(filename, []byte(sb.String()), 0644)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package pcap
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is the file format of a packet capture.
+type Format int
+
+const (
+	// FormatPcap is the classic pcap format, with link type IEEE 802.15.4 (with FCS). It has no frame metadata.
+	FormatPcap Format = iota
+	// FormatPcapNg is the pcapng format, with link type IEEE 802.15.4 (with FCS) and one interface per channel.
+	// Frame metadata is stored in frame comments.
+	FormatPcapNg
+	// FormatPcapNgTap is the pcapng format, with link type IEEE 802.15.4 TAP and one interface per channel.
+	// Frame metadata is stored in the TAP header, which Wireshark shows per frame, and in frame comments.
+	FormatPcapNgTap
+)
+
+var formatNames = map[Format]string{
+	FormatPcap:      "pcap",
+	FormatPcapNg:    "pcapng",
+	FormatPcapNgTap: "pcapng-tap",
+}
+
+func (f Format) String() string {
+	if s, ok := formatNames[f]; ok {
+		return s
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// FileExt returns the file name extension for the format.
+func (f Format) FileExt() string {
+	if f == FormatPcap {
+		return "pcap"
+	}
+	return "pcapng"
+}
+
+// ParseFormat parses a format name as returned by Format.String().
+func ParseFormat(s string) (Format, error) {
+	for f, name := range formatNames {
+		if strings.EqualFold(s, name) {
+			return f, nil
+		}
+	}
+	return FormatPcap, fmt.Errorf("unknown pcap format: %s", s)
+}
+
+// Frame is a captured radio frame with its metadata.
+type Frame struct {
+	Ustime     uint64 // capture time, unit: us
+	Data       []byte // the PSDU, including FCS
+	Channel    int
+	TxPowerDbm int8   // the Tx power of the transmitter in dBm
+	NodeId     int    // the transmitter node
+	Comment    string // optional frame comment
+}
+
+// Writer writes frames to a capture file.
+type Writer interface {
+	WriteFrame(frame *Frame) error
+	Sync() error
+	Close() error
+}
+
+// NewWriter creates a capture file in the given format. The channels are the channels that will be captured,
+// which in the pcapng formats each get their own interface.
+func NewWriter(format Format, filename string, channels []int) (Writer, error) {
+	switch format {
+	case FormatPcap:
+		return NewFile(filename)
+	case FormatPcapNg:
+		return NewNgFile(filename, channels, false)
+	case FormatPcapNgTap:
+		return NewNgFile(filename, channels, true)
+	default:
+		return nil, fmt.Errorf("unknown pcap format: %v", format)
+	}
+}
+
+// NewStreamWriter creates a Writer that writes a capture in the given format to w, e.g. a pipe or a socket.
+func NewStreamWriter(format Format, w io.Writer, channels []int) (Writer, error) {
+	switch format {
+	case FormatPcap:
+		pf := &File{w: w}
+		if err := pf.writeHeader(); err != nil {
+			return nil, err
+		}
+		return pf, nil
+	case FormatPcapNg:
+		return newNgWriter(w, channels, false)
+	case FormatPcapNgTap:
+		return newNgWriter(w, channels, true)
+	default:
+		return nil, fmt.Errorf("unknown pcap format: %v", format)
+	}
+}
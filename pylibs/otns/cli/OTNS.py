@@ -127,7 +127,8 @@ class OTNS(object):
         :param fname: the file name of the .pcap file to save to.
         """
         os.makedirs(fpath, exist_ok = True)
-        shutil.copy2("current.pcap", os.path.join(fpath,fname))
+        src = "current.pcap" if os.path.exists("current.pcap") else "current.pcapng"
+        shutil.copy2(src, os.path.join(fpath,fname))
 
     @property
     def autogo(self) -> bool:
@@ -365,7 +366,7 @@ class OTNS(object):
         return True
 
     def add(self, type: str, x: float = None, y: float = None, id=None, radio_range=None, executable=None,
-            restore=False, txpower: int=None, version: str = None) -> int:
+            restore=False, txpower: int=None, version: str = None, rcp: bool = False) -> int:
         """
         Add a new node to the simulation.
 
@@ -378,6 +379,7 @@ class OTNS(object):
         :param restore: whether the node restores network configuration from persistent storage
         :param txpower: Tx power in dBm of node, or None for OT node default
         :param version: optional OT node version string like 'v11', 'v12', or 'v13'
+        :param rcp: whether the node runs an RCP process plus a host process with the Thread stack
 
         :return: added node ID
         """
@@ -399,6 +401,9 @@ class OTNS(object):
         if restore:
             cmd += f' restore'
 
+        if rcp:
+            cmd += f' rcp'
+
         if version is not None:
             cmd += f' {version}'
 
@@ -528,6 +533,37 @@ class OTNS(object):
         """
         self._do_command(f'plr {value}')
 
+    def set_loss_model(self, model: str, nodeid: int = None, link: Tuple[int, int] = None) -> str:
+        """
+        Set the packet loss model, globally or for a specific node or directed link.
+
+        :param model: loss model, e.g. '0.1', 'ge 0.01 0.3', 'outage 60 5' or 'default'
+        :param nodeid: node ID to set the loss model for, or None
+        :param link: (source node ID, destination node ID) to set the loss model for, or None
+
+        :return: the loss model as set
+        """
+        return self._expect_str(self._do_command(f'plr {self._loss_model_target(nodeid, link)}{model}'))
+
+    def get_loss_model(self, nodeid: int = None, link: Tuple[int, int] = None) -> str:
+        """
+        Get the packet loss model, globally or for a specific node or directed link.
+
+        :param nodeid: node ID to get the loss model of, or None
+        :param link: (source node ID, destination node ID) to get the loss model of, or None
+
+        :return: the loss model, or 'default' if no node or link specific loss model is set
+        """
+        return self._expect_str(self._do_command(f'plr {self._loss_model_target(nodeid, link)}'))
+
+    @staticmethod
+    def _loss_model_target(nodeid: int = None, link: Tuple[int, int] = None) -> str:
+        if nodeid is not None:
+            return f'node {nodeid} '
+        elif link is not None:
+            return f'link {link[0]} {link[1]} '
+        return ''
+
     def nodes(self) -> Dict[int, Dict[str, Any]]:
         """
         Get all nodes in simulation
@@ -537,11 +573,16 @@ class OTNS(object):
         cmd = 'nodes'
         output = self._do_command(cmd)
         nodes = {}
+        nodeinfo = {}
         for line in output:
+            if line.startswith('\tstderr: '):
+                nodeinfo.setdefault('stderr', []).append(line[len('\tstderr: '):])
+                continue
+
             nodeinfo = {}
             for kv in line.split():
                 k, v = kv.split('=')
-                if k in ('id', 'x', 'y'):
+                if k in ('id', 'x', 'y', 'crashes'):
                     v = int(v)
                 elif k in ('extaddr', 'rloc16'):
                     v = int(v, 16)
@@ -575,6 +616,105 @@ class OTNS(object):
 
         return partitions
 
+    def cut(self, group_a: Collection[int], group_b: Collection[int]) -> None:
+        """
+        Block all radio frames between two groups of nodes, regardless of distance.
+
+        :param group_a: node IDs of the first group
+        :param group_b: node IDs of the second group
+        """
+        self._do_command(f'cut {" ".join(map(str, group_a))} | {" ".join(map(str, group_b))}')
+
+    def heal(self) -> None:
+        """
+        Remove all cuts between groups of nodes.
+        """
+        self._do_command('heal')
+
+    def cuts(self) -> List[Tuple[List[int], List[int]]]:
+        """
+        Get the active cuts between groups of nodes.
+
+        :return: list of cuts, each of format (node IDs of first group, node IDs of second group)
+        """
+        output = self._do_command('cut')
+        cuts = []
+        for line in output:
+            line = line.split()
+            assert line[0].startswith('groupA=') and line[1].startswith('groupB='), line
+            group_a = list(map(int, line[0].split('=')[1].split(',')))
+            group_b = list(map(int, line[1].split('=')[1].split(',')))
+            cuts.append((group_a, group_b))
+
+        return cuts
+
+    def heatmap(self, *nodeids: int, cell_size: Optional[int] = None, show: bool = False) -> List[Dict[str, Any]]:
+        """
+        Compute the RSSI and SINR coverage heatmap of transmitter nodes, using the current radio model.
+
+        :param nodeids: node IDs of the transmitters, or none for all nodes
+        :param cell_size: size of the grid cells, or None for default.
+        :param show: if True, also show the heatmap in the web UI.
+
+        :return: list of cells, each a dict with keys 'x', 'y', 'rssi', 'sinr', 'node' and 'reachable'
+        """
+        cmd = 'heatmap'
+        if nodeids:
+            cmd += ' ' + ' '.join(map(str, nodeids))
+        if cell_size is not None:
+            cmd += f' cell {cell_size}'
+        if show:
+            cmd += ' show'
+        output = self._do_command(cmd)
+        cells = []
+        for line in output[1:]:
+            x, y, rssi, sinr, node, reachable = line.split('\t')
+            cells.append({
+                'x': int(x),
+                'y': int(y),
+                'rssi': float(rssi.split('=')[1]),
+                'sinr': float(sinr.split('=')[1]),
+                'node': int(node.split('=')[1]),
+                'reachable': reachable == 'ok',
+            })
+        return cells
+
+    def hide_heatmap(self) -> None:
+        """
+        Hide the heatmap shown in the web UI.
+        """
+        self._do_command('heatmap hide')
+
+    def set_restart_policy(self,
+                           *nodeids: int,
+                           policy: str,
+                           backoff: Optional[float] = None,
+                           max_backoff: Optional[float] = None) -> None:
+        """
+        Set the restart policy for nodes whose OT process exits unexpectedly.
+
+        :param nodeids: node IDs
+        :param policy: 'never', 'on-failure' or 'always'
+        :param backoff: delay (seconds) before the first restart after a crash, or None for default.
+        :param max_backoff: maximum delay (seconds) before a restart, or None for default. Requires backoff.
+        """
+        cmd = f'restartpolicy {" ".join(map(str, nodeids))} {policy}'
+        if backoff is not None:
+            cmd += f' {backoff}'
+            if max_backoff is not None:
+                cmd += f' {max_backoff}'
+        self._do_command(cmd)
+
+    def get_restart_policy(self, nodeid: int) -> str:
+        """
+        Get the restart policy of a node.
+
+        :param nodeid: node ID
+        :return: the policy, e.g. 'never' or 'on-failure 1 60' (with backoff and max-backoff in seconds)
+        """
+        line = self._expect_str(self._do_command(f'restartpolicy {nodeid}'))
+        return line.split('\t', 1)[1]
+
     def radio_on(self, *nodeids: int) -> None:
         """
         Turn on node radio.
@@ -591,16 +731,48 @@ class OTNS(object):
         """
         self._do_command(f'radio {" ".join(map(str, nodeids))} off')
 
-    def radio_set_fail_time(self, *nodeids: int, fail_time: Optional[Tuple[int, int]]) -> None:
+    def radio_set_fail_time(self, *nodeids: int, fail_time: Optional[Tuple[int, int]], reboot: bool = False,
+                            wipe_flash: bool = False) -> None:
         """
         Set node radio fail time parameters.
 
         :param nodeids: node IDs
         :param fail_time: fail time (fail_duration, fail_interval) or None for always on.
+        :param reboot: if True, the node's OT process is restarted at the end of each failure.
+        :param wipe_flash: if True, the node's flash is wiped when it is restarted (implies reboot).
         """
-        fail_duration, period_time = fail_time
+        fail_duration, period_time = fail_time or (0, 0)
         cmd = f'radio {" ".join(map(str, nodeids))} ft {fail_duration} {period_time}'
-        self._do_command(cmd)
+        self._do_command(cmd + self._fail_kind_args(reboot, wipe_flash))
+
+    def radio_set_failure_model(self,
+                                *nodeids: int,
+                                mtbf: float,
+                                mttr: float,
+                                shape: Optional[float] = None,
+                                reboot: bool = False,
+                                wipe_flash: bool = False) -> None:
+        """
+        Set node failures with randomly distributed up and down times.
+
+        :param nodeids: node IDs
+        :param mtbf: mean time between failures (seconds)
+        :param mttr: mean time to recover from a failure (seconds)
+        :param shape: Weibull shape parameter, or None for exponentially distributed times.
+        :param reboot: if True, the node's OT process is restarted at the end of each failure.
+        :param wipe_flash: if True, the node's flash is wiped when it is restarted (implies reboot).
+        """
+        model = 'exp' if shape is None else f'weibull {shape}'
+        cmd = f'radio {" ".join(map(str, nodeids))} ft {model} {mtbf} {mttr}'
+        self._do_command(cmd + self._fail_kind_args(reboot, wipe_flash))
+
+    @staticmethod
+    def _fail_kind_args(reboot: bool, wipe_flash: bool) -> str:
+        if wipe_flash:
+            return ' reboot wipe'
+        if reboot:
+            return ' reboot'
+        return ''
 
     def pings(self) -> List[Tuple[int, str, int, float]]:
         """
@@ -654,6 +826,59 @@ class OTNS(object):
 
         return counters
 
+    def stats(self, nodeid: Optional[int] = None) -> Dict[Any, Any]:
+        """
+        Get radio frame statistics.
+
+        :param nodeid: node ID, or None for a summary of all nodes
+        :return: if nodeid is None, dict with node IDs as keys and summary counters
+                 (tx_frames, tx_delivered, tx_dropped, rx_delivered, rx_dropped) as values.
+                 Otherwise dict with keys 'tx' and 'rx' and counters per drop reason (and 'delivered') as values,
+                 plus the number of frames transmitted under key 'tx_frames'.
+        """
+        if nodeid is None:
+            stats = {}
+            for line in self._do_command('stats'):
+                counters = self._parse_stats_fields(line.split('\t'))
+                stats[counters.pop('node')] = counters
+            return stats
+
+        stats = {}
+        for line in self._do_command(f'stats {nodeid}'):
+            fields = line.split('\t')
+            if fields[0] in ('tx', 'rx'):
+                stats[fields[0]] = self._parse_stats_fields(fields[1:])
+            else:
+                stats.update(self._parse_stats_fields(fields))
+        return stats
+
+    def link_stats(self) -> Dict[Tuple[int, int], Dict[str, int]]:
+        """
+        Get radio frame statistics per directed link.
+
+        :return: dict with (source node ID, destination node ID) as keys and counters per drop reason
+                 (and 'delivered') as values.
+        """
+        stats = {}
+        for line in self._do_command('stats links'):
+            counters = self._parse_stats_fields(line.split('\t'))
+            stats[(counters.pop('src'), counters.pop('dst'))] = counters
+        return stats
+
+    def stats_reset(self) -> None:
+        """
+        Reset all radio frame statistics.
+        """
+        self._do_command('stats reset')
+
+    @staticmethod
+    def _parse_stats_fields(fields: List[str]) -> Dict[str, int]:
+        counters = {}
+        for field in fields:
+            name, val = field.split('=')
+            counters[name] = int(val)
+        return counters
+
     def prefix_add(self, nodeid: int, prefix: str, preferred=True, slaac=True, dhcp=False, dhcp_other=False,
                    default_route=True, on_mesh=True, stable=True, prf='med') -> None:
         """
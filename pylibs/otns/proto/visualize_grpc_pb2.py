@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'Z-github.com/openthread/ot-ns/visualize/grpc/pb',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x14visualize_grpc.proto\x12\x11visualize_grpc_pb\"\x12\n\x10VisualizeRequest\"\xb7\x0b\n\x0eVisualizeEvent\x12\x33\n\x08\x61\x64\x64_node\x18\x01 \x01(\x0b\x32\x1f.visualize_grpc_pb.AddNodeEventH\x00\x12\x39\n\x0b\x64\x65lete_node\x18\x02 \x01(\x0b\x32\".visualize_grpc_pb.DeleteNodeEventH\x00\x12@\n\x0fset_node_rloc16\x18\x03 \x01(\x0b\x32%.visualize_grpc_pb.SetNodeRloc16EventH\x00\x12<\n\rset_node_role\x18\x04 \x01(\x0b\x32#.visualize_grpc_pb.SetNodeRoleEventH\x00\x12:\n\x0cset_node_pos\x18\x05 \x01(\x0b\x32\".visualize_grpc_pb.SetNodePosEventH\x00\x12K\n\x15set_node_partition_id\x18\x06 \x01(\x0b\x32*.visualize_grpc_pb.SetNodePartitionIdEventH\x00\x12:\n\x0con_node_fail\x18\x07 \x01(\x0b\x32\".visualize_grpc_pb.OnNodeFailEventH\x00\x12@\n\x0fon_node_recover\x18\x08 \x01(\x0b\x32%.visualize_grpc_pb.OnNodeRecoverEventH\x00\x12\x37\n\nset_parent\x18\t \x01(\x0b\x32!.visualize_grpc_pb.SetParentEventH\x00\x12\x37\n\ncount_down\x18\n \x01(\x0b\x32!.visualize_grpc_pb.CountDownEventH\x00\x12\x42\n\x10show_demo_legend\x18\x0b \x01(\x0b\x32&.visualize_grpc_pb.ShowDemoLegendEventH\x00\x12;\n\x0c\x61\x64vance_time\x18\x0c \x01(\x0b\x32#.visualize_grpc_pb.AdvanceTimeEventH\x00\x12\x42\n\x10\x61\x64\x64_router_table\x18\r \x01(\x0b\x32&.visualize_grpc_pb.AddRouterTableEventH\x00\x12H\n\x13remove_router_table\x18\x0e \x01(\x0b\x32).visualize_grpc_pb.RemoveRouterTableEventH\x00\x12@\n\x0f\x61\x64\x64_child_table\x18\x0f \x01(\x0b\x32%.visualize_grpc_pb.AddChildTableEventH\x00\x12\x46\n\x12remove_child_table\x18\x10 \x01(\x0b\x32(.visualize_grpc_pb.RemoveChildTableEventH\x00\x12,\n\x04send\x18\x11 \x01(\x0b\x32\x1c.visualize_grpc_pb.SendEventH\x00\x12\x35\n\tset_speed\x18\x12 \x01(\x0b\x32 .visualize_grpc_pb.SetSpeedEventH\x00\x12\x36\n\theartbeat\x18\x13 \x01(\x0b\x32!.visualize_grpc_pb.HeartbeatEventH\x00\x12\x45\n\x12on_ext_addr_change\x18\x14 \x01(\x0b\x32\'.visualize_grpc_pb.OnExtAddrChangeEventH\x00\x12\x35\n\tset_title\x18\x15 \x01(\x0b\x32 .visualize_grpc_pb.SetTitleEventH\x00\x12<\n\rset_node_mode\x18\x16 \x01(\x0b\x32#.visualize_grpc_pb.SetNodeModeEventH\x00\x12\x42\n\x10set_network_info\x18\x17 \x01(\x0b\x32&.visualize_grpc_pb.SetNetworkInfoEventH\x00\x42\x06\n\x04type\"a\n\tSendEvent\x12\x0e\n\x06src_id\x18\x01 \x01(\x05\x12\x0e\n\x06\x64st_id\x18\x02 \x01(\x05\x12\x34\n\x07mv_info\x18\x03 \x01(\x0b\x32#.visualize_grpc_pb.MsgVisualizeInfo\"\xc2\x01\n\x10MsgVisualizeInfo\x12\x0f\n\x07\x63hannel\x18\x01 \x01(\r\x12\x15\n\rframe_control\x18\x02 \x01(\r\x12\x0b\n\x03seq\x18\x03 \x01(\r\x12\x16\n\x0e\x64st_addr_short\x18\x04 \x01(\r\x12\x19\n\x11\x64st_addr_extended\x18\x05 \x01(\x04\x12\x18\n\x10send_duration_us\x18\x06 \x01(\r\x12\x19\n\x11vis_true_duration\x18\x07 \x01(\x08\x12\x11\n\tpower_dbm\x18\x08 \x01(\x05\"8\n\x13\x41\x64\x64RouterTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\";\n\x16RemoveRouterTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"7\n\x12\x41\x64\x64\x43hildTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\":\n\x15RemoveChildTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"\x1e\n\rSetSpeedEvent\x12\r\n\x05speed\x18\x01 \x01(\x01\"\x10\n\x0eHeartbeatEvent\"-\n\x10\x41\x64vanceTimeEvent\x12\n\n\x02ts\x18\x01 \x01(\x04\x12\r\n\x05speed\x18\x02 \x01(\x01\"3\n\x0eSetParentEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"3\n\x0e\x43ountDownEvent\x12\x13\n\x0b\x64uration_ms\x18\x01 \x01(\x03\x12\x0c\n\x04text\x18\x02 \x01(\t\":\n\x13ShowDemoLegendEvent\x12\t\n\x01x\x18\x01 \x01(\x05\x12\t\n\x01y\x18\x02 \x01(\x05\x12\r\n\x05title\x18\x03 \x01(\t\"8\n\x0fSetNodePosEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\t\n\x01x\x18\x02 \x01(\x05\x12\t\n\x01y\x18\x03 \x01(\x05\"R\n\x10SetNodeRoleEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12-\n\x04role\x18\x02 \x01(\x0e\x32\x1f.visualize_grpc_pb.OtDeviceRole\"@\n\x17SetNodePartitionIdEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x14\n\x0cpartition_id\x18\x02 \x01(\r\"\"\n\x0fOnNodeFailEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"%\n\x12OnNodeRecoverEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"\"\n\x0f\x44\x65leteNodeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"J\n\x0c\x41\x64\x64NodeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\t\n\x01x\x18\x02 \x01(\x05\x12\t\n\x01y\x18\x03 \x01(\x05\x12\x13\n\x0bradio_range\x18\x04 \x01(\x05\"x\n\x08NodeMode\x12\x17\n\x0frx_on_when_idle\x18\x01 \x01(\x08\x12\x1c\n\x14secure_data_requests\x18\x02 \x01(\x08\x12\x1a\n\x12\x66ull_thread_device\x18\x03 \x01(\x08\x12\x19\n\x11\x66ull_network_data\x18\x04 \x01(\x08\"5\n\x12SetNodeRloc16Event\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x0e\n\x06rloc16\x18\x02 \x01(\r\"9\n\x14OnExtAddrChangeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"G\n\rSetTitleEvent\x12\r\n\x05title\x18\x01 \x01(\t\x12\t\n\x01x\x18\x02 \x01(\x05\x12\t\n\x01y\x18\x03 \x01(\x05\x12\x11\n\tfont_size\x18\x04 \x01(\x05\"S\n\x10SetNodeModeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12.\n\tnode_mode\x18\x02 \x01(\x0b\x32\x1b.visualize_grpc_pb.NodeMode\"D\n\x13SetNetworkInfoEvent\x12\x0c\n\x04real\x18\x01 \x01(\x08\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x03 \x01(\t\"V\n\nNodeEnergy\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x64isabled\x18\x02 \x01(\x01\x12\r\n\x05sleep\x18\x03 \x01(\x01\x12\n\n\x02tx\x18\x04 \x01(\x01\x12\n\n\x02rx\x18\x05 \x01(\x01\"[\n\x12NetworkEnergyEvent\x12\x11\n\ttimestamp\x18\x01 \x01(\x04\x12\x32\n\x0bNodesEnergy\x18\x02 \x03(\x0b\x32\x1d.visualize_grpc_pb.NodeEnergy\"!\n\x0e\x43ommandRequest\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\"!\n\x0f\x43ommandResponse\x12\x0e\n\x06output\x18\x01 \x03(\t\"R\n\x0bReplayEntry\x12\x11\n\ttimestamp\x18\x01 \x01(\x04\x12\x30\n\x05\x65vent\x18\x02 \x01(\x0b\x32!.visualize_grpc_pb.VisualizeEvent\"\x07\n\x05\x45mpty*\x98\x01\n\x0cOtDeviceRole\x12\x1b\n\x17OT_DEVICE_ROLE_DISABLED\x10\x00\x12\x1b\n\x17OT_DEVICE_ROLE_DETACHED\x10\x01\x12\x18\n\x14OT_DEVICE_ROLE_CHILD\x10\x02\x12\x19\n\x15OT_DEVICE_ROLE_ROUTER\x10\x03\x12\x19\n\x15OT_DEVICE_ROLE_LEADER\x10\x04\x32\x9d\x02\n\x14VisualizeGrpcService\x12U\n\tVisualize\x12#.visualize_grpc_pb.VisualizeRequest\x1a!.visualize_grpc_pb.VisualizeEvent0\x01\x12P\n\x07\x43ommand\x12!.visualize_grpc_pb.CommandRequest\x1a\".visualize_grpc_pb.CommandResponse\x12\\\n\x0c\x45nergyReport\x12#.visualize_grpc_pb.VisualizeRequest\x1a%.visualize_grpc_pb.NetworkEnergyEvent0\x01\x42/Z-github.com/openthread/ot-ns/visualize/grpc/pbb\x06proto3'
+  serialized_pb=b'\n\x14visualize_grpc.proto\x12\x11visualize_grpc_pb\"\x12\n\x10VisualizeRequest\"\xb3\x0c\n\x0eVisualizeEvent\x12\x33\n\x08\x61\x64\x64_node\x18\x01 \x01(\x0b\x32\x1f.visualize_grpc_pb.AddNodeEventH\x00\x12\x39\n\x0b\x64\x65lete_node\x18\x02 \x01(\x0b\x32\".visualize_grpc_pb.DeleteNodeEventH\x00\x12@\n\x0fset_node_rloc16\x18\x03 \x01(\x0b\x32%.visualize_grpc_pb.SetNodeRloc16EventH\x00\x12<\n\rset_node_role\x18\x04 \x01(\x0b\x32#.visualize_grpc_pb.SetNodeRoleEventH\x00\x12:\n\x0cset_node_pos\x18\x05 \x01(\x0b\x32\".visualize_grpc_pb.SetNodePosEventH\x00\x12K\n\x15set_node_partition_id\x18\x06 \x01(\x0b\x32*.visualize_grpc_pb.SetNodePartitionIdEventH\x00\x12:\n\x0con_node_fail\x18\x07 \x01(\x0b\x32\".visualize_grpc_pb.OnNodeFailEventH\x00\x12@\n\x0fon_node_recover\x18\x08 \x01(\x0b\x32%.visualize_grpc_pb.OnNodeRecoverEventH\x00\x12\x37\n\nset_parent\x18\t \x01(\x0b\x32!.visualize_grpc_pb.SetParentEventH\x00\x12\x37\n\ncount_down\x18\n \x01(\x0b\x32!.visualize_grpc_pb.CountDownEventH\x00\x12\x42\n\x10show_demo_legend\x18\x0b \x01(\x0b\x32&.visualize_grpc_pb.ShowDemoLegendEventH\x00\x12;\n\x0c\x61\x64vance_time\x18\x0c \x01(\x0b\x32#.visualize_grpc_pb.AdvanceTimeEventH\x00\x12\x42\n\x10\x61\x64\x64_router_table\x18\r \x01(\x0b\x32&.visualize_grpc_pb.AddRouterTableEventH\x00\x12H\n\x13remove_router_table\x18\x0e \x01(\x0b\x32).visualize_grpc_pb.RemoveRouterTableEventH\x00\x12@\n\x0f\x61\x64\x64_child_table\x18\x0f \x01(\x0b\x32%.visualize_grpc_pb.AddChildTableEventH\x00\x12\x46\n\x12remove_child_table\x18\x10 \x01(\x0b\x32(.visualize_grpc_pb.RemoveChildTableEventH\x00\x12,\n\x04send\x18\x11 \x01(\x0b\x32\x1c.visualize_grpc_pb.SendEventH\x00\x12\x35\n\tset_speed\x18\x12 \x01(\x0b\x32 .visualize_grpc_pb.SetSpeedEventH\x00\x12\x36\n\theartbeat\x18\x13 \x01(\x0b\x32!.visualize_grpc_pb.HeartbeatEventH\x00\x12\x45\n\x12on_ext_addr_change\x18\x14 \x01(\x0b\x32\'.visualize_grpc_pb.OnExtAddrChangeEventH\x00\x12\x35\n\tset_title\x18\x15 \x01(\x0b\x32 .visualize_grpc_pb.SetTitleEventH\x00\x12<\n\rset_node_mode\x18\x16 \x01(\x0b\x32#.visualize_grpc_pb.SetNodeModeEventH\x00\x12\x42\n\x10set_network_info\x18\x17 \x01(\x0b\x32&.visualize_grpc_pb.SetNetworkInfoEventH\x00\x12=\n\rcustom_status\x18\x18 \x01(\x0b\x32$.visualize_grpc_pb.CustomStatusEventH\x00\x12;\n\x0crssi_heatmap\x18\x19 \x01(\x0b\x32#.visualize_grpc_pb.RssiHeatmapEventH\x00\x42\x06\n\x04type\"a\n\tSendEvent\x12\x0e\n\x06src_id\x18\x01 \x01(\x05\x12\x0e\n\x06\x64st_id\x18\x02 \x01(\x05\x12\x34\n\x07mv_info\x18\x03 \x01(\x0b\x32#.visualize_grpc_pb.MsgVisualizeInfo\"\xd4\x01\n\x10MsgVisualizeInfo\x12\x0f\n\x07\x63hannel\x18\x01 \x01(\r\x12\x15\n\rframe_control\x18\x02 \x01(\r\x12\x0b\n\x03seq\x18\x03 \x01(\r\x12\x16\n\x0e\x64st_addr_short\x18\x04 \x01(\r\x12\x19\n\x11\x64st_addr_extended\x18\x05 \x01(\x04\x12\x18\n\x10send_duration_us\x18\x06 \x01(\r\x12\x19\n\x11vis_true_duration\x18\x07 \x01(\x08\x12\x11\n\tpower_dbm\x18\x08 \x01(\x05\x12\x10\n\x08msg_type\x18\t \x01(\t\"8\n\x13\x41\x64\x64RouterTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\";\n\x16RemoveRouterTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"7\n\x12\x41\x64\x64\x43hildTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\":\n\x15RemoveChildTableEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"\x1e\n\rSetSpeedEvent\x12\r\n\x05speed\x18\x01 \x01(\x01\"\x10\n\x0eHeartbeatEvent\"-\n\x10\x41\x64vanceTimeEvent\x12\n\n\x02ts\x18\x01 \x01(\x04\x12\r\n\x05speed\x18\x02 \x01(\x01\"3\n\x0eSetParentEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"3\n\x0e\x43ountDownEvent\x12\x13\n\x0b\x64uration_ms\x18\x01 \x01(\x03\x12\x0c\n\x04text\x18\x02 \x01(\t\":\n\x13ShowDemoLegendEvent\x12\t\n\x01x\x18\x01 \x01(\x05\x12\t\n\x01y\x18\x02 \x01(\x05\x12\r\n\x05title\x18\x03 \x01(\t\"C\n\x10RssiHeatmapEvent\x12/\n\x07heatmap\x18\x01 \x01(\x0b\x32\x1e.visualize_grpc_pb.RssiHeatmap\"8\n\x0fSetNodePosEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\t\n\x01x\x18\x02 \x01(\x05\x12\t\n\x01y\x18\x03 \x01(\x05\"R\n\x10SetNodeRoleEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12-\n\x04role\x18\x02 \x01(\x0e\x32\x1f.visualize_grpc_pb.OtDeviceRole\"@\n\x17SetNodePartitionIdEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x14\n\x0cpartition_id\x18\x02 \x01(\r\"\"\n\x0fOnNodeFailEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"%\n\x12OnNodeRecoverEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"\"\n\x0f\x44\x65leteNodeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"J\n\x0c\x41\x64\x64NodeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\t\n\x01x\x18\x02 \x01(\x05\x12\t\n\x01y\x18\x03 \x01(\x05\x12\x13\n\x0bradio_range\x18\x04 \x01(\x05\"x\n\x08NodeMode\x12\x17\n\x0frx_on_when_idle\x18\x01 \x01(\x08\x12\x1c\n\x14secure_data_requests\x18\x02 \x01(\x08\x12\x1a\n\x12\x66ull_thread_device\x18\x03 \x01(\x08\x12\x19\n\x11\x66ull_network_data\x18\x04 \x01(\x08\"5\n\x12SetNodeRloc16Event\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x0e\n\x06rloc16\x18\x02 \x01(\r\"9\n\x14OnExtAddrChangeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\"G\n\rSetTitleEvent\x12\r\n\x05title\x18\x01 \x01(\t\x12\t\n\x01x\x18\x02 \x01(\x05\x12\t\n\x01y\x18\x03 \x01(\x05\x12\x11\n\tfont_size\x18\x04 \x01(\x05\"S\n\x10SetNodeModeEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12.\n\tnode_mode\x18\x02 \x01(\x0b\x32\x1b.visualize_grpc_pb.NodeMode\"D\n\x13SetNetworkInfoEvent\x12\x0c\n\x04real\x18\x01 \x01(\x08\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x03 \x01(\t\"@\n\x11\x43ustomStatusEvent\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\r\n\x05value\x18\x03 \x01(\t\"V\n\nNodeEnergy\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x64isabled\x18\x02 \x01(\x01\x12\r\n\x05sleep\x18\x03 \x01(\x01\x12\n\n\x02tx\x18\x04 \x01(\x01\x12\n\n\x02rx\x18\x05 \x01(\x01\"[\n\x12NetworkEnergyEvent\x12\x11\n\ttimestamp\x18\x01 \x01(\x04\x12\x32\n\x0bNodesEnergy\x18\x02 \x03(\x0b\x32\x1d.visualize_grpc_pb.NodeEnergy\"!\n\x0e\x43ommandRequest\x12\x0f\n\x07\x63ommand\x18\x01 \x01(\t\"!\n\x0f\x43ommandResponse\x12\x0e\n\x06output\x18\x01 \x03(\t\"(\n\x11\x46rameStatsRequest\x12\x13\n\x0breset_stats\x18\x01 \x01(\x08\"\x8c\x01\n\nFrameStats\x12\x11\n\tdelivered\x18\x01 \x01(\x04\x12;\n\x07\x64ropped\x18\x02 \x03(\x0b\x32*.visualize_grpc_pb.FrameStats.DroppedEntry\x1a.\n\x0c\x44roppedEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\"\x8c\x02\n\x0eNodeFrameStats\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x11\n\ttx_frames\x18\x02 \x01(\x04\x12)\n\x02tx\x18\x03 \x01(\x0b\x32\x1d.visualize_grpc_pb.FrameStats\x12)\n\x02rx\x18\x04 \x01(\x0b\x32\x1d.visualize_grpc_pb.FrameStats\x12K\n\x0etx_frame_types\x18\x05 \x03(\x0b\x32\x33.visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry\x1a\x33\n\x11TxFrameTypesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x04:\x02\x38\x01\"^\n\x0eLinkFrameStats\x12\x0e\n\x06src_id\x18\x01 \x01(\x05\x12\x0e\n\x06\x64st_id\x18\x02 \x01(\x05\x12,\n\x05stats\x18\x03 \x01(\x0b\x32\x1d.visualize_grpc_pb.FrameStats\"x\n\x12\x46rameStatsResponse\x12\x30\n\x05nodes\x18\x01 \x03(\x0b\x32!.visualize_grpc_pb.NodeFrameStats\x12\x30\n\x05links\x18\x02 \x03(\x0b\x32!.visualize_grpc_pb.LinkFrameStats\"$\n\x0cNodePosition\x12\t\n\x01x\x18\x01 \x01(\x05\x12\t\n\x01y\x18\x02 \x01(\x05\"\xba\x01\n\x0e\x41\x64\x64NodeRequest\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x0f\n\x07node_id\x18\x02 \x01(\x05\x12\x31\n\x08position\x18\x03 \x01(\x0b\x32\x1f.visualize_grpc_pb.NodePosition\x12\x13\n\x0bradio_range\x18\x04 \x01(\x05\x12\x12\n\nexecutable\x18\x05 \x01(\t\x12\x0f\n\x07version\x18\x06 \x01(\t\x12\x0f\n\x07restore\x18\x07 \x01(\x08\x12\x0b\n\x03rcp\x18\x08 \x01(\x08\"\"\n\x0f\x41\x64\x64NodeResponse\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\"%\n\x11\x44\x65leteNodeRequest\x12\x10\n\x08node_ids\x18\x01 \x03(\x05\"U\n\x0fMoveNodeRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x31\n\x08position\x18\x02 \x01(\x0b\x32\x1f.visualize_grpc_pb.NodePosition\"/\n\x0fSetRadioRequest\x12\x10\n\x08node_ids\x18\x01 \x03(\x05\x12\n\n\x02on\x18\x02 \x01(\x08\"/\n\tGoRequest\x12\x13\n\x0b\x64uration_us\x18\x01 \x01(\x04\x12\r\n\x05speed\x18\x02 \x01(\x01\"\x1d\n\nGoResponse\x12\x0f\n\x07time_us\x18\x01 \x01(\x04\"\xee\x01\n\x08NodeInfo\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x10\n\x08\x65xt_addr\x18\x02 \x01(\x04\x12\x0e\n\x06rloc16\x18\x03 \x01(\r\x12\x31\n\x08position\x18\x04 \x01(\x0b\x32\x1f.visualize_grpc_pb.NodePosition\x12-\n\x04role\x18\x05 \x01(\x0e\x32\x1f.visualize_grpc_pb.OtDeviceRole\x12\x14\n\x0cpartition_id\x18\x06 \x01(\r\x12\x0e\n\x06\x66\x61iled\x18\x07 \x01(\x08\x12\x12\n\nexecutable\x18\x08 \x01(\t\x12\x13\n\x0b\x63rash_count\x18\t \x01(\x05\">\n\x10GetNodesResponse\x12*\n\x05nodes\x18\x01 \x03(\x0b\x32\x1b.visualize_grpc_pb.NodeInfo\"3\n\tPartition\x12\x14\n\x0cpartition_id\x18\x01 \x01(\r\x12\x10\n\x08node_ids\x18\x02 \x03(\x05\"I\n\x15GetPartitionsResponse\x12\x30\n\npartitions\x18\x01 \x03(\x0b\x32\x1c.visualize_grpc_pb.Partition\"O\n\nPingResult\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x0b\n\x03\x64st\x18\x02 \x01(\t\x12\x11\n\tdata_size\x18\x03 \x01(\x05\x12\x10\n\x08\x64\x65lay_us\x18\x04 \x01(\x04\"@\n\x10GetPingsResponse\x12,\n\x05pings\x18\x01 \x03(\x0b\x32\x1d.visualize_grpc_pb.PingResult\"6\n\x12NodeCommandRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\x05\x12\x0f\n\x07\x63ommand\x18\x02 \x01(\t\"%\n\x13NodeCommandResponse\x12\x0e\n\x06output\x18\x01 \x03(\t\"@\n\x0fNodeLogsRequest\x12\x10\n\x08node_ids\x18\x01 \x03(\x05\x12\r\n\x05level\x18\x02 \x01(\t\x12\x0c\n\x04uart\x18\x03 \x01(\x08\"\\\n\x0cNodeLogEntry\x12\x11\n\ttimestamp\x18\x01 \x01(\x04\x12\x0f\n\x07node_id\x18\x02 \x01(\x05\x12\r\n\x05level\x18\x03 \x01(\t\x12\x0b\n\x03msg\x18\x04 \x01(\t\x12\x0c\n\x04uart\x18\x05 \x01(\x08\"\x93\x01\n\x0bRssiHeatmap\x12\t\n\x01x\x18\x01 \x01(\x05\x12\t\n\x01y\x18\x02 \x01(\x05\x12\x11\n\tcell_size\x18\x03 \x01(\x05\x12\x0c\n\x04\x63ols\x18\x04 \x01(\x05\x12\x0c\n\x04rows\x18\x05 \x01(\x05\x12\x0c\n\x04rssi\x18\x06 \x03(\x02\x12\x0c\n\x04sinr\x18\x07 \x03(\x02\x12\x10\n\x08node_ids\x18\x08 \x03(\x05\x12\x11\n\treachable\x18\t \x03(\x08\"G\n\x12RssiHeatmapRequest\x12\x10\n\x08node_ids\x18\x01 \x03(\x05\x12\x11\n\tcell_size\x18\x02 \x01(\x05\x12\x0c\n\x04show\x18\x03 \x01(\x08\"F\n\x13RssiHeatmapResponse\x12/\n\x07heatmap\x18\x01 \x01(\x0b\x32\x1e.visualize_grpc_pb.RssiHeatmap\"\x9b\x01\n\x0bReplayEntry\x12\x11\n\ttimestamp\x18\x01 \x01(\x04\x12\x30\n\x05\x65vent\x18\x02 \x01(\x0b\x32!.visualize_grpc_pb.VisualizeEvent\x12\x35\n\x06\x65nergy\x18\x03 \x01(\x0b\x32%.visualize_grpc_pb.NetworkEnergyEvent\x12\x10\n\x08keyframe\x18\x04 \x01(\x08\"\x07\n\x05\x45mpty*\x98\x01\n\x0cOtDeviceRole\x12\x1b\n\x17OT_DEVICE_ROLE_DISABLED\x10\x00\x12\x1b\n\x17OT_DEVICE_ROLE_DETACHED\x10\x01\x12\x18\n\x14OT_DEVICE_ROLE_CHILD\x10\x02\x12\x19\n\x15OT_DEVICE_ROLE_ROUTER\x10\x03\x12\x19\n\x15OT_DEVICE_ROLE_LEADER\x10\x04\x32\xe9\t\n\x14VisualizeGrpcService\x12U\n\tVisualize\x12#.visualize_grpc_pb.VisualizeRequest\x1a!.visualize_grpc_pb.VisualizeEvent0\x01\x12P\n\x07\x43ommand\x12!.visualize_grpc_pb.CommandRequest\x1a\".visualize_grpc_pb.CommandResponse\x12\\\n\x0c\x45nergyReport\x12#.visualize_grpc_pb.VisualizeRequest\x1a%.visualize_grpc_pb.NetworkEnergyEvent0\x01\x12Y\n\nFrameStats\x12$.visualize_grpc_pb.FrameStatsRequest\x1a%.visualize_grpc_pb.FrameStatsResponse\x12P\n\x07\x41\x64\x64Node\x12!.visualize_grpc_pb.AddNodeRequest\x1a\".visualize_grpc_pb.AddNodeResponse\x12L\n\nDeleteNode\x12$.visualize_grpc_pb.DeleteNodeRequest\x1a\x18.visualize_grpc_pb.Empty\x12H\n\x08MoveNode\x12\".visualize_grpc_pb.MoveNodeRequest\x1a\x18.visualize_grpc_pb.Empty\x12H\n\x08SetRadio\x12\".visualize_grpc_pb.SetRadioRequest\x1a\x18.visualize_grpc_pb.Empty\x12\x41\n\x02Go\x12\x1c.visualize_grpc_pb.GoRequest\x1a\x1d.visualize_grpc_pb.GoResponse\x12I\n\x08GetNodes\x12\x18.visualize_grpc_pb.Empty\x1a#.visualize_grpc_pb.GetNodesResponse\x12S\n\rGetPartitions\x12\x18.visualize_grpc_pb.Empty\x1a(.visualize_grpc_pb.GetPartitionsResponse\x12I\n\x08GetPings\x12\x18.visualize_grpc_pb.Empty\x1a#.visualize_grpc_pb.GetPingsResponse\x12\\\n\x0bNodeCommand\x12%.visualize_grpc_pb.NodeCommandRequest\x1a&.visualize_grpc_pb.NodeCommandResponse\x12Q\n\x08NodeLogs\x12\".visualize_grpc_pb.NodeLogsRequest\x1a\x1f.visualize_grpc_pb.NodeLogEntry0\x01\x12\\\n\x0bRssiHeatmap\x12%.visualize_grpc_pb.RssiHeatmapRequest\x1a&.visualize_grpc_pb.RssiHeatmapResponseB/Z-github.com/openthread/ot-ns/visualize/grpc/pbb\x06proto3'
 )
 
 _OTDEVICEROLE = _descriptor.EnumDescriptor(
@@ -58,8 +58,8 @@ _OTDEVICEROLE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=3528,
-  serialized_end=3680,
+  serialized_start=6201,
+  serialized_end=6353,
 )
 _sym_db.RegisterEnumDescriptor(_OTDEVICEROLE)
 
@@ -266,6 +266,20 @@ _VISUALIZEEVENT = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='custom_status', full_name='visualize_grpc_pb.VisualizeEvent.custom_status', index=23,
+      number=24, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rssi_heatmap', full_name='visualize_grpc_pb.VisualizeEvent.rssi_heatmap', index=24,
+      number=25, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -284,7 +298,7 @@ _VISUALIZEEVENT = _descriptor.Descriptor(
     fields=[]),
   ],
   serialized_start=64,
-  serialized_end=1527,
+  serialized_end=1651,
 )
 
 
@@ -329,8 +343,8 @@ _SENDEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1529,
-  serialized_end=1626,
+  serialized_start=1653,
+  serialized_end=1750,
 )
 
 
@@ -398,6 +412,13 @@ _MSGVISUALIZEINFO = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='msg_type', full_name='visualize_grpc_pb.MsgVisualizeInfo.msg_type', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -410,8 +431,8 @@ _MSGVISUALIZEINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1629,
-  serialized_end=1823,
+  serialized_start=1753,
+  serialized_end=1965,
 )
 
 
@@ -449,8 +470,8 @@ _ADDROUTERTABLEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1825,
-  serialized_end=1881,
+  serialized_start=1967,
+  serialized_end=2023,
 )
 
 
@@ -488,8 +509,8 @@ _REMOVEROUTERTABLEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1883,
-  serialized_end=1942,
+  serialized_start=2025,
+  serialized_end=2084,
 )
 
 
@@ -527,8 +548,8 @@ _ADDCHILDTABLEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1944,
-  serialized_end=1999,
+  serialized_start=2086,
+  serialized_end=2141,
 )
 
 
@@ -566,8 +587,8 @@ _REMOVECHILDTABLEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2001,
-  serialized_end=2059,
+  serialized_start=2143,
+  serialized_end=2201,
 )
 
 
@@ -598,8 +619,8 @@ _SETSPEEDEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2061,
-  serialized_end=2091,
+  serialized_start=2203,
+  serialized_end=2233,
 )
 
 
@@ -623,8 +644,8 @@ _HEARTBEATEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2093,
-  serialized_end=2109,
+  serialized_start=2235,
+  serialized_end=2251,
 )
 
 
@@ -662,8 +683,8 @@ _ADVANCETIMEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2111,
-  serialized_end=2156,
+  serialized_start=2253,
+  serialized_end=2298,
 )
 
 
@@ -701,8 +722,8 @@ _SETPARENTEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2158,
-  serialized_end=2209,
+  serialized_start=2300,
+  serialized_end=2351,
 )
 
 
@@ -740,8 +761,8 @@ _COUNTDOWNEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2211,
-  serialized_end=2262,
+  serialized_start=2353,
+  serialized_end=2404,
 )
 
 
@@ -786,8 +807,40 @@ _SHOWDEMOLEGENDEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2264,
-  serialized_end=2322,
+  serialized_start=2406,
+  serialized_end=2464,
+)
+
+
+_RSSIHEATMAPEVENT = _descriptor.Descriptor(
+  name='RssiHeatmapEvent',
+  full_name='visualize_grpc_pb.RssiHeatmapEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='heatmap', full_name='visualize_grpc_pb.RssiHeatmapEvent.heatmap', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2466,
+  serialized_end=2533,
 )
 
 
@@ -832,8 +885,8 @@ _SETNODEPOSEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2324,
-  serialized_end=2380,
+  serialized_start=2535,
+  serialized_end=2591,
 )
 
 
@@ -871,8 +924,8 @@ _SETNODEROLEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2382,
-  serialized_end=2464,
+  serialized_start=2593,
+  serialized_end=2675,
 )
 
 
@@ -910,8 +963,8 @@ _SETNODEPARTITIONIDEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2466,
-  serialized_end=2530,
+  serialized_start=2677,
+  serialized_end=2741,
 )
 
 
@@ -942,8 +995,8 @@ _ONNODEFAILEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2532,
-  serialized_end=2566,
+  serialized_start=2743,
+  serialized_end=2777,
 )
 
 
@@ -974,8 +1027,8 @@ _ONNODERECOVEREVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2568,
-  serialized_end=2605,
+  serialized_start=2779,
+  serialized_end=2816,
 )
 
 
@@ -1006,8 +1059,8 @@ _DELETENODEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2607,
-  serialized_end=2641,
+  serialized_start=2818,
+  serialized_end=2852,
 )
 
 
@@ -1059,8 +1112,8 @@ _ADDNODEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2643,
-  serialized_end=2717,
+  serialized_start=2854,
+  serialized_end=2928,
 )
 
 
@@ -1112,8 +1165,8 @@ _NODEMODE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2719,
-  serialized_end=2839,
+  serialized_start=2930,
+  serialized_end=3050,
 )
 
 
@@ -1151,8 +1204,8 @@ _SETNODERLOC16EVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2841,
-  serialized_end=2894,
+  serialized_start=3052,
+  serialized_end=3105,
 )
 
 
@@ -1190,8 +1243,8 @@ _ONEXTADDRCHANGEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2896,
-  serialized_end=2953,
+  serialized_start=3107,
+  serialized_end=3164,
 )
 
 
@@ -1243,8 +1296,8 @@ _SETTITLEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2955,
-  serialized_end=3026,
+  serialized_start=3166,
+  serialized_end=3237,
 )
 
 
@@ -1282,8 +1335,8 @@ _SETNODEMODEEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3028,
-  serialized_end=3111,
+  serialized_start=3239,
+  serialized_end=3322,
 )
 
 
@@ -1328,8 +1381,54 @@ _SETNETWORKINFOEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3113,
-  serialized_end=3181,
+  serialized_start=3324,
+  serialized_end=3392,
+)
+
+
+_CUSTOMSTATUSEVENT = _descriptor.Descriptor(
+  name='CustomStatusEvent',
+  full_name='visualize_grpc_pb.CustomStatusEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.CustomStatusEvent.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key', full_name='visualize_grpc_pb.CustomStatusEvent.key', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='visualize_grpc_pb.CustomStatusEvent.value', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3394,
+  serialized_end=3458,
 )
 
 
@@ -1388,8 +1487,8 @@ _NODEENERGY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3183,
-  serialized_end=3269,
+  serialized_start=3460,
+  serialized_end=3546,
 )
 
 
@@ -1427,8 +1526,8 @@ _NETWORKENERGYEVENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3271,
-  serialized_end=3362,
+  serialized_start=3548,
+  serialized_end=3639,
 )
 
 
@@ -1459,8 +1558,8 @@ _COMMANDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3364,
-  serialized_end=3397,
+  serialized_start=3641,
+  serialized_end=3674,
 )
 
 
@@ -1491,30 +1590,139 @@ _COMMANDRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3399,
-  serialized_end=3432,
+  serialized_start=3676,
+  serialized_end=3709,
 )
 
 
-_REPLAYENTRY = _descriptor.Descriptor(
-  name='ReplayEntry',
-  full_name='visualize_grpc_pb.ReplayEntry',
+_FRAMESTATSREQUEST = _descriptor.Descriptor(
+  name='FrameStatsRequest',
+  full_name='visualize_grpc_pb.FrameStatsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='timestamp', full_name='visualize_grpc_pb.ReplayEntry.timestamp', index=0,
+      name='reset_stats', full_name='visualize_grpc_pb.FrameStatsRequest.reset_stats', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3711,
+  serialized_end=3751,
+)
+
+
+_FRAMESTATS_DROPPEDENTRY = _descriptor.Descriptor(
+  name='DroppedEntry',
+  full_name='visualize_grpc_pb.FrameStats.DroppedEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='visualize_grpc_pb.FrameStats.DroppedEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='visualize_grpc_pb.FrameStats.DroppedEntry.value', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3848,
+  serialized_end=3894,
+)
+
+_FRAMESTATS = _descriptor.Descriptor(
+  name='FrameStats',
+  full_name='visualize_grpc_pb.FrameStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='delivered', full_name='visualize_grpc_pb.FrameStats.delivered', index=0,
       number=1, type=4, cpp_type=4, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='event', full_name='visualize_grpc_pb.ReplayEntry.event', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='dropped', full_name='visualize_grpc_pb.FrameStats.dropped', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_FRAMESTATS_DROPPEDENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3754,
+  serialized_end=3894,
+)
+
+
+_NODEFRAMESTATS_TXFRAMETYPESENTRY = _descriptor.Descriptor(
+  name='TxFrameTypesEntry',
+  full_name='visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry.value', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -1524,25 +1732,105 @@ _REPLAYENTRY = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4114,
+  serialized_end=4165,
+)
+
+_NODEFRAMESTATS = _descriptor.Descriptor(
+  name='NodeFrameStats',
+  full_name='visualize_grpc_pb.NodeFrameStats',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.NodeFrameStats.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tx_frames', full_name='visualize_grpc_pb.NodeFrameStats.tx_frames', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tx', full_name='visualize_grpc_pb.NodeFrameStats.tx', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rx', full_name='visualize_grpc_pb.NodeFrameStats.rx', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tx_frame_types', full_name='visualize_grpc_pb.NodeFrameStats.tx_frame_types', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_NODEFRAMESTATS_TXFRAMETYPESENTRY, ],
+  enum_types=[
+  ],
   serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3434,
-  serialized_end=3516,
+  serialized_start=3897,
+  serialized_end=4165,
 )
 
 
-_EMPTY = _descriptor.Descriptor(
-  name='Empty',
-  full_name='visualize_grpc_pb.Empty',
+_LINKFRAMESTATS = _descriptor.Descriptor(
+  name='LinkFrameStats',
+  full_name='visualize_grpc_pb.LinkFrameStats',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='src_id', full_name='visualize_grpc_pb.LinkFrameStats.src_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dst_id', full_name='visualize_grpc_pb.LinkFrameStats.dst_id', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='stats', full_name='visualize_grpc_pb.LinkFrameStats.stats', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -1555,20 +1843,1089 @@ _EMPTY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3518,
-  serialized_end=3525,
+  serialized_start=4167,
+  serialized_end=4261,
 )
 
-_VISUALIZEEVENT.fields_by_name['add_node'].message_type = _ADDNODEEVENT
-_VISUALIZEEVENT.fields_by_name['delete_node'].message_type = _DELETENODEEVENT
-_VISUALIZEEVENT.fields_by_name['set_node_rloc16'].message_type = _SETNODERLOC16EVENT
-_VISUALIZEEVENT.fields_by_name['set_node_role'].message_type = _SETNODEROLEEVENT
-_VISUALIZEEVENT.fields_by_name['set_node_pos'].message_type = _SETNODEPOSEVENT
-_VISUALIZEEVENT.fields_by_name['set_node_partition_id'].message_type = _SETNODEPARTITIONIDEVENT
-_VISUALIZEEVENT.fields_by_name['on_node_fail'].message_type = _ONNODEFAILEVENT
-_VISUALIZEEVENT.fields_by_name['on_node_recover'].message_type = _ONNODERECOVEREVENT
-_VISUALIZEEVENT.fields_by_name['set_parent'].message_type = _SETPARENTEVENT
-_VISUALIZEEVENT.fields_by_name['count_down'].message_type = _COUNTDOWNEVENT
+
+_FRAMESTATSRESPONSE = _descriptor.Descriptor(
+  name='FrameStatsResponse',
+  full_name='visualize_grpc_pb.FrameStatsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='nodes', full_name='visualize_grpc_pb.FrameStatsResponse.nodes', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='links', full_name='visualize_grpc_pb.FrameStatsResponse.links', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4263,
+  serialized_end=4383,
+)
+
+
+_NODEPOSITION = _descriptor.Descriptor(
+  name='NodePosition',
+  full_name='visualize_grpc_pb.NodePosition',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='x', full_name='visualize_grpc_pb.NodePosition.x', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='y', full_name='visualize_grpc_pb.NodePosition.y', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4385,
+  serialized_end=4421,
+)
+
+
+_ADDNODEREQUEST = _descriptor.Descriptor(
+  name='AddNodeRequest',
+  full_name='visualize_grpc_pb.AddNodeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='visualize_grpc_pb.AddNodeRequest.type', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.AddNodeRequest.node_id', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='position', full_name='visualize_grpc_pb.AddNodeRequest.position', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='radio_range', full_name='visualize_grpc_pb.AddNodeRequest.radio_range', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='executable', full_name='visualize_grpc_pb.AddNodeRequest.executable', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='visualize_grpc_pb.AddNodeRequest.version', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restore', full_name='visualize_grpc_pb.AddNodeRequest.restore', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rcp', full_name='visualize_grpc_pb.AddNodeRequest.rcp', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4424,
+  serialized_end=4610,
+)
+
+
+_ADDNODERESPONSE = _descriptor.Descriptor(
+  name='AddNodeResponse',
+  full_name='visualize_grpc_pb.AddNodeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.AddNodeResponse.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4612,
+  serialized_end=4646,
+)
+
+
+_DELETENODEREQUEST = _descriptor.Descriptor(
+  name='DeleteNodeRequest',
+  full_name='visualize_grpc_pb.DeleteNodeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_ids', full_name='visualize_grpc_pb.DeleteNodeRequest.node_ids', index=0,
+      number=1, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4648,
+  serialized_end=4685,
+)
+
+
+_MOVENODEREQUEST = _descriptor.Descriptor(
+  name='MoveNodeRequest',
+  full_name='visualize_grpc_pb.MoveNodeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.MoveNodeRequest.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='position', full_name='visualize_grpc_pb.MoveNodeRequest.position', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4687,
+  serialized_end=4772,
+)
+
+
+_SETRADIOREQUEST = _descriptor.Descriptor(
+  name='SetRadioRequest',
+  full_name='visualize_grpc_pb.SetRadioRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_ids', full_name='visualize_grpc_pb.SetRadioRequest.node_ids', index=0,
+      number=1, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='on', full_name='visualize_grpc_pb.SetRadioRequest.on', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4774,
+  serialized_end=4821,
+)
+
+
+_GOREQUEST = _descriptor.Descriptor(
+  name='GoRequest',
+  full_name='visualize_grpc_pb.GoRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='duration_us', full_name='visualize_grpc_pb.GoRequest.duration_us', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='speed', full_name='visualize_grpc_pb.GoRequest.speed', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4823,
+  serialized_end=4870,
+)
+
+
+_GORESPONSE = _descriptor.Descriptor(
+  name='GoResponse',
+  full_name='visualize_grpc_pb.GoResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time_us', full_name='visualize_grpc_pb.GoResponse.time_us', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4872,
+  serialized_end=4901,
+)
+
+
+_NODEINFO = _descriptor.Descriptor(
+  name='NodeInfo',
+  full_name='visualize_grpc_pb.NodeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.NodeInfo.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ext_addr', full_name='visualize_grpc_pb.NodeInfo.ext_addr', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rloc16', full_name='visualize_grpc_pb.NodeInfo.rloc16', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='position', full_name='visualize_grpc_pb.NodeInfo.position', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='role', full_name='visualize_grpc_pb.NodeInfo.role', index=4,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partition_id', full_name='visualize_grpc_pb.NodeInfo.partition_id', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='failed', full_name='visualize_grpc_pb.NodeInfo.failed', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='executable', full_name='visualize_grpc_pb.NodeInfo.executable', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='crash_count', full_name='visualize_grpc_pb.NodeInfo.crash_count', index=8,
+      number=9, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4904,
+  serialized_end=5142,
+)
+
+
+_GETNODESRESPONSE = _descriptor.Descriptor(
+  name='GetNodesResponse',
+  full_name='visualize_grpc_pb.GetNodesResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='nodes', full_name='visualize_grpc_pb.GetNodesResponse.nodes', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5144,
+  serialized_end=5206,
+)
+
+
+_PARTITION = _descriptor.Descriptor(
+  name='Partition',
+  full_name='visualize_grpc_pb.Partition',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='partition_id', full_name='visualize_grpc_pb.Partition.partition_id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='node_ids', full_name='visualize_grpc_pb.Partition.node_ids', index=1,
+      number=2, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5208,
+  serialized_end=5259,
+)
+
+
+_GETPARTITIONSRESPONSE = _descriptor.Descriptor(
+  name='GetPartitionsResponse',
+  full_name='visualize_grpc_pb.GetPartitionsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='partitions', full_name='visualize_grpc_pb.GetPartitionsResponse.partitions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5261,
+  serialized_end=5334,
+)
+
+
+_PINGRESULT = _descriptor.Descriptor(
+  name='PingResult',
+  full_name='visualize_grpc_pb.PingResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.PingResult.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dst', full_name='visualize_grpc_pb.PingResult.dst', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='data_size', full_name='visualize_grpc_pb.PingResult.data_size', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='delay_us', full_name='visualize_grpc_pb.PingResult.delay_us', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5336,
+  serialized_end=5415,
+)
+
+
+_GETPINGSRESPONSE = _descriptor.Descriptor(
+  name='GetPingsResponse',
+  full_name='visualize_grpc_pb.GetPingsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pings', full_name='visualize_grpc_pb.GetPingsResponse.pings', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5417,
+  serialized_end=5481,
+)
+
+
+_NODECOMMANDREQUEST = _descriptor.Descriptor(
+  name='NodeCommandRequest',
+  full_name='visualize_grpc_pb.NodeCommandRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.NodeCommandRequest.node_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='visualize_grpc_pb.NodeCommandRequest.command', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5483,
+  serialized_end=5537,
+)
+
+
+_NODECOMMANDRESPONSE = _descriptor.Descriptor(
+  name='NodeCommandResponse',
+  full_name='visualize_grpc_pb.NodeCommandResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='output', full_name='visualize_grpc_pb.NodeCommandResponse.output', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5539,
+  serialized_end=5576,
+)
+
+
+_NODELOGSREQUEST = _descriptor.Descriptor(
+  name='NodeLogsRequest',
+  full_name='visualize_grpc_pb.NodeLogsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_ids', full_name='visualize_grpc_pb.NodeLogsRequest.node_ids', index=0,
+      number=1, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='level', full_name='visualize_grpc_pb.NodeLogsRequest.level', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='uart', full_name='visualize_grpc_pb.NodeLogsRequest.uart', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5578,
+  serialized_end=5642,
+)
+
+
+_NODELOGENTRY = _descriptor.Descriptor(
+  name='NodeLogEntry',
+  full_name='visualize_grpc_pb.NodeLogEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='visualize_grpc_pb.NodeLogEntry.timestamp', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='visualize_grpc_pb.NodeLogEntry.node_id', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='level', full_name='visualize_grpc_pb.NodeLogEntry.level', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='msg', full_name='visualize_grpc_pb.NodeLogEntry.msg', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='uart', full_name='visualize_grpc_pb.NodeLogEntry.uart', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5644,
+  serialized_end=5736,
+)
+
+
+_RSSIHEATMAP = _descriptor.Descriptor(
+  name='RssiHeatmap',
+  full_name='visualize_grpc_pb.RssiHeatmap',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='x', full_name='visualize_grpc_pb.RssiHeatmap.x', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='y', full_name='visualize_grpc_pb.RssiHeatmap.y', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cell_size', full_name='visualize_grpc_pb.RssiHeatmap.cell_size', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cols', full_name='visualize_grpc_pb.RssiHeatmap.cols', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rows', full_name='visualize_grpc_pb.RssiHeatmap.rows', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rssi', full_name='visualize_grpc_pb.RssiHeatmap.rssi', index=5,
+      number=6, type=2, cpp_type=6, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sinr', full_name='visualize_grpc_pb.RssiHeatmap.sinr', index=6,
+      number=7, type=2, cpp_type=6, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='node_ids', full_name='visualize_grpc_pb.RssiHeatmap.node_ids', index=7,
+      number=8, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='reachable', full_name='visualize_grpc_pb.RssiHeatmap.reachable', index=8,
+      number=9, type=8, cpp_type=7, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5739,
+  serialized_end=5886,
+)
+
+
+_RSSIHEATMAPREQUEST = _descriptor.Descriptor(
+  name='RssiHeatmapRequest',
+  full_name='visualize_grpc_pb.RssiHeatmapRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_ids', full_name='visualize_grpc_pb.RssiHeatmapRequest.node_ids', index=0,
+      number=1, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cell_size', full_name='visualize_grpc_pb.RssiHeatmapRequest.cell_size', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='show', full_name='visualize_grpc_pb.RssiHeatmapRequest.show', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5888,
+  serialized_end=5959,
+)
+
+
+_RSSIHEATMAPRESPONSE = _descriptor.Descriptor(
+  name='RssiHeatmapResponse',
+  full_name='visualize_grpc_pb.RssiHeatmapResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='heatmap', full_name='visualize_grpc_pb.RssiHeatmapResponse.heatmap', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5961,
+  serialized_end=6031,
+)
+
+
+_REPLAYENTRY = _descriptor.Descriptor(
+  name='ReplayEntry',
+  full_name='visualize_grpc_pb.ReplayEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='visualize_grpc_pb.ReplayEntry.timestamp', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='event', full_name='visualize_grpc_pb.ReplayEntry.event', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='energy', full_name='visualize_grpc_pb.ReplayEntry.energy', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='keyframe', full_name='visualize_grpc_pb.ReplayEntry.keyframe', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6034,
+  serialized_end=6189,
+)
+
+
+_EMPTY = _descriptor.Descriptor(
+  name='Empty',
+  full_name='visualize_grpc_pb.Empty',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6191,
+  serialized_end=6198,
+)
+
+_VISUALIZEEVENT.fields_by_name['add_node'].message_type = _ADDNODEEVENT
+_VISUALIZEEVENT.fields_by_name['delete_node'].message_type = _DELETENODEEVENT
+_VISUALIZEEVENT.fields_by_name['set_node_rloc16'].message_type = _SETNODERLOC16EVENT
+_VISUALIZEEVENT.fields_by_name['set_node_role'].message_type = _SETNODEROLEEVENT
+_VISUALIZEEVENT.fields_by_name['set_node_pos'].message_type = _SETNODEPOSEVENT
+_VISUALIZEEVENT.fields_by_name['set_node_partition_id'].message_type = _SETNODEPARTITIONIDEVENT
+_VISUALIZEEVENT.fields_by_name['on_node_fail'].message_type = _ONNODEFAILEVENT
+_VISUALIZEEVENT.fields_by_name['on_node_recover'].message_type = _ONNODERECOVEREVENT
+_VISUALIZEEVENT.fields_by_name['set_parent'].message_type = _SETPARENTEVENT
+_VISUALIZEEVENT.fields_by_name['count_down'].message_type = _COUNTDOWNEVENT
 _VISUALIZEEVENT.fields_by_name['show_demo_legend'].message_type = _SHOWDEMOLEGENDEVENT
 _VISUALIZEEVENT.fields_by_name['advance_time'].message_type = _ADVANCETIMEEVENT
 _VISUALIZEEVENT.fields_by_name['add_router_table'].message_type = _ADDROUTERTABLEEVENT
@@ -1582,6 +2939,8 @@ _VISUALIZEEVENT.fields_by_name['on_ext_addr_change'].message_type = _ONEXTADDRCH
 _VISUALIZEEVENT.fields_by_name['set_title'].message_type = _SETTITLEEVENT
 _VISUALIZEEVENT.fields_by_name['set_node_mode'].message_type = _SETNODEMODEEVENT
 _VISUALIZEEVENT.fields_by_name['set_network_info'].message_type = _SETNETWORKINFOEVENT
+_VISUALIZEEVENT.fields_by_name['custom_status'].message_type = _CUSTOMSTATUSEVENT
+_VISUALIZEEVENT.fields_by_name['rssi_heatmap'].message_type = _RSSIHEATMAPEVENT
 _VISUALIZEEVENT.oneofs_by_name['type'].fields.append(
   _VISUALIZEEVENT.fields_by_name['add_node'])
 _VISUALIZEEVENT.fields_by_name['add_node'].containing_oneof = _VISUALIZEEVENT.oneofs_by_name['type']
@@ -1651,11 +3010,36 @@ _VISUALIZEEVENT.fields_by_name['set_node_mode'].containing_oneof = _VISUALIZEEVE
 _VISUALIZEEVENT.oneofs_by_name['type'].fields.append(
   _VISUALIZEEVENT.fields_by_name['set_network_info'])
 _VISUALIZEEVENT.fields_by_name['set_network_info'].containing_oneof = _VISUALIZEEVENT.oneofs_by_name['type']
+_VISUALIZEEVENT.oneofs_by_name['type'].fields.append(
+  _VISUALIZEEVENT.fields_by_name['custom_status'])
+_VISUALIZEEVENT.fields_by_name['custom_status'].containing_oneof = _VISUALIZEEVENT.oneofs_by_name['type']
+_VISUALIZEEVENT.oneofs_by_name['type'].fields.append(
+  _VISUALIZEEVENT.fields_by_name['rssi_heatmap'])
+_VISUALIZEEVENT.fields_by_name['rssi_heatmap'].containing_oneof = _VISUALIZEEVENT.oneofs_by_name['type']
 _SENDEVENT.fields_by_name['mv_info'].message_type = _MSGVISUALIZEINFO
+_RSSIHEATMAPEVENT.fields_by_name['heatmap'].message_type = _RSSIHEATMAP
 _SETNODEROLEEVENT.fields_by_name['role'].enum_type = _OTDEVICEROLE
 _SETNODEMODEEVENT.fields_by_name['node_mode'].message_type = _NODEMODE
 _NETWORKENERGYEVENT.fields_by_name['NodesEnergy'].message_type = _NODEENERGY
+_FRAMESTATS_DROPPEDENTRY.containing_type = _FRAMESTATS
+_FRAMESTATS.fields_by_name['dropped'].message_type = _FRAMESTATS_DROPPEDENTRY
+_NODEFRAMESTATS_TXFRAMETYPESENTRY.containing_type = _NODEFRAMESTATS
+_NODEFRAMESTATS.fields_by_name['tx'].message_type = _FRAMESTATS
+_NODEFRAMESTATS.fields_by_name['rx'].message_type = _FRAMESTATS
+_NODEFRAMESTATS.fields_by_name['tx_frame_types'].message_type = _NODEFRAMESTATS_TXFRAMETYPESENTRY
+_LINKFRAMESTATS.fields_by_name['stats'].message_type = _FRAMESTATS
+_FRAMESTATSRESPONSE.fields_by_name['nodes'].message_type = _NODEFRAMESTATS
+_FRAMESTATSRESPONSE.fields_by_name['links'].message_type = _LINKFRAMESTATS
+_ADDNODEREQUEST.fields_by_name['position'].message_type = _NODEPOSITION
+_MOVENODEREQUEST.fields_by_name['position'].message_type = _NODEPOSITION
+_NODEINFO.fields_by_name['position'].message_type = _NODEPOSITION
+_NODEINFO.fields_by_name['role'].enum_type = _OTDEVICEROLE
+_GETNODESRESPONSE.fields_by_name['nodes'].message_type = _NODEINFO
+_GETPARTITIONSRESPONSE.fields_by_name['partitions'].message_type = _PARTITION
+_GETPINGSRESPONSE.fields_by_name['pings'].message_type = _PINGRESULT
+_RSSIHEATMAPRESPONSE.fields_by_name['heatmap'].message_type = _RSSIHEATMAP
 _REPLAYENTRY.fields_by_name['event'].message_type = _VISUALIZEEVENT
+_REPLAYENTRY.fields_by_name['energy'].message_type = _NETWORKENERGYEVENT
 DESCRIPTOR.message_types_by_name['VisualizeRequest'] = _VISUALIZEREQUEST
 DESCRIPTOR.message_types_by_name['VisualizeEvent'] = _VISUALIZEEVENT
 DESCRIPTOR.message_types_by_name['SendEvent'] = _SENDEVENT
@@ -1670,6 +3054,7 @@ DESCRIPTOR.message_types_by_name['AdvanceTimeEvent'] = _ADVANCETIMEEVENT
 DESCRIPTOR.message_types_by_name['SetParentEvent'] = _SETPARENTEVENT
 DESCRIPTOR.message_types_by_name['CountDownEvent'] = _COUNTDOWNEVENT
 DESCRIPTOR.message_types_by_name['ShowDemoLegendEvent'] = _SHOWDEMOLEGENDEVENT
+DESCRIPTOR.message_types_by_name['RssiHeatmapEvent'] = _RSSIHEATMAPEVENT
 DESCRIPTOR.message_types_by_name['SetNodePosEvent'] = _SETNODEPOSEVENT
 DESCRIPTOR.message_types_by_name['SetNodeRoleEvent'] = _SETNODEROLEEVENT
 DESCRIPTOR.message_types_by_name['SetNodePartitionIdEvent'] = _SETNODEPARTITIONIDEVENT
@@ -1683,10 +3068,37 @@ DESCRIPTOR.message_types_by_name['OnExtAddrChangeEvent'] = _ONEXTADDRCHANGEEVENT
 DESCRIPTOR.message_types_by_name['SetTitleEvent'] = _SETTITLEEVENT
 DESCRIPTOR.message_types_by_name['SetNodeModeEvent'] = _SETNODEMODEEVENT
 DESCRIPTOR.message_types_by_name['SetNetworkInfoEvent'] = _SETNETWORKINFOEVENT
+DESCRIPTOR.message_types_by_name['CustomStatusEvent'] = _CUSTOMSTATUSEVENT
 DESCRIPTOR.message_types_by_name['NodeEnergy'] = _NODEENERGY
 DESCRIPTOR.message_types_by_name['NetworkEnergyEvent'] = _NETWORKENERGYEVENT
 DESCRIPTOR.message_types_by_name['CommandRequest'] = _COMMANDREQUEST
 DESCRIPTOR.message_types_by_name['CommandResponse'] = _COMMANDRESPONSE
+DESCRIPTOR.message_types_by_name['FrameStatsRequest'] = _FRAMESTATSREQUEST
+DESCRIPTOR.message_types_by_name['FrameStats'] = _FRAMESTATS
+DESCRIPTOR.message_types_by_name['NodeFrameStats'] = _NODEFRAMESTATS
+DESCRIPTOR.message_types_by_name['LinkFrameStats'] = _LINKFRAMESTATS
+DESCRIPTOR.message_types_by_name['FrameStatsResponse'] = _FRAMESTATSRESPONSE
+DESCRIPTOR.message_types_by_name['NodePosition'] = _NODEPOSITION
+DESCRIPTOR.message_types_by_name['AddNodeRequest'] = _ADDNODEREQUEST
+DESCRIPTOR.message_types_by_name['AddNodeResponse'] = _ADDNODERESPONSE
+DESCRIPTOR.message_types_by_name['DeleteNodeRequest'] = _DELETENODEREQUEST
+DESCRIPTOR.message_types_by_name['MoveNodeRequest'] = _MOVENODEREQUEST
+DESCRIPTOR.message_types_by_name['SetRadioRequest'] = _SETRADIOREQUEST
+DESCRIPTOR.message_types_by_name['GoRequest'] = _GOREQUEST
+DESCRIPTOR.message_types_by_name['GoResponse'] = _GORESPONSE
+DESCRIPTOR.message_types_by_name['NodeInfo'] = _NODEINFO
+DESCRIPTOR.message_types_by_name['GetNodesResponse'] = _GETNODESRESPONSE
+DESCRIPTOR.message_types_by_name['Partition'] = _PARTITION
+DESCRIPTOR.message_types_by_name['GetPartitionsResponse'] = _GETPARTITIONSRESPONSE
+DESCRIPTOR.message_types_by_name['PingResult'] = _PINGRESULT
+DESCRIPTOR.message_types_by_name['GetPingsResponse'] = _GETPINGSRESPONSE
+DESCRIPTOR.message_types_by_name['NodeCommandRequest'] = _NODECOMMANDREQUEST
+DESCRIPTOR.message_types_by_name['NodeCommandResponse'] = _NODECOMMANDRESPONSE
+DESCRIPTOR.message_types_by_name['NodeLogsRequest'] = _NODELOGSREQUEST
+DESCRIPTOR.message_types_by_name['NodeLogEntry'] = _NODELOGENTRY
+DESCRIPTOR.message_types_by_name['RssiHeatmap'] = _RSSIHEATMAP
+DESCRIPTOR.message_types_by_name['RssiHeatmapRequest'] = _RSSIHEATMAPREQUEST
+DESCRIPTOR.message_types_by_name['RssiHeatmapResponse'] = _RSSIHEATMAPRESPONSE
 DESCRIPTOR.message_types_by_name['ReplayEntry'] = _REPLAYENTRY
 DESCRIPTOR.message_types_by_name['Empty'] = _EMPTY
 DESCRIPTOR.enum_types_by_name['OtDeviceRole'] = _OTDEVICEROLE
@@ -1790,6 +3202,13 @@ ShowDemoLegendEvent = _reflection.GeneratedProtocolMessageType('ShowDemoLegendEv
   })
 _sym_db.RegisterMessage(ShowDemoLegendEvent)
 
+RssiHeatmapEvent = _reflection.GeneratedProtocolMessageType('RssiHeatmapEvent', (_message.Message,), {
+  'DESCRIPTOR' : _RSSIHEATMAPEVENT,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.RssiHeatmapEvent)
+  })
+_sym_db.RegisterMessage(RssiHeatmapEvent)
+
 SetNodePosEvent = _reflection.GeneratedProtocolMessageType('SetNodePosEvent', (_message.Message,), {
   'DESCRIPTOR' : _SETNODEPOSEVENT,
   '__module__' : 'visualize_grpc_pb2'
@@ -1881,6 +3300,13 @@ SetNetworkInfoEvent = _reflection.GeneratedProtocolMessageType('SetNetworkInfoEv
   })
 _sym_db.RegisterMessage(SetNetworkInfoEvent)
 
+CustomStatusEvent = _reflection.GeneratedProtocolMessageType('CustomStatusEvent', (_message.Message,), {
+  'DESCRIPTOR' : _CUSTOMSTATUSEVENT,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.CustomStatusEvent)
+  })
+_sym_db.RegisterMessage(CustomStatusEvent)
+
 NodeEnergy = _reflection.GeneratedProtocolMessageType('NodeEnergy', (_message.Message,), {
   'DESCRIPTOR' : _NODEENERGY,
   '__module__' : 'visualize_grpc_pb2'
@@ -1909,6 +3335,204 @@ CommandResponse = _reflection.GeneratedProtocolMessageType('CommandResponse', (_
   })
 _sym_db.RegisterMessage(CommandResponse)
 
+FrameStatsRequest = _reflection.GeneratedProtocolMessageType('FrameStatsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FRAMESTATSREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.FrameStatsRequest)
+  })
+_sym_db.RegisterMessage(FrameStatsRequest)
+
+FrameStats = _reflection.GeneratedProtocolMessageType('FrameStats', (_message.Message,), {
+
+  'DroppedEntry' : _reflection.GeneratedProtocolMessageType('DroppedEntry', (_message.Message,), {
+    'DESCRIPTOR' : _FRAMESTATS_DROPPEDENTRY,
+    '__module__' : 'visualize_grpc_pb2'
+    # @@protoc_insertion_point(class_scope:visualize_grpc_pb.FrameStats.DroppedEntry)
+    })
+  ,
+  'DESCRIPTOR' : _FRAMESTATS,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.FrameStats)
+  })
+_sym_db.RegisterMessage(FrameStats)
+_sym_db.RegisterMessage(FrameStats.DroppedEntry)
+
+NodeFrameStats = _reflection.GeneratedProtocolMessageType('NodeFrameStats', (_message.Message,), {
+
+  'TxFrameTypesEntry' : _reflection.GeneratedProtocolMessageType('TxFrameTypesEntry', (_message.Message,), {
+    'DESCRIPTOR' : _NODEFRAMESTATS_TXFRAMETYPESENTRY,
+    '__module__' : 'visualize_grpc_pb2'
+    # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry)
+    })
+  ,
+  'DESCRIPTOR' : _NODEFRAMESTATS,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeFrameStats)
+  })
+_sym_db.RegisterMessage(NodeFrameStats)
+_sym_db.RegisterMessage(NodeFrameStats.TxFrameTypesEntry)
+
+LinkFrameStats = _reflection.GeneratedProtocolMessageType('LinkFrameStats', (_message.Message,), {
+  'DESCRIPTOR' : _LINKFRAMESTATS,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.LinkFrameStats)
+  })
+_sym_db.RegisterMessage(LinkFrameStats)
+
+FrameStatsResponse = _reflection.GeneratedProtocolMessageType('FrameStatsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _FRAMESTATSRESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.FrameStatsResponse)
+  })
+_sym_db.RegisterMessage(FrameStatsResponse)
+
+NodePosition = _reflection.GeneratedProtocolMessageType('NodePosition', (_message.Message,), {
+  'DESCRIPTOR' : _NODEPOSITION,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodePosition)
+  })
+_sym_db.RegisterMessage(NodePosition)
+
+AddNodeRequest = _reflection.GeneratedProtocolMessageType('AddNodeRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ADDNODEREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.AddNodeRequest)
+  })
+_sym_db.RegisterMessage(AddNodeRequest)
+
+AddNodeResponse = _reflection.GeneratedProtocolMessageType('AddNodeResponse', (_message.Message,), {
+  'DESCRIPTOR' : _ADDNODERESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.AddNodeResponse)
+  })
+_sym_db.RegisterMessage(AddNodeResponse)
+
+DeleteNodeRequest = _reflection.GeneratedProtocolMessageType('DeleteNodeRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DELETENODEREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.DeleteNodeRequest)
+  })
+_sym_db.RegisterMessage(DeleteNodeRequest)
+
+MoveNodeRequest = _reflection.GeneratedProtocolMessageType('MoveNodeRequest', (_message.Message,), {
+  'DESCRIPTOR' : _MOVENODEREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.MoveNodeRequest)
+  })
+_sym_db.RegisterMessage(MoveNodeRequest)
+
+SetRadioRequest = _reflection.GeneratedProtocolMessageType('SetRadioRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SETRADIOREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.SetRadioRequest)
+  })
+_sym_db.RegisterMessage(SetRadioRequest)
+
+GoRequest = _reflection.GeneratedProtocolMessageType('GoRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GOREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.GoRequest)
+  })
+_sym_db.RegisterMessage(GoRequest)
+
+GoResponse = _reflection.GeneratedProtocolMessageType('GoResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GORESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.GoResponse)
+  })
+_sym_db.RegisterMessage(GoResponse)
+
+NodeInfo = _reflection.GeneratedProtocolMessageType('NodeInfo', (_message.Message,), {
+  'DESCRIPTOR' : _NODEINFO,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeInfo)
+  })
+_sym_db.RegisterMessage(NodeInfo)
+
+GetNodesResponse = _reflection.GeneratedProtocolMessageType('GetNodesResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETNODESRESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.GetNodesResponse)
+  })
+_sym_db.RegisterMessage(GetNodesResponse)
+
+Partition = _reflection.GeneratedProtocolMessageType('Partition', (_message.Message,), {
+  'DESCRIPTOR' : _PARTITION,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.Partition)
+  })
+_sym_db.RegisterMessage(Partition)
+
+GetPartitionsResponse = _reflection.GeneratedProtocolMessageType('GetPartitionsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETPARTITIONSRESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.GetPartitionsResponse)
+  })
+_sym_db.RegisterMessage(GetPartitionsResponse)
+
+PingResult = _reflection.GeneratedProtocolMessageType('PingResult', (_message.Message,), {
+  'DESCRIPTOR' : _PINGRESULT,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.PingResult)
+  })
+_sym_db.RegisterMessage(PingResult)
+
+GetPingsResponse = _reflection.GeneratedProtocolMessageType('GetPingsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETPINGSRESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.GetPingsResponse)
+  })
+_sym_db.RegisterMessage(GetPingsResponse)
+
+NodeCommandRequest = _reflection.GeneratedProtocolMessageType('NodeCommandRequest', (_message.Message,), {
+  'DESCRIPTOR' : _NODECOMMANDREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeCommandRequest)
+  })
+_sym_db.RegisterMessage(NodeCommandRequest)
+
+NodeCommandResponse = _reflection.GeneratedProtocolMessageType('NodeCommandResponse', (_message.Message,), {
+  'DESCRIPTOR' : _NODECOMMANDRESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeCommandResponse)
+  })
+_sym_db.RegisterMessage(NodeCommandResponse)
+
+NodeLogsRequest = _reflection.GeneratedProtocolMessageType('NodeLogsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _NODELOGSREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeLogsRequest)
+  })
+_sym_db.RegisterMessage(NodeLogsRequest)
+
+NodeLogEntry = _reflection.GeneratedProtocolMessageType('NodeLogEntry', (_message.Message,), {
+  'DESCRIPTOR' : _NODELOGENTRY,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.NodeLogEntry)
+  })
+_sym_db.RegisterMessage(NodeLogEntry)
+
+RssiHeatmap = _reflection.GeneratedProtocolMessageType('RssiHeatmap', (_message.Message,), {
+  'DESCRIPTOR' : _RSSIHEATMAP,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.RssiHeatmap)
+  })
+_sym_db.RegisterMessage(RssiHeatmap)
+
+RssiHeatmapRequest = _reflection.GeneratedProtocolMessageType('RssiHeatmapRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RSSIHEATMAPREQUEST,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.RssiHeatmapRequest)
+  })
+_sym_db.RegisterMessage(RssiHeatmapRequest)
+
+RssiHeatmapResponse = _reflection.GeneratedProtocolMessageType('RssiHeatmapResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RSSIHEATMAPRESPONSE,
+  '__module__' : 'visualize_grpc_pb2'
+  # @@protoc_insertion_point(class_scope:visualize_grpc_pb.RssiHeatmapResponse)
+  })
+_sym_db.RegisterMessage(RssiHeatmapResponse)
+
 ReplayEntry = _reflection.GeneratedProtocolMessageType('ReplayEntry', (_message.Message,), {
   'DESCRIPTOR' : _REPLAYENTRY,
   '__module__' : 'visualize_grpc_pb2'
@@ -1925,6 +3549,8 @@ _sym_db.RegisterMessage(Empty)
 
 
 DESCRIPTOR._options = None
+_FRAMESTATS_DROPPEDENTRY._options = None
+_NODEFRAMESTATS_TXFRAMETYPESENTRY._options = None
 
 _VISUALIZEGRPCSERVICE = _descriptor.ServiceDescriptor(
   name='VisualizeGrpcService',
@@ -1933,8 +3559,8 @@ _VISUALIZEGRPCSERVICE = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=3683,
-  serialized_end=3968,
+  serialized_start=6356,
+  serialized_end=7613,
   methods=[
   _descriptor.MethodDescriptor(
     name='Visualize',
@@ -1966,6 +3592,126 @@ _VISUALIZEGRPCSERVICE = _descriptor.ServiceDescriptor(
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='FrameStats',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.FrameStats',
+    index=3,
+    containing_service=None,
+    input_type=_FRAMESTATSREQUEST,
+    output_type=_FRAMESTATSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AddNode',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.AddNode',
+    index=4,
+    containing_service=None,
+    input_type=_ADDNODEREQUEST,
+    output_type=_ADDNODERESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteNode',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.DeleteNode',
+    index=5,
+    containing_service=None,
+    input_type=_DELETENODEREQUEST,
+    output_type=_EMPTY,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='MoveNode',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.MoveNode',
+    index=6,
+    containing_service=None,
+    input_type=_MOVENODEREQUEST,
+    output_type=_EMPTY,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SetRadio',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.SetRadio',
+    index=7,
+    containing_service=None,
+    input_type=_SETRADIOREQUEST,
+    output_type=_EMPTY,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Go',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.Go',
+    index=8,
+    containing_service=None,
+    input_type=_GOREQUEST,
+    output_type=_GORESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetNodes',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.GetNodes',
+    index=9,
+    containing_service=None,
+    input_type=_EMPTY,
+    output_type=_GETNODESRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetPartitions',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.GetPartitions',
+    index=10,
+    containing_service=None,
+    input_type=_EMPTY,
+    output_type=_GETPARTITIONSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetPings',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.GetPings',
+    index=11,
+    containing_service=None,
+    input_type=_EMPTY,
+    output_type=_GETPINGSRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='NodeCommand',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.NodeCommand',
+    index=12,
+    containing_service=None,
+    input_type=_NODECOMMANDREQUEST,
+    output_type=_NODECOMMANDRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='NodeLogs',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.NodeLogs',
+    index=13,
+    containing_service=None,
+    input_type=_NODELOGSREQUEST,
+    output_type=_NODELOGENTRY,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='RssiHeatmap',
+    full_name='visualize_grpc_pb.VisualizeGrpcService.RssiHeatmap',
+    index=14,
+    containing_service=None,
+    input_type=_RSSIHEATMAPREQUEST,
+    output_type=_RSSIHEATMAPRESPONSE,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_VISUALIZEGRPCSERVICE)
 
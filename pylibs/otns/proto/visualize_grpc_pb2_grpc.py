@@ -29,6 +29,66 @@ class VisualizeGrpcServiceStub(object):
                 request_serializer=visualize__grpc__pb2.VisualizeRequest.SerializeToString,
                 response_deserializer=visualize__grpc__pb2.NetworkEnergyEvent.FromString,
                 )
+        self.FrameStats = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/FrameStats',
+                request_serializer=visualize__grpc__pb2.FrameStatsRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.FrameStatsResponse.FromString,
+                )
+        self.AddNode = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/AddNode',
+                request_serializer=visualize__grpc__pb2.AddNodeRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.AddNodeResponse.FromString,
+                )
+        self.DeleteNode = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/DeleteNode',
+                request_serializer=visualize__grpc__pb2.DeleteNodeRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.Empty.FromString,
+                )
+        self.MoveNode = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/MoveNode',
+                request_serializer=visualize__grpc__pb2.MoveNodeRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.Empty.FromString,
+                )
+        self.SetRadio = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/SetRadio',
+                request_serializer=visualize__grpc__pb2.SetRadioRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.Empty.FromString,
+                )
+        self.Go = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/Go',
+                request_serializer=visualize__grpc__pb2.GoRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.GoResponse.FromString,
+                )
+        self.GetNodes = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/GetNodes',
+                request_serializer=visualize__grpc__pb2.Empty.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.GetNodesResponse.FromString,
+                )
+        self.GetPartitions = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/GetPartitions',
+                request_serializer=visualize__grpc__pb2.Empty.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.GetPartitionsResponse.FromString,
+                )
+        self.GetPings = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/GetPings',
+                request_serializer=visualize__grpc__pb2.Empty.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.GetPingsResponse.FromString,
+                )
+        self.NodeCommand = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/NodeCommand',
+                request_serializer=visualize__grpc__pb2.NodeCommandRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.NodeCommandResponse.FromString,
+                )
+        self.NodeLogs = channel.unary_stream(
+                '/visualize_grpc_pb.VisualizeGrpcService/NodeLogs',
+                request_serializer=visualize__grpc__pb2.NodeLogsRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.NodeLogEntry.FromString,
+                )
+        self.RssiHeatmap = channel.unary_unary(
+                '/visualize_grpc_pb.VisualizeGrpcService/RssiHeatmap',
+                request_serializer=visualize__grpc__pb2.RssiHeatmapRequest.SerializeToString,
+                response_deserializer=visualize__grpc__pb2.RssiHeatmapResponse.FromString,
+                )
 
 
 class VisualizeGrpcServiceServicer(object):
@@ -52,6 +112,78 @@ class VisualizeGrpcServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def FrameStats(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AddNode(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteNode(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MoveNode(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetRadio(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Go(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetNodes(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPartitions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPings(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def NodeCommand(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def NodeLogs(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RssiHeatmap(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_VisualizeGrpcServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -70,6 +202,66 @@ def add_VisualizeGrpcServiceServicer_to_server(servicer, server):
                     request_deserializer=visualize__grpc__pb2.VisualizeRequest.FromString,
                     response_serializer=visualize__grpc__pb2.NetworkEnergyEvent.SerializeToString,
             ),
+            'FrameStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.FrameStats,
+                    request_deserializer=visualize__grpc__pb2.FrameStatsRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.FrameStatsResponse.SerializeToString,
+            ),
+            'AddNode': grpc.unary_unary_rpc_method_handler(
+                    servicer.AddNode,
+                    request_deserializer=visualize__grpc__pb2.AddNodeRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.AddNodeResponse.SerializeToString,
+            ),
+            'DeleteNode': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteNode,
+                    request_deserializer=visualize__grpc__pb2.DeleteNodeRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.Empty.SerializeToString,
+            ),
+            'MoveNode': grpc.unary_unary_rpc_method_handler(
+                    servicer.MoveNode,
+                    request_deserializer=visualize__grpc__pb2.MoveNodeRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.Empty.SerializeToString,
+            ),
+            'SetRadio': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetRadio,
+                    request_deserializer=visualize__grpc__pb2.SetRadioRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.Empty.SerializeToString,
+            ),
+            'Go': grpc.unary_unary_rpc_method_handler(
+                    servicer.Go,
+                    request_deserializer=visualize__grpc__pb2.GoRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.GoResponse.SerializeToString,
+            ),
+            'GetNodes': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetNodes,
+                    request_deserializer=visualize__grpc__pb2.Empty.FromString,
+                    response_serializer=visualize__grpc__pb2.GetNodesResponse.SerializeToString,
+            ),
+            'GetPartitions': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPartitions,
+                    request_deserializer=visualize__grpc__pb2.Empty.FromString,
+                    response_serializer=visualize__grpc__pb2.GetPartitionsResponse.SerializeToString,
+            ),
+            'GetPings': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPings,
+                    request_deserializer=visualize__grpc__pb2.Empty.FromString,
+                    response_serializer=visualize__grpc__pb2.GetPingsResponse.SerializeToString,
+            ),
+            'NodeCommand': grpc.unary_unary_rpc_method_handler(
+                    servicer.NodeCommand,
+                    request_deserializer=visualize__grpc__pb2.NodeCommandRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.NodeCommandResponse.SerializeToString,
+            ),
+            'NodeLogs': grpc.unary_stream_rpc_method_handler(
+                    servicer.NodeLogs,
+                    request_deserializer=visualize__grpc__pb2.NodeLogsRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.NodeLogEntry.SerializeToString,
+            ),
+            'RssiHeatmap': grpc.unary_unary_rpc_method_handler(
+                    servicer.RssiHeatmap,
+                    request_deserializer=visualize__grpc__pb2.RssiHeatmapRequest.FromString,
+                    response_serializer=visualize__grpc__pb2.RssiHeatmapResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'visualize_grpc_pb.VisualizeGrpcService', rpc_method_handlers)
@@ -130,3 +322,207 @@ class VisualizeGrpcService(object):
             visualize__grpc__pb2.NetworkEnergyEvent.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def FrameStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/FrameStats',
+            visualize__grpc__pb2.FrameStatsRequest.SerializeToString,
+            visualize__grpc__pb2.FrameStatsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def AddNode(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/AddNode',
+            visualize__grpc__pb2.AddNodeRequest.SerializeToString,
+            visualize__grpc__pb2.AddNodeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteNode(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/DeleteNode',
+            visualize__grpc__pb2.DeleteNodeRequest.SerializeToString,
+            visualize__grpc__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MoveNode(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/MoveNode',
+            visualize__grpc__pb2.MoveNodeRequest.SerializeToString,
+            visualize__grpc__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetRadio(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/SetRadio',
+            visualize__grpc__pb2.SetRadioRequest.SerializeToString,
+            visualize__grpc__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Go(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/Go',
+            visualize__grpc__pb2.GoRequest.SerializeToString,
+            visualize__grpc__pb2.GoResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetNodes(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/GetNodes',
+            visualize__grpc__pb2.Empty.SerializeToString,
+            visualize__grpc__pb2.GetNodesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPartitions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/GetPartitions',
+            visualize__grpc__pb2.Empty.SerializeToString,
+            visualize__grpc__pb2.GetPartitionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPings(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/GetPings',
+            visualize__grpc__pb2.Empty.SerializeToString,
+            visualize__grpc__pb2.GetPingsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def NodeCommand(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/NodeCommand',
+            visualize__grpc__pb2.NodeCommandRequest.SerializeToString,
+            visualize__grpc__pb2.NodeCommandResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def NodeLogs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/visualize_grpc_pb.VisualizeGrpcService/NodeLogs',
+            visualize__grpc__pb2.NodeLogsRequest.SerializeToString,
+            visualize__grpc__pb2.NodeLogEntry.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RssiHeatmap(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/visualize_grpc_pb.VisualizeGrpcService/RssiHeatmap',
+            visualize__grpc__pb2.RssiHeatmapRequest.SerializeToString,
+            visualize__grpc__pb2.RssiHeatmapResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
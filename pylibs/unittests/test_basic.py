@@ -592,9 +592,10 @@ class BasicTests(OTNSTestCase):
         self.assertTrue('-100 dBm', ns._do_command('rxsens 1'))
         self.assertTrue('-100 dBm', ns._do_command('rxsens 2'))
 
-        ns._do_command('rxsens 1 -85')
-        self.assertTrue('-85 dBm', ns._do_command('rxsens 1'))
-        self.assertTrue('-100 dBm', ns._do_command('rxsens 2'))
+        # ot-rfsim doesn't report its capabilities yet, so setting the Rx sensitivity is refused.
+        with self.assertRaises(errors.OTNSCliError):
+            ns._do_command('rxsens 1 -85')
+        self.assertTrue('-100 dBm', ns._do_command('rxsens 1'))
 
     def testCcaThreshold(self):
         ns: OTNS = self.ns
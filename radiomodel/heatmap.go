@@ -0,0 +1,125 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package radiomodel
+
+import (
+	. "github.com/openthread/ot-ns/types"
+)
+
+const (
+	defaultTxPowerDbm       DbValue = 0.0    // Tx power (dBm) assumed for a node that did not transmit yet.
+	defaultRxSensitivityDbm DbValue = -100.0 // Rx sensitivity (dBm) of the receiver placed in each heatmap cell.
+)
+
+// HeatmapCell is the radio coverage at a single cell of a Heatmap.
+type HeatmapCell struct {
+	Rssi      DbValue // the RSSI (dBm) of the strongest transmitter.
+	Sinr      DbValue // the SINR (dB) of the strongest transmitter, with the other transmitters as interferers.
+	NodeId    NodeId  // the strongest transmitter.
+	Reachable bool    // true if a receiver in the cell can receive frames from the strongest transmitter, if alone.
+}
+
+// Heatmap is a grid of radio coverage values over (a part of) the simulation field.
+type Heatmap struct {
+	X, Y       int // the top-left point of the grid, in units/pixels.
+	CellSize   int
+	Cols, Rows int
+	Cells      []HeatmapCell // in row-major order.
+}
+
+// Cell gets the cell at the given column and row of the heatmap.
+func (hm *Heatmap) Cell(col, row int) *HeatmapCell {
+	return &hm.Cells[row*hm.Cols+col]
+}
+
+// ComputeHeatmap evaluates the RSSI and SINR of the transmitters txNodes over a grid of cols x rows cells of cellSize
+// units, with its top-left point at (x, y), at the centre of each cell. It uses the path loss and shadow fading of
+// the current model, as if a receiver was placed in the cell. Transmitters on other channels than the strongest
+// transmitter do not interfere.
+func ComputeHeatmap(model RadioModel, txNodes []*RadioNode, x, y, cellSize, cols, rows int) *Heatmap {
+	hm := &Heatmap{
+		X:        x,
+		Y:        y,
+		CellSize: cellSize,
+		Cols:     cols,
+		Rows:     rows,
+		Cells:    make([]HeatmapCell, cols*rows),
+	}
+
+	noiseFloorDbm := model.GetParameters().NoiseFloorDbm
+	if noiseFloorDbm == UndefinedDbValue {
+		noiseFloorDbm = defaultNoiseFloorIndoorDbm
+	}
+
+	// the transmitters are copied, so that a default Tx power can be used for nodes that did not transmit yet.
+	srcNodes := make([]RadioNode, len(txNodes))
+	for i, txNode := range txNodes {
+		srcNodes[i] = *txNode
+		if srcNodes[i].TxPower == RssiInvalid {
+			srcNodes[i].TxPower = defaultTxPowerDbm
+		}
+	}
+
+	rxNode := NewRadioNode(InvalidNodeId, &RadioNodeConfig{})
+	rxNode.RxSensitivity = defaultRxSensitivityDbm
+	rxNode.RadioState = RadioRx
+	rssi := make([]DbValue, len(srcNodes))
+
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			rxNode.X = float64(x + col*cellSize + cellSize/2)
+			rxNode.Y = float64(y + row*cellSize + cellSize/2)
+
+			best := -1
+			for i := range srcNodes {
+				rxNode.RadioChannel = srcNodes[i].RadioChannel
+				rssi[i] = model.GetTxRssi(&srcNodes[i], rxNode)
+				if best < 0 || rssi[i] > rssi[best] {
+					best = i
+				}
+			}
+			if best < 0 {
+				continue
+			}
+
+			interferenceDbm := noiseFloorDbm
+			for i := range srcNodes {
+				if i != best && srcNodes[i].RadioChannel == srcNodes[best].RadioChannel {
+					interferenceDbm = addSignalPowersDbm(interferenceDbm, rssi[i])
+				}
+			}
+			rxNode.RadioChannel = srcNodes[best].RadioChannel
+
+			cell := hm.Cell(col, row)
+			cell.Rssi = rssi[best]
+			cell.Sinr = rssi[best] - interferenceDbm
+			cell.NodeId = srcNodes[best].Id
+			cell.Reachable = model.CheckRadioReachable(&srcNodes[best], rxNode)
+		}
+	}
+	return hm
+}
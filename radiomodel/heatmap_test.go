@@ -0,0 +1,64 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package radiomodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/openthread/ot-ns/types"
+)
+
+func TestComputeHeatmap(t *testing.T) {
+	model := NewRadioModel("Ideal_Rssi")
+	tx1 := NewRadioNode(1, &RadioNodeConfig{X: 50, Y: 50, RadioRange: 90})
+	tx2 := NewRadioNode(2, &RadioNodeConfig{X: 250, Y: 50, RadioRange: 90})
+	tx2.TxPower = -10.0
+	model.AddNode(1, tx1)
+	model.AddNode(2, tx2)
+
+	hm := ComputeHeatmap(model, []*RadioNode{tx1, tx2}, 0, 0, 100, 3, 2)
+	assert.Equal(t, 6, len(hm.Cells))
+
+	// the cell of each transmitter is covered by that transmitter, at 0 distance.
+	assert.Equal(t, NodeId(1), hm.Cell(0, 0).NodeId)
+	assert.Equal(t, defaultTxPowerDbm, hm.Cell(0, 0).Rssi)
+	assert.True(t, hm.Cell(0, 0).Reachable)
+	assert.Equal(t, NodeId(2), hm.Cell(2, 0).NodeId)
+	assert.Equal(t, -10.0, hm.Cell(2, 0).Rssi)
+	assert.True(t, hm.Cell(0, 0).Sinr > hm.Cell(2, 0).Sinr)
+
+	// between both transmitters, the stronger one wins, but the cell is out of its radio range.
+	assert.Equal(t, NodeId(1), hm.Cell(1, 0).NodeId)
+	assert.True(t, hm.Cell(1, 0).Rssi < hm.Cell(0, 0).Rssi)
+	assert.False(t, hm.Cell(1, 0).Reachable)
+
+	// a single transmitter has no interferers: the SINR is the SNR.
+	hm = ComputeHeatmap(model, []*RadioNode{tx1}, 0, 0, 100, 1, 1)
+	assert.Equal(t, defaultTxPowerDbm-defaultNoiseFloorIndoorDbm, hm.Cell(0, 0).Sinr)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package simulation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/openthread/ot-ns/radiomodel"
+	. "github.com/openthread/ot-ns/types"
+	"github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+const (
+	DefaultHeatmapCellSize = 20     // default size (units/pixels) of the cells of an RSSI heatmap.
+	maxHeatmapCells        = 250000 // maximum number of cells of an RSSI heatmap.
+)
+
+// RssiHeatmap computes the RSSI and SINR heatmap of the transmitters txIds, or of all nodes if empty, using the
+// current radio model. The grid of cells of cellSize units covers all nodes, extended by the largest radio range of
+// the transmitters.
+func (s *Simulation) RssiHeatmap(txIds []NodeId, cellSize int) (*radiomodel.Heatmap, error) {
+	if cellSize <= 0 {
+		return nil, fmt.Errorf("invalid cell size: %d", cellSize)
+	}
+	if len(txIds) == 0 {
+		txIds = s.GetNodes()
+	}
+	if len(txIds) == 0 {
+		return nil, fmt.Errorf("no nodes to compute the heatmap of")
+	}
+
+	var txNodes []*radiomodel.RadioNode
+	radioRange := 0.0
+	for _, id := range txIds {
+		dn := s.d.GetNode(id)
+		if dn == nil {
+			return nil, fmt.Errorf("node %d not found", id)
+		}
+		txNodes = append(txNodes, dn.RadioNode)
+		radioRange = math.Max(radioRange, dn.RadioNode.RadioRange)
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, id := range s.GetNodes() {
+		rn := s.d.GetNode(id).RadioNode
+		minX, minY = math.Min(minX, rn.X), math.Min(minY, rn.Y)
+		maxX, maxY = math.Max(maxX, rn.X), math.Max(maxY, rn.Y)
+	}
+
+	// align the grid on the cell size, and keep it within the (positive) field.
+	x := int(math.Max(0, math.Floor((minX-radioRange)/float64(cellSize)))) * cellSize
+	y := int(math.Max(0, math.Floor((minY-radioRange)/float64(cellSize)))) * cellSize
+	cols := int(math.Floor((maxX+radioRange-float64(x))/float64(cellSize))) + 1
+	rows := int(math.Floor((maxY+radioRange-float64(y))/float64(cellSize))) + 1
+	if cols*rows > maxHeatmapCells {
+		return nil, fmt.Errorf("heatmap too large (%dx%d cells), use a larger cell size", cols, rows)
+	}
+
+	return radiomodel.ComputeHeatmap(s.d.GetRadioModel(), txNodes, x, y, cellSize, cols, rows), nil
+}
+
+// ShowRssiHeatmap shows the heatmap in the visualization, or hides it if nil.
+func (s *Simulation) ShowRssiHeatmap(heatmap *radiomodel.Heatmap) {
+	if heatmap == nil {
+		s.vis.ShowRssiHeatmap(nil)
+		return
+	}
+	s.vis.ShowRssiHeatmap(rssiHeatmapToPb(heatmap))
+}
+
+func rssiHeatmapToPb(heatmap *radiomodel.Heatmap) *pb.RssiHeatmap {
+	hm := &pb.RssiHeatmap{
+		X:         int32(heatmap.X),
+		Y:         int32(heatmap.Y),
+		CellSize:  int32(heatmap.CellSize),
+		Cols:      int32(heatmap.Cols),
+		Rows:      int32(heatmap.Rows),
+		Rssi:      make([]float32, len(heatmap.Cells)),
+		Sinr:      make([]float32, len(heatmap.Cells)),
+		NodeIds:   make([]int32, len(heatmap.Cells)),
+		Reachable: make([]bool, len(heatmap.Cells)),
+	}
+	for i, cell := range heatmap.Cells {
+		hm.Rssi[i] = float32(cell.Rssi)
+		hm.Sinr[i] = float32(cell.Sinr)
+		hm.NodeIds[i] = int32(cell.NodeId)
+		hm.Reachable[i] = cell.Reachable
+	}
+	return hm
+}
@@ -29,6 +29,7 @@ package simulation
 import (
 	"bufio"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
@@ -43,6 +44,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/openthread/ot-ns/dispatcher"
+	"github.com/openthread/ot-ns/event"
 	"github.com/openthread/ot-ns/logger"
 	"github.com/openthread/ot-ns/otoutfilter"
 	"github.com/openthread/ot-ns/radiomodel"
@@ -74,6 +76,7 @@ type Node struct {
 	Logger       *logger.NodeLogger
 	cfg          *NodeConfig
 	cmd          *exec.Cmd
+	rcpCmd       *exec.Cmd   // RCP process of an RCP node, of which cmd is the host process; nil otherwise.
 	cmdErr       error       // store the last CLI command error; nil if none.
 	pendingLines chan string // OT node CLI output lines, pending processing.
 	pipeIn       io.WriteCloser
@@ -81,6 +84,13 @@ type Node struct {
 	pipeErr      io.ReadCloser
 	uartReader   chan []byte
 	uartType     NodeUartType
+
+	restartPolicy      RestartPolicy
+	startTime          uint64   // unit: us; simulation time of the last start of the node process.
+	crashCount         int      // number of unexpected exits of the node process.
+	consecutiveCrashes int      // number of crashes, each within MaxBackoff of the previous restart.
+	stderrLines        []string // last stderr lines of the node process that exited unexpectedly.
+	exited             bool     // true if the exit of the node process was handled.
 }
 
 func newNode(s *Simulation, nodeid NodeId, cfg *NodeConfig, dnode *dispatcher.Node) (*Node, error) {
@@ -106,10 +116,15 @@ func newNode(s *Simulation, nodeid NodeId, cfg *NodeConfig, dnode *dispatcher.No
 		pendingLines: make(chan string, 10000),
 		uartType:     NodeUartTypeUndefined,
 		uartReader:   make(chan []byte, 10000),
+
+		restartPolicy: DefaultRestartPolicy(),
 	}
 	node.Logger.Debugf("Node config: IsMtd=%t IsRouter=%t IsBR=%t RxOffWhenIdle=%t", cfg.IsMtd, cfg.IsRouter,
 		cfg.IsBorderRouter, cfg.RxOffWhenIdle)
 	node.Logger.Debugf("  exe path: %s", cfg.ExecutablePath)
+	if cfg.IsRcp {
+		node.Logger.Debugf("  RCP exe path: %s", cfg.RcpExecutablePath)
+	}
 	node.Logger.Debugf("  position: (%d,%d)", cfg.X, cfg.Y)
 
 	if node.pipeIn, err = cmd.StdinPipe(); err != nil {
@@ -124,15 +139,64 @@ func newNode(s *Simulation, nodeid NodeId, cfg *NodeConfig, dnode *dispatcher.No
 		return nil, err
 	}
 
+	if cfg.IsRcp {
+		if err = node.startRcp(); err != nil {
+			return nil, err
+		}
+		// the host is started once the RCP has connected, which is how the dispatcher tells these apart.
+		if err = s.d.WaitRcpConnected(nodeid); err != nil {
+			node.exitRcp()
+			return nil, err
+		}
+	}
+
 	if err = cmd.Start(); err != nil {
+		node.exitRcp()
 		return nil, err
 	}
 
-	go node.lineReaderStdErr(node.pipeErr) // reads StdErr output from OT node exe and acts on failures
+	go node.lineReaderStdErr(node.pipeErr, "StdErr") // reads StdErr output from OT node exe and acts on failures
 
 	return node, nil
 }
 
+// startRcp launches the RCP process of an RCP node. It connects to the simulator just like the host process,
+// which then talks to it through the simulator.
+func (node *Node) startRcp() error {
+	rcpCmd := exec.CommandContext(context.Background(), node.cfg.RcpExecutablePath, strconv.Itoa(node.Id),
+		node.S.d.GetUnixSocketName())
+	rcpPipeErr, err := rcpCmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err = rcpCmd.Start(); err != nil {
+		return err
+	}
+	node.rcpCmd = rcpCmd
+	go node.lineReaderStdErr(rcpPipeErr, "RCP StdErr")
+	return nil
+}
+
+// exitRcp stops the RCP process of an RCP node, if any.
+func (node *Node) exitRcp() {
+	if node.rcpCmd == nil || node.rcpCmd.ProcessState != nil {
+		return
+	}
+	_ = node.rcpCmd.Process.Signal(syscall.SIGTERM)
+	done := make(chan struct{})
+	go func() {
+		_ = node.rcpCmd.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(NodeExitTimeout):
+		node.Logger.Warn("RCP did not exit in time, sending SIGKILL.")
+		_ = node.rcpCmd.Process.Kill()
+		<-done
+	}
+}
+
 func (node *Node) String() string {
 	return GetNodeName(node.Id)
 }
@@ -159,9 +223,15 @@ func (node *Node) runInitScript(cfg []string) error {
 }
 
 func (node *Node) onStart() {
+	networkKey := node.GetNetworkKey()
 	node.Logger.Infof("started, panid=0x%04x, chan=%d, eui64=%#v, extaddr=%#v, state=%s, key=%#v, mode=%v",
 		node.GetPanid(), node.GetChannel(), node.GetEui64(), node.GetExtAddr(), node.GetState(),
-		node.GetNetworkKey(), node.GetMode())
+		networkKey, node.GetMode())
+
+	// let the dispatcher decrypt the frames of the node's network.
+	if key, err := hex.DecodeString(networkKey); err == nil {
+		node.S.d.AddNetworkKey(key, uint32(node.GetKeySequenceCounter()))
+	}
 }
 
 func (node *Node) IsFED() bool {
@@ -169,10 +239,17 @@ func (node *Node) IsFED() bool {
 }
 
 func (node *Node) SignalExit() error {
+	if node.rcpCmd != nil {
+		_ = node.rcpCmd.Process.Signal(syscall.SIGTERM)
+	}
 	return node.cmd.Process.Signal(syscall.SIGTERM)
 }
 
 func (node *Node) Exit() error {
+	node.exitRcp()
+	if node.cmd.ProcessState != nil { // process already exited, and was waited for.
+		return nil
+	}
 	_ = node.cmd.Process.Signal(syscall.SIGTERM)
 
 	// Pipes are closed to allow cmd.Wait() to be successful and not hang.
@@ -356,6 +433,10 @@ func (node *Node) SetChannel(ch int) {
 }
 
 func (node *Node) GetRxSensitivity() int {
+	if !node.DNode.HasCapability(event.CapRxSensitivity) {
+		// the last Rx sensitivity reported in a RadioState event.
+		return int(node.DNode.RadioNode.RxSensitivity)
+	}
 	err := node.DNode.SendRxSensitivityEvent(false, 0)
 	if err != nil {
 		return int(radiomodel.RssiInvalid)
@@ -775,36 +856,51 @@ loop:
 	}
 }
 
-func (node *Node) onProcessFailure(err error) {
-	node.Logger.Errorf("node process failed")
-	node.S.PostAsync(func() {
-		if node.S.ctx.Err() == nil {
-			logger.Warnf("Deleting node %v due to process failure.", node.Id)
-			_ = node.S.DeleteNode(node.Id)
-		}
-	})
-}
-
-func (node *Node) lineReaderStdErr(reader io.Reader) {
+func (node *Node) lineReaderStdErr(reader io.Reader, prefix string) {
 	scanner := bufio.NewScanner(bufio.NewReader(reader)) // no filter applied.
 	scanner.Split(bufio.ScanLines)
 
 	var errProc error = nil
+	var lastLines []string
 	for scanner.Scan() {
 		line := scanner.Text()
-		stderrLine := fmt.Sprintf("StdErr: %s", line)
+		stderrLine := fmt.Sprintf("%s: %s", prefix, line)
 
 		// mark the first error output line of the node
 		if errProc == nil {
 			errProc = errors.New(stderrLine)
 		}
 		node.Logger.Error(errProc)
-	}
 
-	// when the stderr of the node closes and any error was raised, inform simulation of node's failure.
-	if errProc != nil {
-		node.onProcessFailure(errProc)
+		lastLines = append(lastLines, line)
+		if len(lastLines) > maxStderrLines {
+			lastLines = lastLines[1:]
+		}
 	}
+
+	// when the stderr of the node closes, the node (or RCP) process has ended. Let the simulation check if it
+	// was expected.
+	node.S.PostAsync(func() {
+		node.S.onNodeProcessExit(node, lastLines)
+	})
+}
+
+func (node *Node) GetRestartPolicy() RestartPolicy {
+	return node.restartPolicy
+}
+
+func (node *Node) SetRestartPolicy(policy RestartPolicy) {
+	node.restartPolicy = policy
+}
+
+// GetCrashCount returns the number of times the node process exited unexpectedly.
+func (node *Node) GetCrashCount() int {
+	return node.crashCount
+}
+
+// GetLastStderrLines returns the last stderr output lines of the most recent unexpected exit of the node process.
+func (node *Node) GetLastStderrLines() []string {
+	return node.stderrLines
 }
 
 // readLine attempts to read a line from the node. Returns false when the node is asleep and
@@ -819,7 +915,7 @@ func (node *Node) readLine() (string, bool) {
 			return "", false
 		case readLine := <-node.pendingLines:
 			if len(readLine) > 0 {
-				node.Logger.Tracef("UART: %s", readLine)
+				node.Logger.Uart(readLine)
 			}
 			return readLine, true
 		default:
@@ -848,7 +944,7 @@ func (node *Node) expectLine(line interface{}, timeout time.Duration) ([]string,
 			return outputLines, err
 		case readLine := <-node.pendingLines:
 			if len(readLine) > 0 {
-				node.Logger.Tracef("UART: %s", readLine)
+				node.Logger.Uart(readLine)
 			}
 
 			outputLines = append(outputLines, readLine)
@@ -31,6 +31,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/pkg/errors"
+
 	"github.com/openthread/ot-ns/logger"
 	. "github.com/openthread/ot-ns/types"
 )
@@ -39,6 +41,8 @@ type ExecutableConfig struct {
 	Ftd         string
 	Mtd         string
 	Br          string
+	Rcp         string
+	RcpHost     string
 	SearchPaths []string
 }
 
@@ -56,6 +60,8 @@ var DefaultExecutableConfig ExecutableConfig = ExecutableConfig{
 	Ftd:         "ot-cli-ftd",
 	Mtd:         "ot-cli-ftd",
 	Br:          "ot-br.sh",
+	Rcp:         "ot-rcp",
+	RcpHost:     "ot-cli",
 	SearchPaths: []string{".", "./ot-rfsim/ot-versions"},
 }
 
@@ -68,9 +74,80 @@ func (cfg *ExecutableConfig) SearchPathsString() string {
 	return s[0:len(s)-2] + "]"
 }
 
+// NodeOptions are the options of a node to be added, as given by the CLI 'add' command or the AddNode RPC.
+// Zero values select the defaults of the new-node config of the simulation.
+type NodeOptions struct {
+	Type       string // the node type, e.g. ROUTER; ROUTER if empty.
+	Id         int    // 0 to use the next free node id.
+	X, Y       *int   // nil to auto-place the node.
+	RadioRange int
+	Executable string // name or path of the executable; overrides Version.
+	Version    string // Thread version of a prebuilt executable, as in cli.ThreadVersion.
+	Restore    bool
+	Rcp        bool
+}
+
+// NewNodeConfigFor returns the config for a new node with the given options, based on the new-node config of cfg.
+func (cfg *Config) NewNodeConfigFor(opts *NodeOptions) (*NodeConfig, error) {
+	nodeCfg := cfg.NewNodeConfig // copy current new-node config for simulation, and modify it.
+
+	nodeType := opts.Type
+	if nodeType == "" {
+		nodeType = ROUTER
+	}
+	if !isNodeType(nodeType) {
+		return nil, errors.Errorf("unknown node type: %s", opts.Type)
+	}
+	UpdateNodeConfig(&nodeCfg, nodeType)
+
+	if opts.Id < 0 {
+		return nil, errors.Errorf("invalid node id: %d", opts.Id)
+	} else if opts.Id > 0 {
+		nodeCfg.ID = opts.Id
+	}
+	if opts.X != nil {
+		nodeCfg.X = *opts.X
+		nodeCfg.IsAutoPlaced = false
+	}
+	if opts.Y != nil {
+		nodeCfg.Y = *opts.Y
+		nodeCfg.IsAutoPlaced = false
+	}
+	if opts.RadioRange < 0 {
+		return nil, errors.Errorf("invalid radio range: %d", opts.RadioRange)
+	} else if opts.RadioRange > 0 {
+		nodeCfg.RadioRange = opts.RadioRange
+	}
+
+	if opts.Executable != "" {
+		nodeCfg.ExecutablePath = cfg.ExeConfig.DetermineExecutableBasedOnExeName(opts.Executable)
+	} else if opts.Version != "" {
+		if !isThreadVersion(opts.Version) {
+			return nil, errors.Errorf("invalid Thread version: %s", opts.Version)
+		}
+		nodeCfg.ExecutablePath = GetExecutableForThreadVersion(opts.Version)
+	}
+	nodeCfg.Restore = opts.Restore
+	nodeCfg.IsRcp = opts.Rcp
+	return &nodeCfg, nil
+}
+
+func isNodeType(nodeType string) bool {
+	switch nodeType {
+	case ROUTER, REED, FTD, FED, MED, MTD, SED, SSED, BR:
+		return true
+	default:
+		return false
+	}
+}
+
+func isThreadVersion(version string) bool {
+	return strings.HasPrefix(version, "v1") && len(version) >= 3 && len(version) <= 4
+}
+
 // GetExecutableForThreadVersion gets the prebuilt executable for given Thread version string as in cli.ThreadVersion
 func GetExecutableForThreadVersion(version string) string {
-	logger.AssertTrue(strings.HasPrefix(version, "v1") && len(version) >= 3 && len(version) <= 4)
+	logger.AssertTrue(isThreadVersion(version))
 	return "ot-rfsim/ot-versions/ot-cli-ftd_" + version
 }
 
@@ -107,6 +184,9 @@ func (cfg *ExecutableConfig) DetermineExecutableBasedOnConfig(nodeCfg *NodeConfi
 	if nodeCfg.IsBorderRouter {
 		exeName = cfg.Br
 	}
+	if nodeCfg.IsRcp {
+		exeName = cfg.RcpHost
+	}
 
 	if filepath.IsAbs(exeName) {
 		return exeName
@@ -116,6 +196,15 @@ func (cfg *ExecutableConfig) DetermineExecutableBasedOnConfig(nodeCfg *NodeConfi
 	return cfg.DetermineExecutableBasedOnExeName(exeName)
 }
 
+// DetermineRcpExecutableBasedOnConfig returns the path to the RCP executable for an RCP node.
+func (cfg *ExecutableConfig) DetermineRcpExecutableBasedOnConfig(nodeCfg *NodeConfig) string {
+	logger.AssertTrue(nodeCfg.IsRcp)
+	if filepath.IsAbs(cfg.Rcp) {
+		return cfg.Rcp
+	}
+	return cfg.DetermineExecutableBasedOnExeName(cfg.Rcp)
+}
+
 func NewNodeAutoPlacer() *NodeAutoPlacer {
 	return &NodeAutoPlacer{
 		Xref:            100,
@@ -65,3 +65,54 @@ func TestDetermineExecutableBasedOnConfig(t *testing.T) {
 	exe = cfg.DetermineExecutableBasedOnConfig(&nodeCfg)
 	assert.Equal(t, "../simulation/node_config.go", exe)
 }
+
+func TestDetermineExecutableForRcpNode(t *testing.T) {
+	cfg := ExecutableConfig{
+		Ftd:         "my-ftd",
+		Mtd:         "my-mtd",
+		Br:          "br-script",
+		Rcp:         "my-rcp",
+		RcpHost:     "my-host",
+		SearchPaths: []string{"./otrfsim/path/not/found"},
+	}
+
+	nodeCfg := types.DefaultNodeConfig()
+	nodeCfg.IsRcp = true
+	assert.Equal(t, "my-host", cfg.DetermineExecutableBasedOnConfig(&nodeCfg))
+	assert.Equal(t, "my-rcp", cfg.DetermineRcpExecutableBasedOnConfig(&nodeCfg))
+
+	cfg.Rcp = "/abs/path/to/ot-rcp"
+	assert.Equal(t, "/abs/path/to/ot-rcp", cfg.DetermineRcpExecutableBasedOnConfig(&nodeCfg))
+}
+
+func TestNewNodeConfigFor(t *testing.T) {
+	cfg := DefaultConfig()
+
+	nodeCfg, err := cfg.NewNodeConfigFor(&NodeOptions{})
+	assert.Nil(t, err)
+	assert.True(t, nodeCfg.IsRouter)
+	assert.True(t, nodeCfg.IsAutoPlaced)
+	assert.Equal(t, cfg.NewNodeConfig.RadioRange, nodeCfg.RadioRange)
+
+	x := 100
+	nodeCfg, err = cfg.NewNodeConfigFor(&NodeOptions{Type: types.SED, Id: 5, X: &x, RadioRange: 200, Version: "v13", Rcp: true})
+	assert.Nil(t, err)
+	assert.True(t, nodeCfg.IsMtd)
+	assert.Equal(t, 5, nodeCfg.ID)
+	assert.Equal(t, 100, nodeCfg.X)
+	assert.False(t, nodeCfg.IsAutoPlaced)
+	assert.Equal(t, 200, nodeCfg.RadioRange)
+	assert.Equal(t, "ot-rfsim/ot-versions/ot-cli-ftd_v13", nodeCfg.ExecutablePath)
+	assert.True(t, nodeCfg.IsRcp)
+	assert.True(t, cfg.NewNodeConfig.IsRouter) // the new-node config itself is unchanged.
+
+	for _, opts := range []*NodeOptions{
+		{Type: "coordinator"},
+		{Id: -1},
+		{RadioRange: -1},
+		{Version: "2.0"},
+	} {
+		_, err = cfg.NewNodeConfigFor(opts)
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package simulation
+
+import (
+	"fmt"
+)
+
+// RestartMode determines when the OT process of a node is restarted, after it exited unexpectedly.
+type RestartMode int
+
+const (
+	// RestartNever does not restart the node; a node process that failed is deleted.
+	RestartNever RestartMode = iota
+	// RestartOnFailure restarts the node if its process failed, i.e. it wrote to stderr or had a non-zero exit status.
+	RestartOnFailure
+	// RestartAlways restarts the node whenever its process exits unexpectedly.
+	RestartAlways
+)
+
+const (
+	DefaultRestartBackoff    uint64 = 1000000  // unit: us
+	DefaultRestartMaxBackoff uint64 = 60000000 // unit: us
+	maxStderrLines                  = 5        // number of last stderr lines of a node that are kept.
+)
+
+func (m RestartMode) String() string {
+	switch m {
+	case RestartNever:
+		return "never"
+	case RestartOnFailure:
+		return "on-failure"
+	case RestartAlways:
+		return "always"
+	default:
+		return fmt.Sprintf("RestartMode(%d)", int(m))
+	}
+}
+
+// RestartPolicy is the restart policy of a node. The delay before a restart starts at Backoff, and doubles
+// for each next crash that happens within MaxBackoff of the previous restart, up to MaxBackoff.
+type RestartPolicy struct {
+	Mode       RestartMode
+	Backoff    uint64 // unit: us
+	MaxBackoff uint64 // unit: us
+}
+
+func DefaultRestartPolicy() RestartPolicy {
+	return RestartPolicy{
+		Mode:       RestartNever,
+		Backoff:    DefaultRestartBackoff,
+		MaxBackoff: DefaultRestartMaxBackoff,
+	}
+}
+
+func (p RestartPolicy) String() string {
+	if p.Mode == RestartNever {
+		return p.Mode.String()
+	}
+	return fmt.Sprintf("%s %v %v", p.Mode, float64(p.Backoff)/1e6, float64(p.MaxBackoff)/1e6)
+}
+
+// shouldRestart checks if a node process that exited unexpectedly must be restarted.
+func (p RestartPolicy) shouldRestart(isFailure bool) bool {
+	return p.Mode == RestartAlways || (p.Mode == RestartOnFailure && isFailure)
+}
+
+// restartDelay returns the delay (us) before the n-th consecutive restart, n >= 1.
+func (p RestartPolicy) restartDelay(n int) uint64 {
+	delay := p.Backoff
+	for i := 1; i < n && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package simulation
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openthread/ot-ns/logger"
+	"github.com/openthread/ot-ns/progctx"
+)
+
+func TestRestartPolicyShouldRestart(t *testing.T) {
+	p := DefaultRestartPolicy()
+	assert.False(t, p.shouldRestart(true))
+	assert.False(t, p.shouldRestart(false))
+
+	p.Mode = RestartOnFailure
+	assert.True(t, p.shouldRestart(true))
+	assert.False(t, p.shouldRestart(false))
+
+	p.Mode = RestartAlways
+	assert.True(t, p.shouldRestart(true))
+	assert.True(t, p.shouldRestart(false))
+}
+
+func TestRestartPolicyDelay(t *testing.T) {
+	p := RestartPolicy{
+		Mode:       RestartOnFailure,
+		Backoff:    1000000,
+		MaxBackoff: 10000000,
+	}
+	assert.Equal(t, uint64(1000000), p.restartDelay(1))
+	assert.Equal(t, uint64(2000000), p.restartDelay(2))
+	assert.Equal(t, uint64(8000000), p.restartDelay(4))
+	assert.Equal(t, uint64(10000000), p.restartDelay(5))
+	assert.Equal(t, uint64(10000000), p.restartDelay(100))
+
+	p.Backoff = 0
+	assert.Equal(t, uint64(0), p.restartDelay(3))
+}
+
+func TestRestartPolicyString(t *testing.T) {
+	p := DefaultRestartPolicy()
+	assert.Equal(t, "never", p.String())
+	p.Mode = RestartOnFailure
+	assert.Equal(t, "on-failure 1 60", p.String())
+}
+
+func TestRestartNodeFailure(t *testing.T) {
+	// the simulation creates its ./tmp directory in the working directory.
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+	assert.Nil(t, os.Chdir(t.TempDir()))
+	defer func() { _ = os.Chdir(wd) }()
+
+	cfg := DefaultConfig()
+	ctx := progctx.New(context.Background())
+	defer ctx.Cancel("test-end")
+	s, err := NewSimulation(ctx, cfg, nil)
+	assert.Nil(t, err)
+
+	// a crashed node, of which the restart fails because the executable doesn't exist.
+	nodeCfg := cfg.NewNodeConfig
+	nodeCfg.ID = 1
+	nodeCfg.ExecutablePath = "./ot-cli-ftd-not-found"
+	cmd := exec.Command("true")
+	_ = cmd.Run()
+	node := &Node{
+		S:             s,
+		Id:            1,
+		Logger:        logger.GetNodeLogger(cfg.Id, &nodeCfg),
+		DNode:         s.d.AddNode(1, &nodeCfg),
+		cfg:           &nodeCfg,
+		cmd:           cmd,
+		exited:        true,
+		restartPolicy: RestartPolicy{Mode: RestartOnFailure, Backoff: 1000000, MaxBackoff: 60000000},
+	}
+	s.nodes[1] = node
+
+	// the node is kept, and its restart is tried again with an increasing backoff delay.
+	for i := 1; i <= 3; i++ {
+		assert.NotNil(t, s.RestartNode(1, false))
+		assert.Same(t, node, s.nodes[1])
+		assert.True(t, node.DNode.IsFailed())
+		assert.Equal(t, i, node.crashCount)
+		assert.Equal(t, node.restartPolicy.restartDelay(i), s.pendingRestarts[1])
+	}
+
+	// until the policy gives up.
+	node.restartPolicy.Mode = RestartNever
+	assert.NotNil(t, s.RestartNode(1, false))
+	assert.Nil(t, s.nodes[1])
+	assert.Nil(t, s.d.Nodes()[1])
+}
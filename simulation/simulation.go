@@ -60,6 +60,8 @@ type Simulation struct {
 	networkInfo    visualize.NetworkInfo
 	energyAnalyser *energy.EnergyAnalyser
 	nodePlacer     *NodeAutoPlacer
+
+	pendingRestarts map[NodeId]uint64 // simulation time (us) at which a crashed node is to be restarted.
 }
 
 func NewSimulation(ctx *progctx.ProgCtx, cfg *Config, dispatcherCfg *dispatcher.Config) (*Simulation, error) {
@@ -74,6 +76,8 @@ func NewSimulation(ctx *progctx.ProgCtx, cfg *Config, dispatcherCfg *dispatcher.
 		autoGoChange: make(chan bool, 1),
 		networkInfo:  visualize.DefaultNetworkInfo(),
 		nodePlacer:   NewNodeAutoPlacer(),
+
+		pendingRestarts: map[NodeId]uint64{},
 	}
 	s.SetLogLevel(cfg.LogLevel)
 	s.networkInfo.Real = cfg.Real
@@ -127,15 +131,34 @@ func (s *Simulation) AddNode(cfg *NodeConfig) (*Node, error) {
 	if len(cfg.ExecutablePath) == 0 {
 		cfg.ExecutablePath = s.cfg.ExeConfig.DetermineExecutableBasedOnConfig(cfg)
 	}
+	if cfg.IsRcp {
+		if s.cfg.Real {
+			return nil, errors.Errorf("RCP nodes are not supported in real mode")
+		}
+		if len(cfg.RcpExecutablePath) == 0 {
+			cfg.RcpExecutablePath = s.cfg.ExeConfig.DetermineRcpExecutableBasedOnConfig(cfg)
+		}
+	}
 
 	// creation of the dispatcher and simulation nodes
 	logger.Debugf("simulation:AddNode: %+v, rawMode=%v", cfg, s.rawMode)
 	dnode := s.d.AddNode(nodeid, cfg)
+	node, err := s.startNode(cfg, dnode)
+	if err != nil && err != CommandInterruptedError {
+		s.d.DeleteNode(nodeid) // delete dispatcher node again.
+		s.nodePlacer.ReuseNextNodePosition()
+	}
+	return node, err
+}
+
+// startNode launches the OT process for the dispatcher node dnode, and performs the node's setup and init
+// script. On failure, the process is stopped again and the node is removed from the simulation nodes; the
+// dispatcher node is kept, for the caller to delete.
+func (s *Simulation) startNode(cfg *NodeConfig, dnode *dispatcher.Node) (*Node, error) {
+	nodeid := dnode.Id
 	node, err := newNode(s, nodeid, cfg, dnode)
 	if err != nil {
 		logger.Errorf("simulation add node failed: %v", err)
-		s.d.DeleteNode(nodeid) // delete dispatcher node again.
-		s.nodePlacer.ReuseNextNodePosition()
 		return nil, err
 	}
 	s.nodes[nodeid] = node
@@ -153,9 +176,7 @@ func (s *Simulation) AddNode(cfg *NodeConfig) (*Node, error) {
 
 	logger.AssertFalse(s.d.IsAlive(nodeid))
 	if !dnode.IsConnected() {
-		_ = s.DeleteNode(nodeid)
-		s.nodePlacer.ReuseNextNodePosition()
-		node.Logger.DisplayPendingLogEntries(ts)
+		_ = s.stopNode(node)
 		return nil, errors.Errorf("simulation AddNode: new node %d did not respond (evtCnt=%d)", nodeid, evtCnt)
 	}
 	node.Logger.Debugf("start setup of node (mode, init script)")
@@ -172,18 +193,117 @@ func (s *Simulation) AddNode(cfg *NodeConfig) (*Node, error) {
 	}
 
 	if err != nil {
-		node.Logger.Errorf("simulation node init failed, deleting node - %v", err)
-		_ = s.DeleteNode(node.Id)
-		s.nodePlacer.ReuseNextNodePosition()
-		node.Logger.DisplayPendingLogEntries(ts)
+		node.Logger.Errorf("simulation node init failed, stopping node - %v", err)
+		_ = s.stopNode(node)
 		return nil, err
 	}
 
+	node.startTime = s.d.CurTime
 	node.onStart()
 	node.Logger.DisplayPendingLogEntries(ts)
 	return node, err
 }
 
+// RestartNode stops the OT process of a node and starts it again, at the same position and with the same
+// settings. If wipeFlash is true, the node's flash is erased before the restart; otherwise the node restores
+// its state from flash. If the restart fails, the node's RestartPolicy determines whether the restart is
+// tried again later, or the node is deleted.
+func (s *Simulation) RestartNode(nodeid NodeId, wipeFlash bool) error {
+	node := s.nodes[nodeid]
+	if node == nil {
+		err := fmt.Errorf("node %d not found", nodeid)
+		return err
+	}
+	cfg := *node.cfg
+	cfg.X, cfg.Y = node.DNode.X, node.DNode.Y
+	cfg.IsAutoPlaced = false
+	cfg.Restore = !wipeFlash
+
+	node.Logger.Infof("restarting node process (wipe flash: %v)", wipeFlash)
+	if err := s.stopNode(node); err != nil {
+		node.Logger.Debugf("node process exit: %v", err)
+	}
+	dnode := s.d.ReplaceNode(nodeid, &cfg)
+	newNode, err := s.startNode(&cfg, dnode)
+	if newNode != nil {
+		newNode.restartPolicy = node.restartPolicy
+		newNode.crashCount = node.crashCount
+		newNode.consecutiveCrashes = node.consecutiveCrashes
+		newNode.stderrLines = node.stderrLines
+	} else if err != CommandInterruptedError {
+		node.crashCount++
+		if node.restartPolicy.shouldRestart(true) {
+			// keep the stopped node, with its restart state, until the next restart.
+			node.DNode = dnode
+			node.startTime = s.d.CurTime
+			s.nodes[nodeid] = node
+			s.scheduleRestart(node)
+		} else {
+			s.d.DeleteNode(nodeid)
+		}
+	}
+	return err
+}
+
+// postRestartNode restarts the node as a task, for use while the dispatcher is delivering events.
+func (s *Simulation) postRestartNode(nodeid NodeId, wipeFlash bool) {
+	s.PostAsync(func() {
+		if s.nodes[nodeid] == nil || s.ctx.Err() != nil {
+			return
+		}
+		if err := s.RestartNode(nodeid, wipeFlash); err != nil {
+			logger.Errorf("restart of node %d failed: %v", nodeid, err)
+		}
+	})
+}
+
+// onNodeProcessExit handles the end of a node process. If it was not stopped by the simulation, the
+// node's RestartPolicy determines whether the node is restarted.
+func (s *Simulation) onNodeProcessExit(node *Node, stderrLines []string) {
+	if s.nodes[node.Id] != node || s.IsStopping() || node.exited {
+		return // node was deleted or restarted by the simulation, or the exit was already handled.
+	}
+	node.exited = true
+
+	exitErr := node.Exit() // collects the exit status of the process.
+	isFailure := len(stderrLines) > 0 || exitErr != nil
+	node.crashCount++
+	if len(stderrLines) > 0 {
+		node.stderrLines = stderrLines
+	}
+	node.Logger.Errorf("node process exited unexpectedly (failure=%v, exit status: %v)", isFailure, exitErr)
+
+	if node.restartPolicy.shouldRestart(isFailure) {
+		s.scheduleRestart(node)
+	} else if isFailure {
+		logger.Warnf("Deleting node %v due to process failure.", node.Id)
+		_ = s.DeleteNode(node.Id)
+	}
+}
+
+// scheduleRestart schedules the restart of a crashed node, after the backoff delay of its RestartPolicy.
+// The node is failed until the restart.
+func (s *Simulation) scheduleRestart(node *Node) {
+	policy := node.restartPolicy
+	if s.d.CurTime-node.startTime > policy.MaxBackoff {
+		node.consecutiveCrashes = 0
+	}
+	node.consecutiveCrashes++
+	delay := policy.restartDelay(node.consecutiveCrashes)
+	logger.Warnf("Restarting node %v in %v s due to process exit (crash count %d).", node.Id,
+		float64(delay)/1e6, node.crashCount)
+	node.DNode.Fail() // node is not operational until its restart.
+	restartTs := s.d.CurTime + delay
+	s.pendingRestarts[node.Id] = restartTs
+	s.d.PostAsyncAt(restartTs, func() {
+		if s.pendingRestarts[node.Id] != restartTs {
+			return // node was deleted meanwhile.
+		}
+		delete(s.pendingRestarts, node.Id)
+		s.postRestartNode(node.Id, false)
+	})
+}
+
 func (s *Simulation) genNodeId() NodeId {
 	nodeid := 1
 	for s.nodes[nodeid] != nil {
@@ -320,6 +440,27 @@ func (s *Simulation) OnNodeRecover(nodeid NodeId) {
 	logger.AssertNotNil(node)
 }
 
+// OnNodeShutdown stops the OT process of a node that starts a reboot-type failure.
+// It is part of implementation of dispatcher.CallbackHandler.
+func (s *Simulation) OnNodeShutdown(nodeid NodeId) {
+	s.PostAsync(func() {
+		node := s.nodes[nodeid]
+		if node == nil || s.ctx.Err() != nil {
+			return
+		}
+		node.Logger.Infof("stopping node process for the duration of the failure")
+		if err := s.stopNodeProcess(node); err != nil {
+			node.Logger.Debugf("node process exit: %v", err)
+		}
+	})
+}
+
+// OnNodeReboot restarts the OT process of a node that recovered from a reboot-type failure.
+// It is part of implementation of dispatcher.CallbackHandler.
+func (s *Simulation) OnNodeReboot(nodeid NodeId, wipeFlash bool) {
+	s.postRestartNode(nodeid, wipeFlash)
+}
+
 // OnUartWrite notifies the simulation that a node has received some data from UART.
 // It is part of implementation of dispatcher.CallbackHandler.
 func (s *Simulation) OnUartWrite(nodeid NodeId, data []byte) {
@@ -353,6 +494,19 @@ func (s *Simulation) PostAsync(f func()) bool {
 	}
 }
 
+// Subscribe registers an EventSubscriber to be notified of network events in the simulation, and returns its
+// id for use with Unsubscribe. It may be called from any goroutine, including the simulation goroutine; the
+// subscriber is called from the simulation goroutine.
+func (s *Simulation) Subscribe(sub dispatcher.EventSubscriber) dispatcher.SubscriptionId {
+	return s.d.Subscribe(sub)
+}
+
+// Unsubscribe removes an EventSubscriber registered with Subscribe. It may be called from any goroutine,
+// including the simulation goroutine.
+func (s *Simulation) Unsubscribe(id dispatcher.SubscriptionId) {
+	s.d.Unsubscribe(id)
+}
+
 func (s *Simulation) Dispatcher() *dispatcher.Dispatcher {
 	return s.d
 }
@@ -385,14 +539,35 @@ func (s *Simulation) DeleteNode(nodeid NodeId) error {
 		err := fmt.Errorf("node %d not found", nodeid)
 		return err
 	}
-	logger.AssertFalse(s.Dispatcher().IsAlive(nodeid))
-	s.d.NotifyCommand(nodeid) // sets node alive, as we expect a NodeExit event as final one in queue.
-	err := node.Exit()
-	s.d.RecvEvents()
+	err := s.stopNode(node)
 	s.d.DeleteNode(nodeid)
-	node.Logger.DisplayPendingLogEntries(s.d.CurTime)
+	delete(s.pendingRestarts, nodeid)
+	return err
+}
+
+// stopNode stops the OT process of the node and removes it from the simulation nodes. The dispatcher
+// node is kept.
+func (s *Simulation) stopNode(node *Node) error {
+	err := s.stopNodeProcess(node)
 	node.Logger.Close()
-	delete(s.nodes, nodeid)
+	delete(s.nodes, node.Id)
+	return err
+}
+
+// stopNodeProcess stops the OT process of the node, which stays in the simulation, e.g. during a reboot-type
+// failure. The process can be started again using RestartNode.
+func (s *Simulation) stopNodeProcess(node *Node) error {
+	var err error
+	node.exited = true // the exit is expected, so it doesn't trigger the RestartPolicy.
+	if node.DNode.IsConnected() {
+		logger.AssertFalse(s.Dispatcher().IsAlive(node.Id))
+		s.d.NotifyCommand(node.Id) // sets node alive, as we expect a NodeExit event as final one in queue.
+		err = node.Exit()
+		s.d.RecvEvents()
+	} else {
+		err = node.Exit() // no connection, so no NodeExit event will follow.
+	}
+	node.Logger.DisplayPendingLogEntries(s.d.CurTime)
 	return err
 }
 
@@ -27,9 +27,15 @@
 package simulation
 
 import (
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/openthread/ot-ns/dispatcher"
+	"github.com/openthread/ot-ns/dissectpkt"
+	. "github.com/openthread/ot-ns/types"
 	"github.com/openthread/ot-ns/visualize"
+	"github.com/openthread/ot-ns/visualize/grpc/pb"
 	"github.com/pkg/errors"
 )
 
@@ -52,6 +58,312 @@ func (sc *simulationController) Command(cmd string) ([]string, error) {
 	return output, nil
 }
 
+func (sc *simulationController) FrameStats(reset bool) (*pb.FrameStatsResponse, error) {
+	sim := sc.sim
+	resp := &pb.FrameStatsResponse{}
+	err := sc.postAsyncWait(func() error {
+		for _, nodeid := range sim.GetNodes() {
+			ns := sim.d.GetNodeFrameStats(nodeid)
+			txFrameTypes := map[string]uint64{}
+			for frameType, n := range ns.TxFrameTypes {
+				if n > 0 {
+					txFrameTypes[dissectpkt.WpanFrameType(frameType).String()] = n
+				}
+			}
+			resp.Nodes = append(resp.Nodes, &pb.NodeFrameStats{
+				NodeId:       int32(nodeid),
+				TxFrames:     ns.TxFrames,
+				Tx:           convertFrameStats(&ns.Tx),
+				Rx:           convertFrameStats(&ns.Rx),
+				TxFrameTypes: txFrameTypes,
+			})
+		}
+		for _, ls := range sim.d.GetLinkFrameStats() {
+			resp.Links = append(resp.Links, &pb.LinkFrameStats{
+				SrcId: int32(ls.Src),
+				DstId: int32(ls.Dst),
+				Stats: convertFrameStats(&ls.FrameStats),
+			})
+		}
+		if reset {
+			sim.d.ResetFrameStats()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (sc *simulationController) AddNode(req *pb.AddNodeRequest) (*pb.AddNodeResponse, error) {
+	sim := sc.sim
+	opts := &NodeOptions{
+		Type:       req.Type,
+		Id:         int(req.NodeId),
+		RadioRange: int(req.RadioRange),
+		Executable: req.Executable,
+		Version:    req.Version,
+		Restore:    req.Restore,
+		Rcp:        req.Rcp,
+	}
+	if req.Position != nil {
+		x, y := int(req.Position.X), int(req.Position.Y)
+		opts.X, opts.Y = &x, &y
+	}
+	cfg, err := sim.GetConfig().NewNodeConfigFor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AddNodeResponse{}
+	err = sc.postAsyncWait(func() error {
+		node, err := sim.AddNode(cfg)
+		if err != nil {
+			return err
+		}
+		resp.NodeId = int32(node.Id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (sc *simulationController) DeleteNode(req *pb.DeleteNodeRequest) error {
+	sim := sc.sim
+	return sc.postAsyncWait(func() error {
+		for _, nodeid := range req.NodeIds {
+			if sim.Nodes()[NodeId(nodeid)] == nil {
+				return errors.Errorf("node %d not found", nodeid)
+			}
+		}
+		for _, nodeid := range req.NodeIds {
+			if err := sim.DeleteNode(NodeId(nodeid)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (sc *simulationController) MoveNode(req *pb.MoveNodeRequest) error {
+	sim := sc.sim
+	if req.Position == nil {
+		return errors.Errorf("node position not set")
+	}
+	return sc.postAsyncWait(func() error {
+		return sim.MoveNodeTo(NodeId(req.NodeId), int(req.Position.X), int(req.Position.Y))
+	})
+}
+
+func (sc *simulationController) SetRadio(req *pb.SetRadioRequest) error {
+	sim := sc.sim
+	return sc.postAsyncWait(func() error {
+		for _, nodeid := range req.NodeIds {
+			if sim.Nodes()[NodeId(nodeid)] == nil {
+				return errors.Errorf("node %d not found", nodeid)
+			}
+		}
+		for _, nodeid := range req.NodeIds {
+			sim.SetNodeFailed(NodeId(nodeid), !req.On)
+		}
+		return nil
+	})
+}
+
+func (sc *simulationController) Go(req *pb.GoRequest) (*pb.GoResponse, error) {
+	sim := sc.sim
+	if req.Speed < 0 {
+		return nil, errors.Errorf("invalid speed: %v", req.Speed)
+	}
+
+	var done <-chan error
+	err := sc.postAsyncWait(func() error {
+		speed := req.Speed
+		if speed == 0 {
+			speed = sim.GetSpeed()
+		}
+		if speed == 0 { // when paused, 'go' is used to quickly jump time.
+			speed = dispatcher.MaxSimulateSpeed
+		}
+		done = sim.GoAtSpeed(time.Duration(req.DurationUs)*time.Microsecond, speed)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = <-done; err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GoResponse{}
+	err = sc.postAsyncWait(func() error {
+		resp.TimeUs = sim.Dispatcher().CurTime
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (sc *simulationController) GetNodes() (*pb.GetNodesResponse, error) {
+	sim := sc.sim
+	resp := &pb.GetNodesResponse{}
+	err := sc.postAsyncWait(func() error {
+		for _, nodeid := range sim.GetNodes() {
+			snode := sim.Nodes()[nodeid]
+			dnode := sim.Dispatcher().GetNode(nodeid)
+			resp.Nodes = append(resp.Nodes, &pb.NodeInfo{
+				NodeId:      int32(nodeid),
+				ExtAddr:     dnode.ExtAddr,
+				Rloc16:      uint32(dnode.Rloc16),
+				Position:    &pb.NodePosition{X: int32(dnode.X), Y: int32(dnode.Y)},
+				Role:        pb.OtDeviceRole(dnode.Role),
+				PartitionId: dnode.PartitionId,
+				Failed:      dnode.IsFailed(),
+				Executable:  snode.GetExecutableName(),
+				CrashCount:  int32(snode.GetCrashCount()),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (sc *simulationController) GetPartitions() (*pb.GetPartitionsResponse, error) {
+	sim := sc.sim
+	pars := map[uint32][]int32{}
+	err := sc.postAsyncWait(func() error {
+		for _, nodeid := range sim.GetNodes() {
+			parid := sim.Dispatcher().GetNode(nodeid).PartitionId
+			pars[parid] = append(pars[parid], int32(nodeid))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetPartitionsResponse{}
+	for parid, nodeids := range pars {
+		resp.Partitions = append(resp.Partitions, &pb.Partition{
+			PartitionId: parid,
+			NodeIds:     nodeids,
+		})
+	}
+	sort.Slice(resp.Partitions, func(i, j int) bool {
+		return resp.Partitions[i].PartitionId < resp.Partitions[j].PartitionId
+	})
+	return resp, nil
+}
+
+func (sc *simulationController) GetPings() (*pb.GetPingsResponse, error) {
+	sim := sc.sim
+	resp := &pb.GetPingsResponse{}
+	err := sc.postAsyncWait(func() error {
+		for _, nodeid := range sim.GetNodes() {
+			for _, ping := range sim.Dispatcher().GetNode(nodeid).CollectPings() {
+				resp.Pings = append(resp.Pings, &pb.PingResult{
+					NodeId:   int32(nodeid),
+					Dst:      ping.Dst,
+					DataSize: int32(ping.DataSize),
+					DelayUs:  ping.Delay,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (sc *simulationController) NodeCommand(req *pb.NodeCommandRequest) (*pb.NodeCommandResponse, error) {
+	sim := sc.sim
+	resp := &pb.NodeCommandResponse{}
+	err := sc.postAsyncWait(func() (err error) {
+		node := sim.Nodes()[NodeId(req.NodeId)]
+		if node == nil {
+			return errors.Errorf("node %d not found", req.NodeId)
+		}
+
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				err = errors.Errorf("%+v", rerr)
+			}
+		}()
+
+		resp.Output = node.Command(req.Command, DefaultCommandTimeout)
+		node.DisplayPendingLogEntries()
+		return node.CommandResult()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (sc *simulationController) RssiHeatmap(req *pb.RssiHeatmapRequest) (*pb.RssiHeatmapResponse, error) {
+	sim := sc.sim
+	cellSize := int(req.CellSize)
+	if cellSize == 0 {
+		cellSize = DefaultHeatmapCellSize
+	}
+	var txIds []NodeId
+	for _, id := range req.NodeIds {
+		txIds = append(txIds, NodeId(id))
+	}
+
+	resp := &pb.RssiHeatmapResponse{}
+	err := sc.postAsyncWait(func() error {
+		heatmap, err := sim.RssiHeatmap(txIds, cellSize)
+		if err != nil {
+			return err
+		}
+		if req.Show {
+			sim.ShowRssiHeatmap(heatmap)
+		}
+		resp.Heatmap = rssiHeatmapToPb(heatmap)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// postAsyncWait runs f in the simulation goroutine, and waits until it is done.
+func (sc *simulationController) postAsyncWait(f func() error) error {
+	var err error
+	done := make(chan struct{})
+	if !sc.sim.PostAsync(func() {
+		defer close(done)
+		err = f()
+	}) {
+		return CommandInterruptedError
+	}
+	<-done
+	return err
+}
+
+func convertFrameStats(fs *dispatcher.FrameStats) *pb.FrameStats {
+	stats := &pb.FrameStats{
+		Delivered: fs.Delivered,
+		Dropped:   map[string]uint64{},
+	}
+	for reason, n := range fs.Dropped {
+		stats.Dropped[dispatcher.FrameDropReason(reason).String()] = n
+	}
+	return stats
+}
+
 type readonlySimulationController struct {
 }
 
@@ -61,6 +373,50 @@ func (r readonlySimulationController) Command(cmd string) (output []string, err
 	return nil, readonlySimulationError
 }
 
+func (r readonlySimulationController) FrameStats(reset bool) (*pb.FrameStatsResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) AddNode(req *pb.AddNodeRequest) (*pb.AddNodeResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) DeleteNode(req *pb.DeleteNodeRequest) error {
+	return readonlySimulationError
+}
+
+func (r readonlySimulationController) MoveNode(req *pb.MoveNodeRequest) error {
+	return readonlySimulationError
+}
+
+func (r readonlySimulationController) SetRadio(req *pb.SetRadioRequest) error {
+	return readonlySimulationError
+}
+
+func (r readonlySimulationController) Go(req *pb.GoRequest) (*pb.GoResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) GetNodes() (*pb.GetNodesResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) GetPartitions() (*pb.GetPartitionsResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) GetPings() (*pb.GetPingsResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) NodeCommand(req *pb.NodeCommandRequest) (*pb.NodeCommandResponse, error) {
+	return nil, readonlySimulationError
+}
+
+func (r readonlySimulationController) RssiHeatmap(req *pb.RssiHeatmapRequest) (*pb.RssiHeatmapResponse, error) {
+	return nil, readonlySimulationError
+}
+
 func NewSimulationController(sim *Simulation) visualize.SimulationController {
 	if !sim.cfg.ReadOnly {
 		return &simulationController{sim}
@@ -29,35 +29,39 @@ package types
 // NodeConfig is a generic config for a new simulated node (used in dispatcher, simulation, radiomodel,
 // ... packages).
 type NodeConfig struct {
-	ID             int
-	X, Y           int
-	IsAutoPlaced   bool
-	IsMtd          bool
-	IsRouter       bool
-	IsBorderRouter bool
-	RxOffWhenIdle  bool
-	NodeLogFile    bool
-	RadioRange     int
-	ExecutablePath string
-	Restore        bool
-	InitScript     []string
+	ID                int
+	X, Y              int
+	IsAutoPlaced      bool
+	IsMtd             bool
+	IsRouter          bool
+	IsBorderRouter    bool
+	IsRcp             bool
+	RxOffWhenIdle     bool
+	NodeLogFile       bool
+	RadioRange        int
+	ExecutablePath    string
+	RcpExecutablePath string
+	Restore           bool
+	InitScript        []string
 }
 
 func DefaultNodeConfig() NodeConfig {
 	return NodeConfig{
-		ID:             -1, // -1 for the next available nodeid
-		X:              0,
-		Y:              0,
-		IsAutoPlaced:   true,
-		IsRouter:       true,
-		IsMtd:          false,
-		IsBorderRouter: false,
-		RxOffWhenIdle:  false,
-		NodeLogFile:    true,
-		RadioRange:     220,
-		ExecutablePath: "",
-		Restore:        false,
-		InitScript:     nil,
+		ID:                -1, // -1 for the next available nodeid
+		X:                 0,
+		Y:                 0,
+		IsAutoPlaced:      true,
+		IsRouter:          true,
+		IsMtd:             false,
+		IsBorderRouter:    false,
+		IsRcp:             false,
+		RxOffWhenIdle:     false,
+		NodeLogFile:       true,
+		RadioRange:        220,
+		ExecutablePath:    "",
+		RcpExecutablePath: "",
+		Restore:           false,
+		InitScript:        nil,
 	}
 }
 
@@ -26,6 +26,32 @@
 
 package visualize
 
+import "github.com/openthread/ot-ns/visualize/grpc/pb"
+
 type SimulationController interface {
 	Command(cmd string) ([]string, error)
+
+	// FrameStats gets the per-node and per-link radio frame statistics, and optionally resets these.
+	FrameStats(reset bool) (*pb.FrameStatsResponse, error)
+
+	// AddNode adds a node and returns its node id.
+	AddNode(req *pb.AddNodeRequest) (*pb.AddNodeResponse, error)
+	// DeleteNode deletes nodes.
+	DeleteNode(req *pb.DeleteNodeRequest) error
+	// MoveNode moves a node.
+	MoveNode(req *pb.MoveNodeRequest) error
+	// SetRadio switches the radio of nodes on or off.
+	SetRadio(req *pb.SetRadioRequest) error
+	// Go runs the simulation for a duration, and returns when done.
+	Go(req *pb.GoRequest) (*pb.GoResponse, error)
+	// GetNodes gets the state of all nodes.
+	GetNodes() (*pb.GetNodesResponse, error)
+	// GetPartitions gets the nodes of each Thread partition.
+	GetPartitions() (*pb.GetPartitionsResponse, error)
+	// GetPings collects the ping results of all nodes.
+	GetPings() (*pb.GetPingsResponse, error)
+	// NodeCommand runs an OpenThread CLI command on a node.
+	NodeCommand(req *pb.NodeCommandRequest) (*pb.NodeCommandResponse, error)
+	// RssiHeatmap computes the RSSI and SINR heatmap of nodes, and optionally shows it in the visualization.
+	RssiHeatmap(req *pb.RssiHeatmapRequest) (*pb.RssiHeatmapResponse, error)
 }
@@ -117,6 +117,10 @@ func (f *grpcField) onExtAddrChange(id NodeId, extaddr uint64) {
 	f.nodes[id].extaddr = extaddr
 }
 
+func (f *grpcField) setCustomStatus(id NodeId, key string, value string) {
+	f.nodes[id].customStatus[key] = value
+}
+
 func (f *grpcField) setTitleInfo(info visualize.TitleInfo) {
 	f.titleInfo = info
 }
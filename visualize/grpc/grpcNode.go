@@ -32,36 +32,38 @@ import (
 )
 
 type grpcNode struct {
-	nodeid      NodeId
-	extaddr     uint64
-	x           int
-	y           int
-	radioRange  int
-	mode        NodeMode
-	rloc16      uint16
-	role        OtDeviceRole
-	partitionId uint32
-	failed      bool
-	parent      uint64
-	routerTable map[uint64]struct{}
-	childTable  map[uint64]struct{}
+	nodeid       NodeId
+	extaddr      uint64
+	x            int
+	y            int
+	radioRange   int
+	mode         NodeMode
+	rloc16       uint16
+	role         OtDeviceRole
+	partitionId  uint32
+	failed       bool
+	parent       uint64
+	routerTable  map[uint64]struct{}
+	childTable   map[uint64]struct{}
+	customStatus map[string]string
 }
 
 func newGprcNode(id NodeId, x int, y int, radioRange int) *grpcNode {
 	gn := &grpcNode{
-		nodeid:      id,
-		extaddr:     InvalidExtAddr,
-		x:           x,
-		y:           y,
-		radioRange:  radioRange,
-		mode:        DefaultNodeMode(),
-		rloc16:      threadconst.InvalidRloc16,
-		role:        OtDeviceRoleDisabled,
-		partitionId: 0,
-		failed:      false,
-		parent:      0,
-		routerTable: map[uint64]struct{}{},
-		childTable:  map[uint64]struct{}{},
+		nodeid:       id,
+		extaddr:      InvalidExtAddr,
+		x:            x,
+		y:            y,
+		radioRange:   radioRange,
+		mode:         DefaultNodeMode(),
+		rloc16:       threadconst.InvalidRloc16,
+		role:         OtDeviceRoleDisabled,
+		partitionId:  0,
+		failed:       false,
+		parent:       0,
+		routerTable:  map[uint64]struct{}{},
+		childTable:   map[uint64]struct{}{},
+		customStatus: map[string]string{},
 	}
 	return gn
 }
@@ -29,9 +29,11 @@ package visualize_grpc
 import (
 	"context"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/openthread/ot-ns/logger"
+	. "github.com/openthread/ot-ns/types"
 	"google.golang.org/grpc"
 
 	"github.com/openthread/ot-ns/visualize/grpc/pb"
@@ -43,6 +45,8 @@ type grpcServer struct {
 	address                  string
 	visualizingStreams       map[*grpcStream]struct{}
 	visualizingEnergyStreams map[*grpcEnergyStream]struct{}
+	nodeLogStreams           map[*grpcNodeLogStream]struct{}
+	nodeLogStreamsLock       sync.Mutex
 }
 
 func (gs *grpcServer) Visualize(req *pb.VisualizeRequest, stream pb.VisualizeGrpcService_VisualizeServer) error {
@@ -103,9 +107,14 @@ func (gs *grpcServer) EnergyReport(req *pb.VisualizeRequest, stream pb.Visualize
 
 	energyHist := gs.vis.energyAnalyser.GetNetworkEnergyHistory()
 	energyHistByNodes := gs.vis.energyAnalyser.GetEnergyHistoryByNodes()
+	gs.vis.Lock()
 	for i := 0; i < len(energyHistByNodes); i++ {
-		gs.vis.UpdateNodesEnergy(energyHistByNodes[i], energyHist[i].Timestamp, ((i + 1) == len(energyHistByNodes)))
+		gs.vis.sendNodesEnergy(&pb.NetworkEnergyEvent{
+			Timestamp:   energyHist[i].Timestamp / 1000000, // convert to s
+			NodesEnergy: energyHistByNodes[i],
+		}, (i+1) == len(energyHistByNodes))
 	}
+	gs.vis.Unlock()
 
 	//Wait for the first event
 	<-contextDone
@@ -122,6 +131,91 @@ func (gs *grpcServer) Command(ctx context.Context, req *pb.CommandRequest) (*pb.
 	}, err
 }
 
+func (gs *grpcServer) FrameStats(ctx context.Context, req *pb.FrameStatsRequest) (*pb.FrameStatsResponse, error) {
+	return gs.vis.simctrl.FrameStats(req.ResetStats)
+}
+
+func (gs *grpcServer) AddNode(ctx context.Context, req *pb.AddNodeRequest) (*pb.AddNodeResponse, error) {
+	return gs.vis.simctrl.AddNode(req)
+}
+
+func (gs *grpcServer) DeleteNode(ctx context.Context, req *pb.DeleteNodeRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, gs.vis.simctrl.DeleteNode(req)
+}
+
+func (gs *grpcServer) MoveNode(ctx context.Context, req *pb.MoveNodeRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, gs.vis.simctrl.MoveNode(req)
+}
+
+func (gs *grpcServer) SetRadio(ctx context.Context, req *pb.SetRadioRequest) (*pb.Empty, error) {
+	return &pb.Empty{}, gs.vis.simctrl.SetRadio(req)
+}
+
+func (gs *grpcServer) Go(ctx context.Context, req *pb.GoRequest) (*pb.GoResponse, error) {
+	return gs.vis.simctrl.Go(req)
+}
+
+func (gs *grpcServer) GetNodes(ctx context.Context, req *pb.Empty) (*pb.GetNodesResponse, error) {
+	return gs.vis.simctrl.GetNodes()
+}
+
+func (gs *grpcServer) GetPartitions(ctx context.Context, req *pb.Empty) (*pb.GetPartitionsResponse, error) {
+	return gs.vis.simctrl.GetPartitions()
+}
+
+func (gs *grpcServer) GetPings(ctx context.Context, req *pb.Empty) (*pb.GetPingsResponse, error) {
+	return gs.vis.simctrl.GetPings()
+}
+
+func (gs *grpcServer) NodeCommand(ctx context.Context, req *pb.NodeCommandRequest) (*pb.NodeCommandResponse, error) {
+	return gs.vis.simctrl.NodeCommand(req)
+}
+
+func (gs *grpcServer) RssiHeatmap(ctx context.Context, req *pb.RssiHeatmapRequest) (*pb.RssiHeatmapResponse, error) {
+	return gs.vis.simctrl.RssiHeatmap(req)
+}
+
+func (gs *grpcServer) NodeLogs(req *pb.NodeLogsRequest, stream pb.VisualizeGrpcService_NodeLogsServer) error {
+	gstream := newGrpcNodeLogStream(stream, req)
+	logger.Debugf("New node logs request got.")
+
+	gs.nodeLogStreamsLock.Lock()
+	gs.nodeLogStreams[gstream] = struct{}{}
+	gs.updateNodeLogCallback()
+	gs.nodeLogStreamsLock.Unlock()
+
+	defer gs.disposeNodeLogStream(gstream)
+
+	<-stream.Context().Done()
+	err := stream.Context().Err()
+
+	logger.Debugf("node logs stream exit: %v", err)
+	return err
+}
+
+// OnNodeLog sends a node log entry to the node log streams it matches. It is called by the logger.
+func (gs *grpcServer) OnNodeLog(ts uint64, nodeid NodeId, level logger.Level, isUart bool, msg string) {
+	gs.nodeLogStreamsLock.Lock()
+	defer gs.nodeLogStreamsLock.Unlock()
+
+	var entry *pb.NodeLogEntry
+	for stream := range gs.nodeLogStreams {
+		if !stream.isMatch(nodeid, level, isUart) {
+			continue
+		}
+		if entry == nil {
+			entry = &pb.NodeLogEntry{
+				Timestamp: ts,
+				NodeId:    int32(nodeid),
+				Level:     logger.GetLevelString(level),
+				Msg:       msg,
+				Uart:      isUart,
+			}
+		}
+		_ = stream.Send(entry)
+	}
+}
+
 func (gs *grpcServer) Run() error {
 	lis, err := net.Listen("tcp", gs.address)
 	if err != nil {
@@ -164,6 +258,29 @@ func (gs *grpcServer) disposeEnergyStream(stream *grpcEnergyStream) {
 	stream.close()
 }
 
+func (gs *grpcServer) disposeNodeLogStream(stream *grpcNodeLogStream) {
+	gs.nodeLogStreamsLock.Lock()
+	delete(gs.nodeLogStreams, stream)
+	gs.updateNodeLogCallback()
+	gs.nodeLogStreamsLock.Unlock()
+}
+
+// updateNodeLogCallback registers the server for the node log entries of the levels needed by the node log streams,
+// or unregisters it if there are none. It is called with nodeLogStreamsLock held.
+func (gs *grpcServer) updateNodeLogCallback() {
+	if len(gs.nodeLogStreams) == 0 {
+		logger.SetNodeLogCallback(nil, logger.OffLevel)
+		return
+	}
+	level := logger.OffLevel
+	for stream := range gs.nodeLogStreams {
+		if stream.maxLevel() > level {
+			level = stream.maxLevel()
+		}
+	}
+	logger.SetNodeLogCallback(gs, level)
+}
+
 func (gs *grpcServer) prepareStream(stream *grpcStream) error {
 	return gs.vis.prepareStream(stream)
 }
@@ -176,6 +293,7 @@ func newGrpcServer(vis *grpcVisualizer, address string) *grpcServer {
 		address:                  address,
 		visualizingStreams:       map[*grpcStream]struct{}{},
 		visualizingEnergyStreams: map[*grpcEnergyStream]struct{}{},
+		nodeLogStreams:           map[*grpcNodeLogStream]struct{}{},
 	}
 	pb.RegisterVisualizeGrpcServiceServer(server, gs)
 	return gs
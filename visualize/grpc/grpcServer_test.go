@@ -27,11 +27,17 @@
 package visualize_grpc
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/openthread/ot-ns/logger"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	. "github.com/openthread/ot-ns/types"
+	"github.com/openthread/ot-ns/visualize/grpc/pb"
 )
 
 func TestStartStopServer(t *testing.T) {
@@ -74,3 +80,191 @@ func TestStopBeforeStart(t *testing.T) {
 	assert.NotNil(t, err, "expected Run() error but got nil")
 	logger.Infof("Run() err returned: %v", err)
 }
+
+type testSimulationController struct {
+	requests []interface{}
+}
+
+func (sc *testSimulationController) Command(cmd string) ([]string, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+func (sc *testSimulationController) FrameStats(reset bool) (*pb.FrameStatsResponse, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+func (sc *testSimulationController) AddNode(req *pb.AddNodeRequest) (*pb.AddNodeResponse, error) {
+	sc.requests = append(sc.requests, req)
+	if req.Type == "unknown" {
+		return nil, errors.Errorf("unknown node type: %s", req.Type)
+	}
+	return &pb.AddNodeResponse{NodeId: 5}, nil
+}
+
+func (sc *testSimulationController) DeleteNode(req *pb.DeleteNodeRequest) error {
+	sc.requests = append(sc.requests, req)
+	return nil
+}
+
+func (sc *testSimulationController) MoveNode(req *pb.MoveNodeRequest) error {
+	sc.requests = append(sc.requests, req)
+	return errors.Errorf("node %d not found", req.NodeId)
+}
+
+func (sc *testSimulationController) SetRadio(req *pb.SetRadioRequest) error {
+	sc.requests = append(sc.requests, req)
+	return nil
+}
+
+func (sc *testSimulationController) Go(req *pb.GoRequest) (*pb.GoResponse, error) {
+	sc.requests = append(sc.requests, req)
+	return &pb.GoResponse{TimeUs: req.DurationUs}, nil
+}
+
+func (sc *testSimulationController) GetNodes() (*pb.GetNodesResponse, error) {
+	return &pb.GetNodesResponse{Nodes: []*pb.NodeInfo{{NodeId: 1, Role: pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER}}}, nil
+}
+
+func (sc *testSimulationController) GetPartitions() (*pb.GetPartitionsResponse, error) {
+	return &pb.GetPartitionsResponse{Partitions: []*pb.Partition{{PartitionId: 0x1234, NodeIds: []int32{1, 2}}}}, nil
+}
+
+func (sc *testSimulationController) GetPings() (*pb.GetPingsResponse, error) {
+	return &pb.GetPingsResponse{}, nil
+}
+
+func (sc *testSimulationController) NodeCommand(req *pb.NodeCommandRequest) (*pb.NodeCommandResponse, error) {
+	sc.requests = append(sc.requests, req)
+	return &pb.NodeCommandResponse{Output: []string{"leader"}}, nil
+}
+
+func (sc *testSimulationController) RssiHeatmap(req *pb.RssiHeatmapRequest) (*pb.RssiHeatmapResponse, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+func TestControlApi(t *testing.T) {
+	simctrl := &testSimulationController{}
+	vis := &grpcVisualizer{
+		simctrl: simctrl,
+		f:       newGrpcField(),
+	}
+	srv := newGrpcServer(vis, "localhost:8997")
+	ctx := context.Background()
+
+	addResp, err := srv.AddNode(ctx, &pb.AddNodeRequest{Type: "med", Position: &pb.NodePosition{X: 100, Y: 200}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), addResp.NodeId)
+	_, err = srv.AddNode(ctx, &pb.AddNodeRequest{Type: "unknown"})
+	assert.NotNil(t, err)
+
+	_, err = srv.DeleteNode(ctx, &pb.DeleteNodeRequest{NodeIds: []int32{1, 2}})
+	assert.Nil(t, err)
+	_, err = srv.MoveNode(ctx, &pb.MoveNodeRequest{NodeId: 3, Position: &pb.NodePosition{X: 1, Y: 2}})
+	assert.Equal(t, "node 3 not found", err.Error())
+	_, err = srv.SetRadio(ctx, &pb.SetRadioRequest{NodeIds: []int32{1}, On: false})
+	assert.Nil(t, err)
+
+	goResp, err := srv.Go(ctx, &pb.GoRequest{DurationUs: 1000000})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1000000), goResp.TimeUs)
+
+	nodesResp, err := srv.GetNodes(ctx, &pb.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER, nodesResp.Nodes[0].Role)
+	parsResp, err := srv.GetPartitions(ctx, &pb.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{1, 2}, parsResp.Partitions[0].NodeIds)
+
+	cmdResp, err := srv.NodeCommand(ctx, &pb.NodeCommandRequest{NodeId: 1, Command: "state"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"leader"}, cmdResp.Output)
+
+	assert.Equal(t, 7, len(simctrl.requests))
+	assert.Equal(t, "med", simctrl.requests[0].(*pb.AddNodeRequest).Type)
+	assert.Equal(t, []int32{1, 2}, simctrl.requests[2].(*pb.DeleteNodeRequest).NodeIds)
+	assert.Equal(t, "state", simctrl.requests[6].(*pb.NodeCommandRequest).Command)
+}
+
+type testNodeLogsServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	entries []*pb.NodeLogEntry
+}
+
+func (s *testNodeLogsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testNodeLogsServer) Send(entry *pb.NodeLogEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestNodeLogs(t *testing.T) {
+	srv := newGrpcServer(&grpcVisualizer{f: newGrpcField()}, "localhost:8997")
+
+	allStream := &testNodeLogsServer{}
+	uartStream := &testNodeLogsServer{}
+	all := newGrpcNodeLogStream(allStream, &pb.NodeLogsRequest{Level: "debug"})
+	uart := newGrpcNodeLogStream(uartStream, &pb.NodeLogsRequest{NodeIds: []int32{2}, Level: "warn", Uart: true})
+	assert.Equal(t, logger.DebugLevel, all.maxLevel())
+	assert.Equal(t, logger.TraceLevel, uart.maxLevel())
+
+	srv.nodeLogStreams[all] = struct{}{}
+	srv.nodeLogStreams[uart] = struct{}{}
+	srv.OnNodeLog(10, 1, logger.InfoLevel, false, "info 1")
+	srv.OnNodeLog(20, 2, logger.ErrorLevel, false, "error 2")
+	srv.OnNodeLog(30, 2, logger.TraceLevel, false, "trace 2")
+	srv.OnNodeLog(40, 2, logger.TraceLevel, true, "leader")
+	srv.OnNodeLog(50, 1, logger.TraceLevel, true, "router")
+
+	assert.Equal(t, 2, len(allStream.entries))
+	assert.Equal(t, "info 1", allStream.entries[0].Msg)
+	assert.Equal(t, "info", allStream.entries[0].Level)
+	assert.Equal(t, uint64(20), allStream.entries[1].Timestamp)
+
+	assert.Equal(t, 2, len(uartStream.entries))
+	assert.Equal(t, "error 2", uartStream.entries[0].Msg)
+	assert.Equal(t, "leader", uartStream.entries[1].Msg)
+	assert.Equal(t, int32(2), uartStream.entries[1].NodeId)
+	assert.True(t, uartStream.entries[1].Uart)
+}
+
+func TestNodeLogsStreamOpenClose(t *testing.T) {
+	srv := newGrpcServer(&grpcVisualizer{f: newGrpcField()}, "localhost:8997")
+	nl := logger.GetNodeLogger(0, &NodeConfig{ID: 1})
+
+	// emit node logs as the simulation goroutine does, while streams come and go.
+	done := make(chan struct{})
+	emitted := make(chan struct{})
+	go func() {
+		defer close(emitted)
+		for ts := uint64(0); ; ts++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			nl.Uart("uart line")
+			nl.Tracef("trace %d", ts)
+			nl.DisplayPendingLogEntries(ts)
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		exited := make(chan error)
+		go func() {
+			exited <- srv.NodeLogs(&pb.NodeLogsRequest{Level: "trace", Uart: true}, &testNodeLogsServer{ctx: ctx})
+		}()
+		time.Sleep(time.Millisecond)
+		cancel()
+		assert.Equal(t, context.Canceled, <-exited)
+	}
+	close(done)
+	<-emitted
+
+	srv.nodeLogStreamsLock.Lock()
+	assert.Equal(t, 0, len(srv.nodeLogStreams))
+	srv.nodeLogStreamsLock.Unlock()
+}
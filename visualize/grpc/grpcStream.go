@@ -26,7 +26,11 @@
 
 package visualize_grpc
 
-import pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+import (
+	"github.com/openthread/ot-ns/logger"
+	. "github.com/openthread/ot-ns/types"
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
 
 type grpcStream struct {
 	pb.VisualizeGrpcService_VisualizeServer
@@ -36,6 +40,13 @@ type grpcEnergyStream struct {
 	pb.VisualizeGrpcService_EnergyReportServer
 }
 
+type grpcNodeLogStream struct {
+	pb.VisualizeGrpcService_NodeLogsServer
+	nodeIds map[NodeId]struct{}
+	level   logger.Level
+	uart    bool
+}
+
 func (gst *grpcStream) close() {
 }
 
@@ -55,3 +66,37 @@ func newGrpcEnergyStream(stream pb.VisualizeGrpcService_EnergyReportServer) *grp
 	}
 	return gst
 }
+
+func newGrpcNodeLogStream(stream pb.VisualizeGrpcService_NodeLogsServer, req *pb.NodeLogsRequest) *grpcNodeLogStream {
+	gst := &grpcNodeLogStream{
+		VisualizeGrpcService_NodeLogsServer: stream,
+		nodeIds:                             map[NodeId]struct{}{},
+		level:                               logger.ParseLevelString(req.Level),
+		uart:                                req.Uart,
+	}
+	for _, nodeid := range req.NodeIds {
+		gst.nodeIds[NodeId(nodeid)] = struct{}{}
+	}
+	return gst
+}
+
+// maxLevel returns the maximum log level of the entries streamed.
+func (gst *grpcNodeLogStream) maxLevel() logger.Level {
+	if gst.uart && gst.level < logger.TraceLevel {
+		return logger.TraceLevel
+	}
+	return gst.level
+}
+
+// isMatch checks if a node log entry passes the node and level filters of the stream.
+func (gst *grpcNodeLogStream) isMatch(nodeid NodeId, level logger.Level, isUart bool) bool {
+	if len(gst.nodeIds) > 0 {
+		if _, ok := gst.nodeIds[nodeid]; !ok {
+			return false
+		}
+	}
+	if isUart {
+		return gst.uart
+	}
+	return level <= gst.level
+}
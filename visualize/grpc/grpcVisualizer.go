@@ -45,6 +45,7 @@ type grpcVisualizer struct {
 	server              *grpcServer
 	f                   *grpcField
 	showDemoLegendEvent *pb.VisualizeEvent
+	rssiHeatmapEvent    *pb.VisualizeEvent
 	replay              *replay.Replay
 	energyAnalyser      *energy.EnergyAnalyser
 
@@ -88,7 +89,7 @@ func (gv *grpcVisualizer) Stop() {
 
 	gv.server.stop()
 	if gv.replay != nil {
-		gv.replay.Close()
+		_ = gv.replay.Close()
 	}
 }
 
@@ -117,6 +118,18 @@ func (gv *grpcVisualizer) OnExtAddrChange(nodeid NodeId, extaddr uint64) {
 	}}}, false)
 }
 
+func (gv *grpcVisualizer) OnCustomStatus(nodeid NodeId, key string, value string) {
+	gv.Lock()
+	defer gv.Unlock()
+
+	gv.f.setCustomStatus(nodeid, key, value)
+	gv.addVisualizationEvent(&pb.VisualizeEvent{Type: &pb.VisualizeEvent_CustomStatus{CustomStatus: &pb.CustomStatusEvent{
+		NodeId: int32(nodeid),
+		Key:    key,
+		Value:  value,
+	}}}, false)
+}
+
 func (gv *grpcVisualizer) SetNodeRloc16(nodeid NodeId, rloc16 uint16) {
 	gv.Lock()
 	defer gv.Unlock()
@@ -170,6 +183,7 @@ func (gv *grpcVisualizer) Send(srcid NodeId, dstid NodeId, mvinfo *visualize.Msg
 			SendDurationUs:  mvinfo.SendDurationUs,
 			VisTrueDuration: gv.f.speed <= 0.01,
 			PowerDbm:        int32(mvinfo.PowerDbm),
+			MsgType:         mvinfo.MsgType,
 		},
 	}}}, false)
 }
@@ -207,6 +221,16 @@ func (gv *grpcVisualizer) AdvanceTime(ts uint64, speed float64) {
 	}
 }
 
+// SetCurTime sets the simulation time of the following events, which is used to timestamp the replay entries.
+func (gv *grpcVisualizer) SetCurTime(ts uint64) {
+	gv.Lock()
+	defer gv.Unlock()
+
+	if gv.replay != nil {
+		gv.replay.SetCurTime(ts)
+	}
+}
+
 func (gv *grpcVisualizer) OnNodeFail(nodeid NodeId) {
 	gv.Lock()
 	defer gv.Unlock()
@@ -313,6 +337,20 @@ func (gv *grpcVisualizer) ShowDemoLegend(x int, y int, title string) {
 	gv.addVisualizationEvent(e, false)
 }
 
+func (gv *grpcVisualizer) ShowRssiHeatmap(heatmap *pb.RssiHeatmap) {
+	gv.Lock()
+	defer gv.Unlock()
+
+	e := &pb.VisualizeEvent{Type: &pb.VisualizeEvent_RssiHeatmap{RssiHeatmap: &pb.RssiHeatmapEvent{
+		Heatmap: heatmap,
+	}}}
+	gv.rssiHeatmapEvent = e
+	if heatmap == nil {
+		gv.rssiHeatmapEvent = nil
+	}
+	gv.addVisualizationEvent(e, false)
+}
+
 func (gv *grpcVisualizer) CountDown(duration time.Duration, text string) {
 	gv.Lock()
 	defer gv.Unlock()
@@ -362,6 +400,12 @@ func (gv *grpcVisualizer) prepareStream(stream *grpcStream) error {
 			return err
 		}
 	}
+	// show RSSI heatmap if necessary
+	if gv.rssiHeatmapEvent != nil {
+		if err := stream.Send(gv.rssiHeatmapEvent); err != nil {
+			return err
+		}
+	}
 
 	// set speed
 	if err := stream.Send(&pb.VisualizeEvent{
@@ -491,6 +535,18 @@ func (gv *grpcVisualizer) prepareStream(stream *grpcStream) error {
 				return err
 			}
 		}
+		// custom status
+		for key, value := range node.customStatus {
+			if err := stream.Send(&pb.VisualizeEvent{
+				Type: &pb.VisualizeEvent_CustomStatus{CustomStatus: &pb.CustomStatusEvent{
+					NodeId: int32(nodeid),
+					Key:    key,
+					Value:  value,
+				}},
+			}); err != nil {
+				return err
+			}
+		}
 		// node fail
 		if node.failed {
 			if err := stream.Send(&pb.VisualizeEvent{
@@ -508,7 +564,7 @@ func (gv *grpcVisualizer) prepareStream(stream *grpcStream) error {
 
 func (gv *grpcVisualizer) addVisualizationEvent(event *pb.VisualizeEvent, trivial bool) {
 	if gv.replay != nil {
-		gv.replay.Append(event, trivial)
+		gv.replay.Append(event)
 	}
 	gv.server.SendEvent(event, trivial)
 }
@@ -517,11 +573,21 @@ func (gv *grpcVisualizer) UpdateNodesEnergy(nodes []*pb.NodeEnergy, timestamp ui
 	gv.Lock()
 	defer gv.Unlock()
 
-	//logger.Debugf("Updating Nodes Energy to the charts")
-	gv.server.SendEnergyEvent(&pb.NetworkEnergyEvent{
+	event := &pb.NetworkEnergyEvent{
 		Timestamp:   timestamp / 1000000, // convert to s
 		NodesEnergy: nodes,
-	})
+	}
+	if gv.replay != nil {
+		gv.replay.AppendEnergy(event)
+	}
+	gv.sendNodesEnergy(event, updateView)
+}
+
+// sendNodesEnergy sends an energy report event to the energy report streams, followed by an empty event to update
+// the charts if updateView is true.
+func (gv *grpcVisualizer) sendNodesEnergy(event *pb.NetworkEnergyEvent, updateView bool) {
+	//logger.Debugf("Updating Nodes Energy to the charts")
+	gv.server.SendEnergyEvent(event)
 	if updateView {
 		gv.server.SendEnergyEvent(&pb.NetworkEnergyEvent{
 			Timestamp:   math.MaxUint64, // convert to s
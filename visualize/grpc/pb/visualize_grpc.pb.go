@@ -172,6 +172,8 @@ type VisualizeEvent struct {
 	//	*VisualizeEvent_SetTitle
 	//	*VisualizeEvent_SetNodeMode
 	//	*VisualizeEvent_SetNetworkInfo
+	//	*VisualizeEvent_CustomStatus
+	//	*VisualizeEvent_RssiHeatmap
 	Type isVisualizeEvent_Type `protobuf_oneof:"type"`
 }
 
@@ -375,6 +377,20 @@ func (x *VisualizeEvent) GetSetNetworkInfo() *SetNetworkInfoEvent {
 	return nil
 }
 
+func (x *VisualizeEvent) GetCustomStatus() *CustomStatusEvent {
+	if x, ok := x.GetType().(*VisualizeEvent_CustomStatus); ok {
+		return x.CustomStatus
+	}
+	return nil
+}
+
+func (x *VisualizeEvent) GetRssiHeatmap() *RssiHeatmapEvent {
+	if x, ok := x.GetType().(*VisualizeEvent_RssiHeatmap); ok {
+		return x.RssiHeatmap
+	}
+	return nil
+}
+
 type isVisualizeEvent_Type interface {
 	isVisualizeEvent_Type()
 }
@@ -471,6 +487,14 @@ type VisualizeEvent_SetNetworkInfo struct {
 	SetNetworkInfo *SetNetworkInfoEvent `protobuf:"bytes,23,opt,name=set_network_info,json=setNetworkInfo,proto3,oneof"`
 }
 
+type VisualizeEvent_CustomStatus struct {
+	CustomStatus *CustomStatusEvent `protobuf:"bytes,24,opt,name=custom_status,json=customStatus,proto3,oneof"`
+}
+
+type VisualizeEvent_RssiHeatmap struct {
+	RssiHeatmap *RssiHeatmapEvent `protobuf:"bytes,25,opt,name=rssi_heatmap,json=rssiHeatmap,proto3,oneof"`
+}
+
 func (*VisualizeEvent_AddNode) isVisualizeEvent_Type() {}
 
 func (*VisualizeEvent_DeleteNode) isVisualizeEvent_Type() {}
@@ -517,6 +541,10 @@ func (*VisualizeEvent_SetNodeMode) isVisualizeEvent_Type() {}
 
 func (*VisualizeEvent_SetNetworkInfo) isVisualizeEvent_Type() {}
 
+func (*VisualizeEvent_CustomStatus) isVisualizeEvent_Type() {}
+
+func (*VisualizeEvent_RssiHeatmap) isVisualizeEvent_Type() {}
+
 type SendEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -593,6 +621,7 @@ type MsgVisualizeInfo struct {
 	SendDurationUs  uint32 `protobuf:"varint,6,opt,name=send_duration_us,json=sendDurationUs,proto3" json:"send_duration_us,omitempty"`
 	VisTrueDuration bool   `protobuf:"varint,7,opt,name=vis_true_duration,json=visTrueDuration,proto3" json:"vis_true_duration,omitempty"`
 	PowerDbm        int32  `protobuf:"varint,8,opt,name=power_dbm,json=powerDbm,proto3" json:"power_dbm,omitempty"`
+	MsgType         string `protobuf:"bytes,9,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
 }
 
 func (x *MsgVisualizeInfo) Reset() {
@@ -683,6 +712,13 @@ func (x *MsgVisualizeInfo) GetPowerDbm() int32 {
 	return 0
 }
 
+func (x *MsgVisualizeInfo) GetMsgType() string {
+	if x != nil {
+		return x.MsgType
+	}
+	return ""
+}
+
 type AddRouterTableEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1216,6 +1252,53 @@ func (x *ShowDemoLegendEvent) GetTitle() string {
 	return ""
 }
 
+type RssiHeatmapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Heatmap *RssiHeatmap `protobuf:"bytes,1,opt,name=heatmap,proto3" json:"heatmap,omitempty"` // the heatmap to show; hides the heatmap if not set.
+}
+
+func (x *RssiHeatmapEvent) Reset() {
+	*x = RssiHeatmapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RssiHeatmapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RssiHeatmapEvent) ProtoMessage() {}
+
+func (x *RssiHeatmapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RssiHeatmapEvent.ProtoReflect.Descriptor instead.
+func (*RssiHeatmapEvent) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RssiHeatmapEvent) GetHeatmap() *RssiHeatmap {
+	if x != nil {
+		return x.Heatmap
+	}
+	return nil
+}
+
 type SetNodePosEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1229,7 +1312,7 @@ type SetNodePosEvent struct {
 func (x *SetNodePosEvent) Reset() {
 	*x = SetNodePosEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[14]
+		mi := &file_visualize_grpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1242,7 +1325,7 @@ func (x *SetNodePosEvent) String() string {
 func (*SetNodePosEvent) ProtoMessage() {}
 
 func (x *SetNodePosEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[14]
+	mi := &file_visualize_grpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,7 +1338,7 @@ func (x *SetNodePosEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodePosEvent.ProtoReflect.Descriptor instead.
 func (*SetNodePosEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{14}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SetNodePosEvent) GetNodeId() int32 {
@@ -1291,7 +1374,7 @@ type SetNodeRoleEvent struct {
 func (x *SetNodeRoleEvent) Reset() {
 	*x = SetNodeRoleEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[15]
+		mi := &file_visualize_grpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1304,7 +1387,7 @@ func (x *SetNodeRoleEvent) String() string {
 func (*SetNodeRoleEvent) ProtoMessage() {}
 
 func (x *SetNodeRoleEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[15]
+	mi := &file_visualize_grpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,7 +1400,7 @@ func (x *SetNodeRoleEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeRoleEvent.ProtoReflect.Descriptor instead.
 func (*SetNodeRoleEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{15}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetNodeRoleEvent) GetNodeId() int32 {
@@ -1346,7 +1429,7 @@ type SetNodePartitionIdEvent struct {
 func (x *SetNodePartitionIdEvent) Reset() {
 	*x = SetNodePartitionIdEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[16]
+		mi := &file_visualize_grpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1359,7 +1442,7 @@ func (x *SetNodePartitionIdEvent) String() string {
 func (*SetNodePartitionIdEvent) ProtoMessage() {}
 
 func (x *SetNodePartitionIdEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[16]
+	mi := &file_visualize_grpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +1455,7 @@ func (x *SetNodePartitionIdEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodePartitionIdEvent.ProtoReflect.Descriptor instead.
 func (*SetNodePartitionIdEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{16}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SetNodePartitionIdEvent) GetNodeId() int32 {
@@ -1400,7 +1483,7 @@ type OnNodeFailEvent struct {
 func (x *OnNodeFailEvent) Reset() {
 	*x = OnNodeFailEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[17]
+		mi := &file_visualize_grpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1413,7 +1496,7 @@ func (x *OnNodeFailEvent) String() string {
 func (*OnNodeFailEvent) ProtoMessage() {}
 
 func (x *OnNodeFailEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[17]
+	mi := &file_visualize_grpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +1509,7 @@ func (x *OnNodeFailEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnNodeFailEvent.ProtoReflect.Descriptor instead.
 func (*OnNodeFailEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{17}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *OnNodeFailEvent) GetNodeId() int32 {
@@ -1447,7 +1530,7 @@ type OnNodeRecoverEvent struct {
 func (x *OnNodeRecoverEvent) Reset() {
 	*x = OnNodeRecoverEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[18]
+		mi := &file_visualize_grpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1460,7 +1543,7 @@ func (x *OnNodeRecoverEvent) String() string {
 func (*OnNodeRecoverEvent) ProtoMessage() {}
 
 func (x *OnNodeRecoverEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[18]
+	mi := &file_visualize_grpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1473,7 +1556,7 @@ func (x *OnNodeRecoverEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnNodeRecoverEvent.ProtoReflect.Descriptor instead.
 func (*OnNodeRecoverEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{18}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *OnNodeRecoverEvent) GetNodeId() int32 {
@@ -1494,7 +1577,7 @@ type DeleteNodeEvent struct {
 func (x *DeleteNodeEvent) Reset() {
 	*x = DeleteNodeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[19]
+		mi := &file_visualize_grpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1507,7 +1590,7 @@ func (x *DeleteNodeEvent) String() string {
 func (*DeleteNodeEvent) ProtoMessage() {}
 
 func (x *DeleteNodeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[19]
+	mi := &file_visualize_grpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +1603,7 @@ func (x *DeleteNodeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNodeEvent.ProtoReflect.Descriptor instead.
 func (*DeleteNodeEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{19}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteNodeEvent) GetNodeId() int32 {
@@ -1544,7 +1627,7 @@ type AddNodeEvent struct {
 func (x *AddNodeEvent) Reset() {
 	*x = AddNodeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[20]
+		mi := &file_visualize_grpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1557,7 +1640,7 @@ func (x *AddNodeEvent) String() string {
 func (*AddNodeEvent) ProtoMessage() {}
 
 func (x *AddNodeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[20]
+	mi := &file_visualize_grpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1570,7 +1653,7 @@ func (x *AddNodeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddNodeEvent.ProtoReflect.Descriptor instead.
 func (*AddNodeEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{20}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AddNodeEvent) GetNodeId() int32 {
@@ -1615,7 +1698,7 @@ type NodeMode struct {
 func (x *NodeMode) Reset() {
 	*x = NodeMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[21]
+		mi := &file_visualize_grpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +1711,7 @@ func (x *NodeMode) String() string {
 func (*NodeMode) ProtoMessage() {}
 
 func (x *NodeMode) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[21]
+	mi := &file_visualize_grpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +1724,7 @@ func (x *NodeMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeMode.ProtoReflect.Descriptor instead.
 func (*NodeMode) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{21}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *NodeMode) GetRxOnWhenIdle() bool {
@@ -1684,7 +1767,7 @@ type SetNodeRloc16Event struct {
 func (x *SetNodeRloc16Event) Reset() {
 	*x = SetNodeRloc16Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[22]
+		mi := &file_visualize_grpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1697,7 +1780,7 @@ func (x *SetNodeRloc16Event) String() string {
 func (*SetNodeRloc16Event) ProtoMessage() {}
 
 func (x *SetNodeRloc16Event) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[22]
+	mi := &file_visualize_grpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,7 +1793,7 @@ func (x *SetNodeRloc16Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeRloc16Event.ProtoReflect.Descriptor instead.
 func (*SetNodeRloc16Event) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{22}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SetNodeRloc16Event) GetNodeId() int32 {
@@ -1739,7 +1822,7 @@ type OnExtAddrChangeEvent struct {
 func (x *OnExtAddrChangeEvent) Reset() {
 	*x = OnExtAddrChangeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[23]
+		mi := &file_visualize_grpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1752,7 +1835,7 @@ func (x *OnExtAddrChangeEvent) String() string {
 func (*OnExtAddrChangeEvent) ProtoMessage() {}
 
 func (x *OnExtAddrChangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[23]
+	mi := &file_visualize_grpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,7 +1848,7 @@ func (x *OnExtAddrChangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnExtAddrChangeEvent.ProtoReflect.Descriptor instead.
 func (*OnExtAddrChangeEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{23}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OnExtAddrChangeEvent) GetNodeId() int32 {
@@ -1796,7 +1879,7 @@ type SetTitleEvent struct {
 func (x *SetTitleEvent) Reset() {
 	*x = SetTitleEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[24]
+		mi := &file_visualize_grpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1809,7 +1892,7 @@ func (x *SetTitleEvent) String() string {
 func (*SetTitleEvent) ProtoMessage() {}
 
 func (x *SetTitleEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[24]
+	mi := &file_visualize_grpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +1905,7 @@ func (x *SetTitleEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTitleEvent.ProtoReflect.Descriptor instead.
 func (*SetTitleEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{24}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SetTitleEvent) GetTitle() string {
@@ -1865,7 +1948,7 @@ type SetNodeModeEvent struct {
 func (x *SetNodeModeEvent) Reset() {
 	*x = SetNodeModeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[25]
+		mi := &file_visualize_grpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1878,7 +1961,7 @@ func (x *SetNodeModeEvent) String() string {
 func (*SetNodeModeEvent) ProtoMessage() {}
 
 func (x *SetNodeModeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[25]
+	mi := &file_visualize_grpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1891,7 +1974,7 @@ func (x *SetNodeModeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeModeEvent.ProtoReflect.Descriptor instead.
 func (*SetNodeModeEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{25}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SetNodeModeEvent) GetNodeId() int32 {
@@ -1921,7 +2004,7 @@ type SetNetworkInfoEvent struct {
 func (x *SetNetworkInfoEvent) Reset() {
 	*x = SetNetworkInfoEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[26]
+		mi := &file_visualize_grpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1934,7 +2017,7 @@ func (x *SetNetworkInfoEvent) String() string {
 func (*SetNetworkInfoEvent) ProtoMessage() {}
 
 func (x *SetNetworkInfoEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[26]
+	mi := &file_visualize_grpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +2030,7 @@ func (x *SetNetworkInfoEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNetworkInfoEvent.ProtoReflect.Descriptor instead.
 func (*SetNetworkInfoEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{26}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SetNetworkInfoEvent) GetReal() bool {
@@ -1971,6 +2054,69 @@ func (x *SetNetworkInfoEvent) GetCommit() string {
 	return ""
 }
 
+type CustomStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId int32  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value  string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CustomStatusEvent) Reset() {
+	*x = CustomStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomStatusEvent) ProtoMessage() {}
+
+func (x *CustomStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomStatusEvent.ProtoReflect.Descriptor instead.
+func (*CustomStatusEvent) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CustomStatusEvent) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *CustomStatusEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomStatusEvent) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type NodeEnergy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1986,7 +2132,7 @@ type NodeEnergy struct {
 func (x *NodeEnergy) Reset() {
 	*x = NodeEnergy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[27]
+		mi := &file_visualize_grpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1999,7 +2145,7 @@ func (x *NodeEnergy) String() string {
 func (*NodeEnergy) ProtoMessage() {}
 
 func (x *NodeEnergy) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[27]
+	mi := &file_visualize_grpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +2158,7 @@ func (x *NodeEnergy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeEnergy.ProtoReflect.Descriptor instead.
 func (*NodeEnergy) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{27}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *NodeEnergy) GetNodeId() int32 {
@@ -2062,7 +2208,7 @@ type NetworkEnergyEvent struct {
 func (x *NetworkEnergyEvent) Reset() {
 	*x = NetworkEnergyEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[28]
+		mi := &file_visualize_grpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2075,7 +2221,7 @@ func (x *NetworkEnergyEvent) String() string {
 func (*NetworkEnergyEvent) ProtoMessage() {}
 
 func (x *NetworkEnergyEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[28]
+	mi := &file_visualize_grpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2088,7 +2234,7 @@ func (x *NetworkEnergyEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkEnergyEvent.ProtoReflect.Descriptor instead.
 func (*NetworkEnergyEvent) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{28}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *NetworkEnergyEvent) GetTimestamp() uint64 {
@@ -2116,7 +2262,7 @@ type CommandRequest struct {
 func (x *CommandRequest) Reset() {
 	*x = CommandRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[29]
+		mi := &file_visualize_grpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2129,7 +2275,7 @@ func (x *CommandRequest) String() string {
 func (*CommandRequest) ProtoMessage() {}
 
 func (x *CommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[29]
+	mi := &file_visualize_grpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2142,7 +2288,7 @@ func (x *CommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandRequest.ProtoReflect.Descriptor instead.
 func (*CommandRequest) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{29}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CommandRequest) GetCommand() string {
@@ -2163,7 +2309,7 @@ type CommandResponse struct {
 func (x *CommandResponse) Reset() {
 	*x = CommandResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[30]
+		mi := &file_visualize_grpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2176,7 +2322,7 @@ func (x *CommandResponse) String() string {
 func (*CommandResponse) ProtoMessage() {}
 
 func (x *CommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[30]
+	mi := &file_visualize_grpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2189,7 +2335,7 @@ func (x *CommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandResponse.ProtoReflect.Descriptor instead.
 func (*CommandResponse) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{30}
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CommandResponse) GetOutput() []string {
@@ -2199,32 +2345,31 @@ func (x *CommandResponse) GetOutput() []string {
 	return nil
 }
 
-type ReplayEntry struct {
+type FrameStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp uint64          `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Event     *VisualizeEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	ResetStats bool `protobuf:"varint,1,opt,name=reset_stats,json=resetStats,proto3" json:"reset_stats,omitempty"` // if true, the statistics are cleared after being returned.
 }
 
-func (x *ReplayEntry) Reset() {
-	*x = ReplayEntry{}
+func (x *FrameStatsRequest) Reset() {
+	*x = FrameStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[31]
+		mi := &file_visualize_grpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplayEntry) String() string {
+func (x *FrameStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplayEntry) ProtoMessage() {}
+func (*FrameStatsRequest) ProtoMessage() {}
 
-func (x *ReplayEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[31]
+func (x *FrameStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2235,48 +2380,44 @@ func (x *ReplayEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplayEntry.ProtoReflect.Descriptor instead.
-func (*ReplayEntry) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ReplayEntry) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+// Deprecated: Use FrameStatsRequest.ProtoReflect.Descriptor instead.
+func (*FrameStatsRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ReplayEntry) GetEvent() *VisualizeEvent {
+func (x *FrameStatsRequest) GetResetStats() bool {
 	if x != nil {
-		return x.Event
+		return x.ResetStats
 	}
-	return nil
+	return false
 }
 
-type Empty struct {
+type FrameStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Delivered uint64            `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Dropped   map[string]uint64 `protobuf:"bytes,2,rep,name=dropped,proto3" json:"dropped,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // number of frames not delivered, per drop reason.
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *FrameStats) Reset() {
+	*x = FrameStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_visualize_grpc_proto_msgTypes[32]
+		mi := &file_visualize_grpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Empty) String() string {
+func (x *FrameStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*FrameStats) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_visualize_grpc_proto_msgTypes[32]
+func (x *FrameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,26 +2428,1654 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_visualize_grpc_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use FrameStats.ProtoReflect.Descriptor instead.
+func (*FrameStats) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{34}
 }
 
-var File_visualize_grpc_proto protoreflect.FileDescriptor
+func (x *FrameStats) GetDelivered() uint64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
 
-var file_visualize_grpc_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x69, 0x73,
-	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xed, 0x0d,
-	0x0a, 0x0e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x3c, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
-	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x45,
-	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
-	0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f,
+func (x *FrameStats) GetDropped() map[string]uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return nil
+}
+
+type NodeFrameStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId       int32             `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TxFrames     uint64            `protobuf:"varint,2,opt,name=tx_frames,json=txFrames,proto3" json:"tx_frames,omitempty"`
+	Tx           *FrameStats       `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	Rx           *FrameStats       `protobuf:"bytes,4,opt,name=rx,proto3" json:"rx,omitempty"`
+	TxFrameTypes map[string]uint64 `protobuf:"bytes,5,rep,name=tx_frame_types,json=txFrameTypes,proto3" json:"tx_frame_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *NodeFrameStats) Reset() {
+	*x = NodeFrameStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeFrameStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeFrameStats) ProtoMessage() {}
+
+func (x *NodeFrameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeFrameStats.ProtoReflect.Descriptor instead.
+func (*NodeFrameStats) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NodeFrameStats) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodeFrameStats) GetTxFrames() uint64 {
+	if x != nil {
+		return x.TxFrames
+	}
+	return 0
+}
+
+func (x *NodeFrameStats) GetTx() *FrameStats {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *NodeFrameStats) GetRx() *FrameStats {
+	if x != nil {
+		return x.Rx
+	}
+	return nil
+}
+
+func (x *NodeFrameStats) GetTxFrameTypes() map[string]uint64 {
+	if x != nil {
+		return x.TxFrameTypes
+	}
+	return nil
+}
+
+type LinkFrameStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcId int32       `protobuf:"varint,1,opt,name=src_id,json=srcId,proto3" json:"src_id,omitempty"`
+	DstId int32       `protobuf:"varint,2,opt,name=dst_id,json=dstId,proto3" json:"dst_id,omitempty"`
+	Stats *FrameStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *LinkFrameStats) Reset() {
+	*x = LinkFrameStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkFrameStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkFrameStats) ProtoMessage() {}
+
+func (x *LinkFrameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkFrameStats.ProtoReflect.Descriptor instead.
+func (*LinkFrameStats) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LinkFrameStats) GetSrcId() int32 {
+	if x != nil {
+		return x.SrcId
+	}
+	return 0
+}
+
+func (x *LinkFrameStats) GetDstId() int32 {
+	if x != nil {
+		return x.DstId
+	}
+	return 0
+}
+
+func (x *LinkFrameStats) GetStats() *FrameStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type FrameStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*NodeFrameStats `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Links []*LinkFrameStats `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *FrameStatsResponse) Reset() {
+	*x = FrameStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameStatsResponse) ProtoMessage() {}
+
+func (x *FrameStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameStatsResponse.ProtoReflect.Descriptor instead.
+func (*FrameStatsResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FrameStatsResponse) GetNodes() []*NodeFrameStats {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *FrameStatsResponse) GetLinks() []*LinkFrameStats {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type NodePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *NodePosition) Reset() {
+	*x = NodePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePosition) ProtoMessage() {}
+
+func (x *NodePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePosition.ProtoReflect.Descriptor instead.
+func (*NodePosition) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NodePosition) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *NodePosition) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type AddNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                // the node type, e.g. "router", "fed", "med", "sed" or "br"; "router" if empty.
+	NodeId     int32         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`             // the node id, or 0 for the next available node id.
+	Position   *NodePosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`                        // the node position; the node is placed automatically if not set.
+	RadioRange int32         `protobuf:"varint,4,opt,name=radio_range,json=radioRange,proto3" json:"radio_range,omitempty"` // the radio range, or 0 for the default.
+	Executable string        `protobuf:"bytes,5,opt,name=executable,proto3" json:"executable,omitempty"`                    // the OpenThread executable, if not the default one for the node type.
+	Version    string        `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`                          // the Thread version of the executable (e.g. "v13"), if not the default one.
+	Restore    bool          `protobuf:"varint,7,opt,name=restore,proto3" json:"restore,omitempty"`                         // restore the node from its saved state (flash).
+	Rcp        bool          `protobuf:"varint,8,opt,name=rcp,proto3" json:"rcp,omitempty"`                                 // run the node as an RCP with a host.
+}
+
+func (x *AddNodeRequest) Reset() {
+	*x = AddNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeRequest) ProtoMessage() {}
+
+func (x *AddNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeRequest.ProtoReflect.Descriptor instead.
+func (*AddNodeRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddNodeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddNodeRequest) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *AddNodeRequest) GetPosition() *NodePosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *AddNodeRequest) GetRadioRange() int32 {
+	if x != nil {
+		return x.RadioRange
+	}
+	return 0
+}
+
+func (x *AddNodeRequest) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
+func (x *AddNodeRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AddNodeRequest) GetRestore() bool {
+	if x != nil {
+		return x.Restore
+	}
+	return false
+}
+
+func (x *AddNodeRequest) GetRcp() bool {
+	if x != nil {
+		return x.Rcp
+	}
+	return false
+}
+
+type AddNodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId int32 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *AddNodeResponse) Reset() {
+	*x = AddNodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeResponse) ProtoMessage() {}
+
+func (x *AddNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeResponse.ProtoReflect.Descriptor instead.
+func (*AddNodeResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AddNodeResponse) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+type DeleteNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeIds []int32 `protobuf:"varint,1,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+}
+
+func (x *DeleteNodeRequest) Reset() {
+	*x = DeleteNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNodeRequest) ProtoMessage() {}
+
+func (x *DeleteNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNodeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNodeRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteNodeRequest) GetNodeIds() []int32 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+type MoveNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId   int32         `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Position *NodePosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *MoveNodeRequest) Reset() {
+	*x = MoveNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveNodeRequest) ProtoMessage() {}
+
+func (x *MoveNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveNodeRequest.ProtoReflect.Descriptor instead.
+func (*MoveNodeRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MoveNodeRequest) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *MoveNodeRequest) GetPosition() *NodePosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type SetRadioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeIds []int32 `protobuf:"varint,1,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	On      bool    `protobuf:"varint,2,opt,name=on,proto3" json:"on,omitempty"`
+}
+
+func (x *SetRadioRequest) Reset() {
+	*x = SetRadioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRadioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRadioRequest) ProtoMessage() {}
+
+func (x *SetRadioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRadioRequest.ProtoReflect.Descriptor instead.
+func (*SetRadioRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetRadioRequest) GetNodeIds() []int32 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *SetRadioRequest) GetOn() bool {
+	if x != nil {
+		return x.On
+	}
+	return false
+}
+
+type GoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DurationUs uint64  `protobuf:"varint,1,opt,name=duration_us,json=durationUs,proto3" json:"duration_us,omitempty"`
+	Speed      float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"` // the simulation speed, or 0 for the current speed.
+}
+
+func (x *GoRequest) Reset() {
+	*x = GoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoRequest) ProtoMessage() {}
+
+func (x *GoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoRequest.ProtoReflect.Descriptor instead.
+func (*GoRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GoRequest) GetDurationUs() uint64 {
+	if x != nil {
+		return x.DurationUs
+	}
+	return 0
+}
+
+func (x *GoRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type GoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeUs uint64 `protobuf:"varint,1,opt,name=time_us,json=timeUs,proto3" json:"time_us,omitempty"` // the simulation time after the go period.
+}
+
+func (x *GoResponse) Reset() {
+	*x = GoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoResponse) ProtoMessage() {}
+
+func (x *GoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoResponse.ProtoReflect.Descriptor instead.
+func (*GoResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GoResponse) GetTimeUs() uint64 {
+	if x != nil {
+		return x.TimeUs
+	}
+	return 0
+}
+
+type NodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId      int32         `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ExtAddr     uint64        `protobuf:"varint,2,opt,name=ext_addr,json=extAddr,proto3" json:"ext_addr,omitempty"`
+	Rloc16      uint32        `protobuf:"varint,3,opt,name=rloc16,proto3" json:"rloc16,omitempty"`
+	Position    *NodePosition `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Role        OtDeviceRole  `protobuf:"varint,5,opt,name=role,proto3,enum=visualize_grpc_pb.OtDeviceRole" json:"role,omitempty"`
+	PartitionId uint32        `protobuf:"varint,6,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Failed      bool          `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Executable  string        `protobuf:"bytes,8,opt,name=executable,proto3" json:"executable,omitempty"`
+	CrashCount  int32         `protobuf:"varint,9,opt,name=crash_count,json=crashCount,proto3" json:"crash_count,omitempty"`
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NodeInfo) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetExtAddr() uint64 {
+	if x != nil {
+		return x.ExtAddr
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetRloc16() uint32 {
+	if x != nil {
+		return x.Rloc16
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetPosition() *NodePosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetRole() OtDeviceRole {
+	if x != nil {
+		return x.Role
+	}
+	return OtDeviceRole_OT_DEVICE_ROLE_DISABLED
+}
+
+func (x *NodeInfo) GetPartitionId() uint32 {
+	if x != nil {
+		return x.PartitionId
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *NodeInfo) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetCrashCount() int32 {
+	if x != nil {
+		return x.CrashCount
+	}
+	return 0
+}
+
+type GetNodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*NodeInfo `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *GetNodesResponse) Reset() {
+	*x = GetNodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesResponse) ProtoMessage() {}
+
+func (x *GetNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesResponse.ProtoReflect.Descriptor instead.
+func (*GetNodesResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetNodesResponse) GetNodes() []*NodeInfo {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type Partition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartitionId uint32  `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	NodeIds     []int32 `protobuf:"varint,2,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+}
+
+func (x *Partition) Reset() {
+	*x = Partition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Partition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Partition) ProtoMessage() {}
+
+func (x *Partition) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Partition.ProtoReflect.Descriptor instead.
+func (*Partition) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Partition) GetPartitionId() uint32 {
+	if x != nil {
+		return x.PartitionId
+	}
+	return 0
+}
+
+func (x *Partition) GetNodeIds() []int32 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+type GetPartitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partitions []*Partition `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *GetPartitionsResponse) Reset() {
+	*x = GetPartitionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartitionsResponse) ProtoMessage() {}
+
+func (x *GetPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPartitionsResponse) GetPartitions() []*Partition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type PingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId   int32  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Dst      string `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	DataSize int32  `protobuf:"varint,3,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	DelayUs  uint64 `protobuf:"varint,4,opt,name=delay_us,json=delayUs,proto3" json:"delay_us,omitempty"`
+}
+
+func (x *PingResult) Reset() {
+	*x = PingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResult) ProtoMessage() {}
+
+func (x *PingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResult.ProtoReflect.Descriptor instead.
+func (*PingResult) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PingResult) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *PingResult) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *PingResult) GetDataSize() int32 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+func (x *PingResult) GetDelayUs() uint64 {
+	if x != nil {
+		return x.DelayUs
+	}
+	return 0
+}
+
+type GetPingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pings []*PingResult `protobuf:"bytes,1,rep,name=pings,proto3" json:"pings,omitempty"` // the ping results collected since the previous call.
+}
+
+func (x *GetPingsResponse) Reset() {
+	*x = GetPingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPingsResponse) ProtoMessage() {}
+
+func (x *GetPingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPingsResponse.ProtoReflect.Descriptor instead.
+func (*GetPingsResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPingsResponse) GetPings() []*PingResult {
+	if x != nil {
+		return x.Pings
+	}
+	return nil
+}
+
+type NodeCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId  int32  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"` // the OpenThread CLI command.
+}
+
+func (x *NodeCommandRequest) Reset() {
+	*x = NodeCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCommandRequest) ProtoMessage() {}
+
+func (x *NodeCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCommandRequest.ProtoReflect.Descriptor instead.
+func (*NodeCommandRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NodeCommandRequest) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodeCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type NodeCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output []string `protobuf:"bytes,1,rep,name=output,proto3" json:"output,omitempty"` // the output of the command, without the final "Done".
+}
+
+func (x *NodeCommandResponse) Reset() {
+	*x = NodeCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCommandResponse) ProtoMessage() {}
+
+func (x *NodeCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCommandResponse.ProtoReflect.Descriptor instead.
+func (*NodeCommandResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NodeCommandResponse) GetOutput() []string {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type NodeLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeIds []int32 `protobuf:"varint,1,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"` // the nodes to stream the logs of; all nodes if empty.
+	Level   string  `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`                            // the maximum log level to stream, e.g. "debug"; "info" if empty.
+	Uart    bool    `protobuf:"varint,3,opt,name=uart,proto3" json:"uart,omitempty"`                             // if true, also stream the UART output of the nodes, whatever the level.
+}
+
+func (x *NodeLogsRequest) Reset() {
+	*x = NodeLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLogsRequest) ProtoMessage() {}
+
+func (x *NodeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLogsRequest.ProtoReflect.Descriptor instead.
+func (*NodeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NodeLogsRequest) GetNodeIds() []int32 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *NodeLogsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *NodeLogsRequest) GetUart() bool {
+	if x != nil {
+		return x.Uart
+	}
+	return false
+}
+
+type NodeLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // the simulation time, in us.
+	NodeId    int32  `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Level     string `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	Msg       string `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Uart      bool   `protobuf:"varint,5,opt,name=uart,proto3" json:"uart,omitempty"` // true if msg is a line of UART output of the node.
+}
+
+func (x *NodeLogEntry) Reset() {
+	*x = NodeLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLogEntry) ProtoMessage() {}
+
+func (x *NodeLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLogEntry.ProtoReflect.Descriptor instead.
+func (*NodeLogEntry) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NodeLogEntry) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NodeLogEntry) GetNodeId() int32 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *NodeLogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *NodeLogEntry) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *NodeLogEntry) GetUart() bool {
+	if x != nil {
+		return x.Uart
+	}
+	return false
+}
+
+type RssiHeatmap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X        int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"` // the top-left point of the grid.
+	Y        int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	CellSize int32 `protobuf:"varint,3,opt,name=cell_size,json=cellSize,proto3" json:"cell_size,omitempty"`
+	Cols     int32 `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows     int32 `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	// the values of the cells, in row-major order, evaluated at the centre of each cell.
+	Rssi      []float32 `protobuf:"fixed32,6,rep,packed,name=rssi,proto3" json:"rssi,omitempty"`                     // the RSSI (dBm) of the strongest transmitter.
+	Sinr      []float32 `protobuf:"fixed32,7,rep,packed,name=sinr,proto3" json:"sinr,omitempty"`                     // the SINR (dB) of the strongest transmitter, with the other transmitters as interferers.
+	NodeIds   []int32   `protobuf:"varint,8,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"` // the strongest transmitter.
+	Reachable []bool    `protobuf:"varint,9,rep,packed,name=reachable,proto3" json:"reachable,omitempty"`            // true if a node at the cell can receive frames from the strongest transmitter, if alone.
+}
+
+func (x *RssiHeatmap) Reset() {
+	*x = RssiHeatmap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RssiHeatmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RssiHeatmap) ProtoMessage() {}
+
+func (x *RssiHeatmap) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RssiHeatmap.ProtoReflect.Descriptor instead.
+func (*RssiHeatmap) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RssiHeatmap) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *RssiHeatmap) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *RssiHeatmap) GetCellSize() int32 {
+	if x != nil {
+		return x.CellSize
+	}
+	return 0
+}
+
+func (x *RssiHeatmap) GetCols() int32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *RssiHeatmap) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *RssiHeatmap) GetRssi() []float32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return nil
+}
+
+func (x *RssiHeatmap) GetSinr() []float32 {
+	if x != nil {
+		return x.Sinr
+	}
+	return nil
+}
+
+func (x *RssiHeatmap) GetNodeIds() []int32 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *RssiHeatmap) GetReachable() []bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return nil
+}
+
+type RssiHeatmapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeIds  []int32 `protobuf:"varint,1,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"` // the transmitters; all nodes if empty.
+	CellSize int32   `protobuf:"varint,2,opt,name=cell_size,json=cellSize,proto3" json:"cell_size,omitempty"`     // the size of the grid cells; the default is used if 0.
+	Show     bool    `protobuf:"varint,3,opt,name=show,proto3" json:"show,omitempty"`                             // if true, also show the heatmap in the visualization.
+}
+
+func (x *RssiHeatmapRequest) Reset() {
+	*x = RssiHeatmapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RssiHeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RssiHeatmapRequest) ProtoMessage() {}
+
+func (x *RssiHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RssiHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*RssiHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RssiHeatmapRequest) GetNodeIds() []int32 {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *RssiHeatmapRequest) GetCellSize() int32 {
+	if x != nil {
+		return x.CellSize
+	}
+	return 0
+}
+
+func (x *RssiHeatmapRequest) GetShow() bool {
+	if x != nil {
+		return x.Show
+	}
+	return false
+}
+
+type RssiHeatmapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Heatmap *RssiHeatmap `protobuf:"bytes,1,opt,name=heatmap,proto3" json:"heatmap,omitempty"`
+}
+
+func (x *RssiHeatmapResponse) Reset() {
+	*x = RssiHeatmapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RssiHeatmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RssiHeatmapResponse) ProtoMessage() {}
+
+func (x *RssiHeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RssiHeatmapResponse.ProtoReflect.Descriptor instead.
+func (*RssiHeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RssiHeatmapResponse) GetHeatmap() *RssiHeatmap {
+	if x != nil {
+		return x.Heatmap
+	}
+	return nil
+}
+
+type ReplayEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp uint64              `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event     *VisualizeEvent     `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Energy    *NetworkEnergyEvent `protobuf:"bytes,3,opt,name=energy,proto3" json:"energy,omitempty"`      // set instead of event for energy report entries.
+	Keyframe  bool                `protobuf:"varint,4,opt,name=keyframe,proto3" json:"keyframe,omitempty"` // true for the network state snapshot at the start of a block of a binary replay.
+}
+
+func (x *ReplayEntry) Reset() {
+	*x = ReplayEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEntry) ProtoMessage() {}
+
+func (x *ReplayEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEntry.ProtoReflect.Descriptor instead.
+func (*ReplayEntry) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReplayEntry) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ReplayEntry) GetEvent() *VisualizeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ReplayEntry) GetEnergy() *NetworkEnergyEvent {
+	if x != nil {
+		return x.Energy
+	}
+	return nil
+}
+
+func (x *ReplayEntry) GetKeyframe() bool {
+	if x != nil {
+		return x.Keyframe
+	}
+	return false
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_visualize_grpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_visualize_grpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_visualize_grpc_proto_rawDescGZIP(), []int{60}
+}
+
+var File_visualize_grpc_proto protoreflect.FileDescriptor
+
+var file_visualize_grpc_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x0f,
+	0x0a, 0x0e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x3c, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x45,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f,
 	0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x6f, 0x64,
 	0x65, 0x5f, 0x72, 0x6c, 0x6f, 0x63, 0x31, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
@@ -2409,73 +4178,89 @@ var file_visualize_grpc_proto_rawDesc = []byte{
 	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e,
 	0x53, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x76,
 	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x77, 0x0a,
-	0x09, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x72,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49,
-	0x64, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x64, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x76, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x69, 0x73, 0x75,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x73,
-	0x67, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
-	0x6d, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa8, 0x02, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x56, 0x69,
-	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
-	0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0e,
-	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64,
-	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x69, 0x73, 0x5f,
-	0x74, 0x72, 0x75, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x69, 0x73, 0x54, 0x72, 0x75, 0x65, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x62,
-	0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x62,
-	0x6d, 0x22, 0x49, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x54, 0x61,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76,
+	0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x73, 0x73, 0x69, 0x5f, 0x68, 0x65, 0x61, 0x74, 0x6d,
+	0x61, 0x70, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x52, 0x73, 0x73,
+	0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x0b, 0x72, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x77, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x07, 0x6d, 0x76, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6d, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc3, 0x02,
+	0x0a, 0x10, 0x4d, 0x73, 0x67, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x73, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x76, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x69, 0x73, 0x54,
+	0x72, 0x75, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x62, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x62, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x4c,
+	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x54, 0x61,
 	0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
 	0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x4c, 0x0a, 0x16,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x48, 0x0a, 0x12, 0x41, 0x64,
-	0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68,
-	0x69, 0x6c, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x10, 0x41, 0x64,
-	0x76, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
-	0x70, 0x65, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x45, 0x0a, 0x0e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6d, 0x6f, 0x4c, 0x65, 0x67,
-	0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x01, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x46, 0x0a, 0x0f, 0x53, 0x65,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x48, 0x0a, 0x12,
+	0x41, 0x64, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x10,
+	0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x45, 0x0a, 0x0e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6d, 0x6f, 0x4c,
+	0x65, 0x67, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x4c, 0x0a, 0x10,
+	0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61,
+	0x70, 0x52, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x22, 0x46, 0x0a, 0x0f, 0x53, 0x65,
 	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
 	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -2546,65 +4331,321 @@ var file_visualize_grpc_proto_rawDesc = []byte{
 	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22,
-	0x77, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x17, 0x0a,
-	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x74, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x72, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x72, 0x78, 0x22, 0x73, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3f, 0x0a, 0x0b,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x54, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x65,
+	0x72, 0x67, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x65, 0x65,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x74, 0x78, 0x12, 0x0e,
+	0x0a, 0x02, 0x72, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x72, 0x78, 0x22, 0x73,
+	0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x3f, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x65, 0x72, 0x67,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x52, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x65,
+	0x72, 0x67, 0x79, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
+	0x29, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x34, 0x0a, 0x11, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0xac, 0x01, 0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x44, 0x0a,
+	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x44, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xc0, 0x02, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x78, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x02, 0x74, 0x78, 0x12, 0x2d, 0x0a, 0x02, 0x72, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x02, 0x72, 0x78, 0x12, 0x59, 0x0a, 0x0e, 0x74, 0x78, 0x5f, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x2e, 0x54, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x73, 0x0a, 0x0e, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x64,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79,
-	0x52, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x22, 0x2a, 0x0a,
-	0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x64, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x37, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70,
-	0x63, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x2a, 0x98, 0x01, 0x0a, 0x0c, 0x4f, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43,
-	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52,
-	0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18,
-	0x0a, 0x14, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
-	0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x54, 0x5f, 0x44,
-	0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45,
-	0x52, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45,
-	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x04, 0x32, 0x9d,
-	0x02, 0x0a, 0x14, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x70, 0x63,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x09, 0x56, 0x69, 0x73, 0x75, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x73, 0x75,
+	0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x22, 0x2a, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x81, 0x02, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x63, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x63, 0x70,
+	0x22, 0x2a, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0x67, 0x0a, 0x0f,
+	0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x02, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x09, 0x47, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x0a, 0x47, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x22, 0xc4,
+	0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x6c, 0x6f, 0x63, 0x31, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x6c, 0x6f, 0x63, 0x31, 0x36, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x73, 0x75,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4f, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x61, 0x73, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x09,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6f,
+	0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x55, 0x73, 0x22,
+	0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x05, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x47, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x22, 0x2d, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x56, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x75, 0x61, 0x72, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64,
+	0x65, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x61, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x61, 0x72, 0x74, 0x22, 0xcf, 0x01, 0x0a,
+	0x0b, 0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x65, 0x6c,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x73, 0x73, 0x69, 0x18, 0x06, 0x20, 0x03, 0x28, 0x02, 0x52, 0x04, 0x72, 0x73, 0x73,
+	0x69, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x6e, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x02, 0x52,
+	0x04, 0x73, 0x69, 0x6e, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x60,
+	0x0a, 0x12, 0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x68, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x68, 0x6f, 0x77,
+	0x22, 0x4f, 0x0a, 0x13, 0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d,
+	0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x52, 0x73, 0x73,
+	0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61,
+	0x70, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x37, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x2a, 0x98, 0x01, 0x0a,
+	0x0c, 0x4f, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x54,
+	0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x54,
+	0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x54, 0x5f, 0x44, 0x45,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
+	0x4f, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4c,
+	0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x04, 0x32, 0xe9, 0x09, 0x0a, 0x14, 0x56, 0x69, 0x73, 0x75,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x55, 0x0a, 0x09, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x2e,
+	0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x62, 0x2e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x45, 0x6e, 0x65,
+	0x72, 0x67, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x73, 0x75,
 	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x69,
-	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x50,
-	0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x73, 0x75,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69,
+	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x2e,
+	0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x48, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x22,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x08,
+	0x53, 0x65, 0x74, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x52, 0x61, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76,
+	0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x02, 0x47, 0x6f, 0x12, 0x1c, 0x2e, 0x76,
 	0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5c, 0x0a, 0x0c, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x23, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70,
-	0x63, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x2f,
-	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65,
-	0x6e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x6f, 0x74, 0x2d, 0x6e, 0x73, 0x2f, 0x76, 0x69,
-	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x47, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x47,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x23, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x28, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x23, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x22,
+	0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0b, 0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61,
+	0x74, 0x6d, 0x61, 0x70, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e, 0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61,
+	0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69,
+	0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x62, 0x2e,
+	0x52, 0x73, 0x73, 0x69, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x6f, 0x74, 0x2d,
+	0x6e, 0x73, 0x2f, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2620,7 +4661,7 @@ func file_visualize_grpc_proto_rawDescGZIP() []byte {
 }
 
 var file_visualize_grpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_visualize_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_visualize_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_visualize_grpc_proto_goTypes = []interface{}{
 	(OtDeviceRole)(0),               // 0: visualize_grpc_pb.OtDeviceRole
 	(*VisualizeRequest)(nil),        // 1: visualize_grpc_pb.VisualizeRequest
@@ -2637,35 +4678,65 @@ var file_visualize_grpc_proto_goTypes = []interface{}{
 	(*SetParentEvent)(nil),          // 12: visualize_grpc_pb.SetParentEvent
 	(*CountDownEvent)(nil),          // 13: visualize_grpc_pb.CountDownEvent
 	(*ShowDemoLegendEvent)(nil),     // 14: visualize_grpc_pb.ShowDemoLegendEvent
-	(*SetNodePosEvent)(nil),         // 15: visualize_grpc_pb.SetNodePosEvent
-	(*SetNodeRoleEvent)(nil),        // 16: visualize_grpc_pb.SetNodeRoleEvent
-	(*SetNodePartitionIdEvent)(nil), // 17: visualize_grpc_pb.SetNodePartitionIdEvent
-	(*OnNodeFailEvent)(nil),         // 18: visualize_grpc_pb.OnNodeFailEvent
-	(*OnNodeRecoverEvent)(nil),      // 19: visualize_grpc_pb.OnNodeRecoverEvent
-	(*DeleteNodeEvent)(nil),         // 20: visualize_grpc_pb.DeleteNodeEvent
-	(*AddNodeEvent)(nil),            // 21: visualize_grpc_pb.AddNodeEvent
-	(*NodeMode)(nil),                // 22: visualize_grpc_pb.NodeMode
-	(*SetNodeRloc16Event)(nil),      // 23: visualize_grpc_pb.SetNodeRloc16Event
-	(*OnExtAddrChangeEvent)(nil),    // 24: visualize_grpc_pb.OnExtAddrChangeEvent
-	(*SetTitleEvent)(nil),           // 25: visualize_grpc_pb.SetTitleEvent
-	(*SetNodeModeEvent)(nil),        // 26: visualize_grpc_pb.SetNodeModeEvent
-	(*SetNetworkInfoEvent)(nil),     // 27: visualize_grpc_pb.SetNetworkInfoEvent
-	(*NodeEnergy)(nil),              // 28: visualize_grpc_pb.NodeEnergy
-	(*NetworkEnergyEvent)(nil),      // 29: visualize_grpc_pb.NetworkEnergyEvent
-	(*CommandRequest)(nil),          // 30: visualize_grpc_pb.CommandRequest
-	(*CommandResponse)(nil),         // 31: visualize_grpc_pb.CommandResponse
-	(*ReplayEntry)(nil),             // 32: visualize_grpc_pb.ReplayEntry
-	(*Empty)(nil),                   // 33: visualize_grpc_pb.Empty
+	(*RssiHeatmapEvent)(nil),        // 15: visualize_grpc_pb.RssiHeatmapEvent
+	(*SetNodePosEvent)(nil),         // 16: visualize_grpc_pb.SetNodePosEvent
+	(*SetNodeRoleEvent)(nil),        // 17: visualize_grpc_pb.SetNodeRoleEvent
+	(*SetNodePartitionIdEvent)(nil), // 18: visualize_grpc_pb.SetNodePartitionIdEvent
+	(*OnNodeFailEvent)(nil),         // 19: visualize_grpc_pb.OnNodeFailEvent
+	(*OnNodeRecoverEvent)(nil),      // 20: visualize_grpc_pb.OnNodeRecoverEvent
+	(*DeleteNodeEvent)(nil),         // 21: visualize_grpc_pb.DeleteNodeEvent
+	(*AddNodeEvent)(nil),            // 22: visualize_grpc_pb.AddNodeEvent
+	(*NodeMode)(nil),                // 23: visualize_grpc_pb.NodeMode
+	(*SetNodeRloc16Event)(nil),      // 24: visualize_grpc_pb.SetNodeRloc16Event
+	(*OnExtAddrChangeEvent)(nil),    // 25: visualize_grpc_pb.OnExtAddrChangeEvent
+	(*SetTitleEvent)(nil),           // 26: visualize_grpc_pb.SetTitleEvent
+	(*SetNodeModeEvent)(nil),        // 27: visualize_grpc_pb.SetNodeModeEvent
+	(*SetNetworkInfoEvent)(nil),     // 28: visualize_grpc_pb.SetNetworkInfoEvent
+	(*CustomStatusEvent)(nil),       // 29: visualize_grpc_pb.CustomStatusEvent
+	(*NodeEnergy)(nil),              // 30: visualize_grpc_pb.NodeEnergy
+	(*NetworkEnergyEvent)(nil),      // 31: visualize_grpc_pb.NetworkEnergyEvent
+	(*CommandRequest)(nil),          // 32: visualize_grpc_pb.CommandRequest
+	(*CommandResponse)(nil),         // 33: visualize_grpc_pb.CommandResponse
+	(*FrameStatsRequest)(nil),       // 34: visualize_grpc_pb.FrameStatsRequest
+	(*FrameStats)(nil),              // 35: visualize_grpc_pb.FrameStats
+	(*NodeFrameStats)(nil),          // 36: visualize_grpc_pb.NodeFrameStats
+	(*LinkFrameStats)(nil),          // 37: visualize_grpc_pb.LinkFrameStats
+	(*FrameStatsResponse)(nil),      // 38: visualize_grpc_pb.FrameStatsResponse
+	(*NodePosition)(nil),            // 39: visualize_grpc_pb.NodePosition
+	(*AddNodeRequest)(nil),          // 40: visualize_grpc_pb.AddNodeRequest
+	(*AddNodeResponse)(nil),         // 41: visualize_grpc_pb.AddNodeResponse
+	(*DeleteNodeRequest)(nil),       // 42: visualize_grpc_pb.DeleteNodeRequest
+	(*MoveNodeRequest)(nil),         // 43: visualize_grpc_pb.MoveNodeRequest
+	(*SetRadioRequest)(nil),         // 44: visualize_grpc_pb.SetRadioRequest
+	(*GoRequest)(nil),               // 45: visualize_grpc_pb.GoRequest
+	(*GoResponse)(nil),              // 46: visualize_grpc_pb.GoResponse
+	(*NodeInfo)(nil),                // 47: visualize_grpc_pb.NodeInfo
+	(*GetNodesResponse)(nil),        // 48: visualize_grpc_pb.GetNodesResponse
+	(*Partition)(nil),               // 49: visualize_grpc_pb.Partition
+	(*GetPartitionsResponse)(nil),   // 50: visualize_grpc_pb.GetPartitionsResponse
+	(*PingResult)(nil),              // 51: visualize_grpc_pb.PingResult
+	(*GetPingsResponse)(nil),        // 52: visualize_grpc_pb.GetPingsResponse
+	(*NodeCommandRequest)(nil),      // 53: visualize_grpc_pb.NodeCommandRequest
+	(*NodeCommandResponse)(nil),     // 54: visualize_grpc_pb.NodeCommandResponse
+	(*NodeLogsRequest)(nil),         // 55: visualize_grpc_pb.NodeLogsRequest
+	(*NodeLogEntry)(nil),            // 56: visualize_grpc_pb.NodeLogEntry
+	(*RssiHeatmap)(nil),             // 57: visualize_grpc_pb.RssiHeatmap
+	(*RssiHeatmapRequest)(nil),      // 58: visualize_grpc_pb.RssiHeatmapRequest
+	(*RssiHeatmapResponse)(nil),     // 59: visualize_grpc_pb.RssiHeatmapResponse
+	(*ReplayEntry)(nil),             // 60: visualize_grpc_pb.ReplayEntry
+	(*Empty)(nil),                   // 61: visualize_grpc_pb.Empty
+	nil,                             // 62: visualize_grpc_pb.FrameStats.DroppedEntry
+	nil,                             // 63: visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry
 }
 var file_visualize_grpc_proto_depIdxs = []int32{
-	21, // 0: visualize_grpc_pb.VisualizeEvent.add_node:type_name -> visualize_grpc_pb.AddNodeEvent
-	20, // 1: visualize_grpc_pb.VisualizeEvent.delete_node:type_name -> visualize_grpc_pb.DeleteNodeEvent
-	23, // 2: visualize_grpc_pb.VisualizeEvent.set_node_rloc16:type_name -> visualize_grpc_pb.SetNodeRloc16Event
-	16, // 3: visualize_grpc_pb.VisualizeEvent.set_node_role:type_name -> visualize_grpc_pb.SetNodeRoleEvent
-	15, // 4: visualize_grpc_pb.VisualizeEvent.set_node_pos:type_name -> visualize_grpc_pb.SetNodePosEvent
-	17, // 5: visualize_grpc_pb.VisualizeEvent.set_node_partition_id:type_name -> visualize_grpc_pb.SetNodePartitionIdEvent
-	18, // 6: visualize_grpc_pb.VisualizeEvent.on_node_fail:type_name -> visualize_grpc_pb.OnNodeFailEvent
-	19, // 7: visualize_grpc_pb.VisualizeEvent.on_node_recover:type_name -> visualize_grpc_pb.OnNodeRecoverEvent
+	22, // 0: visualize_grpc_pb.VisualizeEvent.add_node:type_name -> visualize_grpc_pb.AddNodeEvent
+	21, // 1: visualize_grpc_pb.VisualizeEvent.delete_node:type_name -> visualize_grpc_pb.DeleteNodeEvent
+	24, // 2: visualize_grpc_pb.VisualizeEvent.set_node_rloc16:type_name -> visualize_grpc_pb.SetNodeRloc16Event
+	17, // 3: visualize_grpc_pb.VisualizeEvent.set_node_role:type_name -> visualize_grpc_pb.SetNodeRoleEvent
+	16, // 4: visualize_grpc_pb.VisualizeEvent.set_node_pos:type_name -> visualize_grpc_pb.SetNodePosEvent
+	18, // 5: visualize_grpc_pb.VisualizeEvent.set_node_partition_id:type_name -> visualize_grpc_pb.SetNodePartitionIdEvent
+	19, // 6: visualize_grpc_pb.VisualizeEvent.on_node_fail:type_name -> visualize_grpc_pb.OnNodeFailEvent
+	20, // 7: visualize_grpc_pb.VisualizeEvent.on_node_recover:type_name -> visualize_grpc_pb.OnNodeRecoverEvent
 	12, // 8: visualize_grpc_pb.VisualizeEvent.set_parent:type_name -> visualize_grpc_pb.SetParentEvent
 	13, // 9: visualize_grpc_pb.VisualizeEvent.count_down:type_name -> visualize_grpc_pb.CountDownEvent
 	14, // 10: visualize_grpc_pb.VisualizeEvent.show_demo_legend:type_name -> visualize_grpc_pb.ShowDemoLegendEvent
@@ -2677,26 +4748,69 @@ var file_visualize_grpc_proto_depIdxs = []int32{
 	3,  // 16: visualize_grpc_pb.VisualizeEvent.send:type_name -> visualize_grpc_pb.SendEvent
 	9,  // 17: visualize_grpc_pb.VisualizeEvent.set_speed:type_name -> visualize_grpc_pb.SetSpeedEvent
 	10, // 18: visualize_grpc_pb.VisualizeEvent.heartbeat:type_name -> visualize_grpc_pb.HeartbeatEvent
-	24, // 19: visualize_grpc_pb.VisualizeEvent.on_ext_addr_change:type_name -> visualize_grpc_pb.OnExtAddrChangeEvent
-	25, // 20: visualize_grpc_pb.VisualizeEvent.set_title:type_name -> visualize_grpc_pb.SetTitleEvent
-	26, // 21: visualize_grpc_pb.VisualizeEvent.set_node_mode:type_name -> visualize_grpc_pb.SetNodeModeEvent
-	27, // 22: visualize_grpc_pb.VisualizeEvent.set_network_info:type_name -> visualize_grpc_pb.SetNetworkInfoEvent
-	4,  // 23: visualize_grpc_pb.SendEvent.mv_info:type_name -> visualize_grpc_pb.MsgVisualizeInfo
-	0,  // 24: visualize_grpc_pb.SetNodeRoleEvent.role:type_name -> visualize_grpc_pb.OtDeviceRole
-	22, // 25: visualize_grpc_pb.SetNodeModeEvent.node_mode:type_name -> visualize_grpc_pb.NodeMode
-	28, // 26: visualize_grpc_pb.NetworkEnergyEvent.NodesEnergy:type_name -> visualize_grpc_pb.NodeEnergy
-	2,  // 27: visualize_grpc_pb.ReplayEntry.event:type_name -> visualize_grpc_pb.VisualizeEvent
-	1,  // 28: visualize_grpc_pb.VisualizeGrpcService.Visualize:input_type -> visualize_grpc_pb.VisualizeRequest
-	30, // 29: visualize_grpc_pb.VisualizeGrpcService.Command:input_type -> visualize_grpc_pb.CommandRequest
-	1,  // 30: visualize_grpc_pb.VisualizeGrpcService.EnergyReport:input_type -> visualize_grpc_pb.VisualizeRequest
-	2,  // 31: visualize_grpc_pb.VisualizeGrpcService.Visualize:output_type -> visualize_grpc_pb.VisualizeEvent
-	31, // 32: visualize_grpc_pb.VisualizeGrpcService.Command:output_type -> visualize_grpc_pb.CommandResponse
-	29, // 33: visualize_grpc_pb.VisualizeGrpcService.EnergyReport:output_type -> visualize_grpc_pb.NetworkEnergyEvent
-	31, // [31:34] is the sub-list for method output_type
-	28, // [28:31] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	25, // 19: visualize_grpc_pb.VisualizeEvent.on_ext_addr_change:type_name -> visualize_grpc_pb.OnExtAddrChangeEvent
+	26, // 20: visualize_grpc_pb.VisualizeEvent.set_title:type_name -> visualize_grpc_pb.SetTitleEvent
+	27, // 21: visualize_grpc_pb.VisualizeEvent.set_node_mode:type_name -> visualize_grpc_pb.SetNodeModeEvent
+	28, // 22: visualize_grpc_pb.VisualizeEvent.set_network_info:type_name -> visualize_grpc_pb.SetNetworkInfoEvent
+	29, // 23: visualize_grpc_pb.VisualizeEvent.custom_status:type_name -> visualize_grpc_pb.CustomStatusEvent
+	15, // 24: visualize_grpc_pb.VisualizeEvent.rssi_heatmap:type_name -> visualize_grpc_pb.RssiHeatmapEvent
+	4,  // 25: visualize_grpc_pb.SendEvent.mv_info:type_name -> visualize_grpc_pb.MsgVisualizeInfo
+	57, // 26: visualize_grpc_pb.RssiHeatmapEvent.heatmap:type_name -> visualize_grpc_pb.RssiHeatmap
+	0,  // 27: visualize_grpc_pb.SetNodeRoleEvent.role:type_name -> visualize_grpc_pb.OtDeviceRole
+	23, // 28: visualize_grpc_pb.SetNodeModeEvent.node_mode:type_name -> visualize_grpc_pb.NodeMode
+	30, // 29: visualize_grpc_pb.NetworkEnergyEvent.NodesEnergy:type_name -> visualize_grpc_pb.NodeEnergy
+	62, // 30: visualize_grpc_pb.FrameStats.dropped:type_name -> visualize_grpc_pb.FrameStats.DroppedEntry
+	35, // 31: visualize_grpc_pb.NodeFrameStats.tx:type_name -> visualize_grpc_pb.FrameStats
+	35, // 32: visualize_grpc_pb.NodeFrameStats.rx:type_name -> visualize_grpc_pb.FrameStats
+	63, // 33: visualize_grpc_pb.NodeFrameStats.tx_frame_types:type_name -> visualize_grpc_pb.NodeFrameStats.TxFrameTypesEntry
+	35, // 34: visualize_grpc_pb.LinkFrameStats.stats:type_name -> visualize_grpc_pb.FrameStats
+	36, // 35: visualize_grpc_pb.FrameStatsResponse.nodes:type_name -> visualize_grpc_pb.NodeFrameStats
+	37, // 36: visualize_grpc_pb.FrameStatsResponse.links:type_name -> visualize_grpc_pb.LinkFrameStats
+	39, // 37: visualize_grpc_pb.AddNodeRequest.position:type_name -> visualize_grpc_pb.NodePosition
+	39, // 38: visualize_grpc_pb.MoveNodeRequest.position:type_name -> visualize_grpc_pb.NodePosition
+	39, // 39: visualize_grpc_pb.NodeInfo.position:type_name -> visualize_grpc_pb.NodePosition
+	0,  // 40: visualize_grpc_pb.NodeInfo.role:type_name -> visualize_grpc_pb.OtDeviceRole
+	47, // 41: visualize_grpc_pb.GetNodesResponse.nodes:type_name -> visualize_grpc_pb.NodeInfo
+	49, // 42: visualize_grpc_pb.GetPartitionsResponse.partitions:type_name -> visualize_grpc_pb.Partition
+	51, // 43: visualize_grpc_pb.GetPingsResponse.pings:type_name -> visualize_grpc_pb.PingResult
+	57, // 44: visualize_grpc_pb.RssiHeatmapResponse.heatmap:type_name -> visualize_grpc_pb.RssiHeatmap
+	2,  // 45: visualize_grpc_pb.ReplayEntry.event:type_name -> visualize_grpc_pb.VisualizeEvent
+	31, // 46: visualize_grpc_pb.ReplayEntry.energy:type_name -> visualize_grpc_pb.NetworkEnergyEvent
+	1,  // 47: visualize_grpc_pb.VisualizeGrpcService.Visualize:input_type -> visualize_grpc_pb.VisualizeRequest
+	32, // 48: visualize_grpc_pb.VisualizeGrpcService.Command:input_type -> visualize_grpc_pb.CommandRequest
+	1,  // 49: visualize_grpc_pb.VisualizeGrpcService.EnergyReport:input_type -> visualize_grpc_pb.VisualizeRequest
+	34, // 50: visualize_grpc_pb.VisualizeGrpcService.FrameStats:input_type -> visualize_grpc_pb.FrameStatsRequest
+	40, // 51: visualize_grpc_pb.VisualizeGrpcService.AddNode:input_type -> visualize_grpc_pb.AddNodeRequest
+	42, // 52: visualize_grpc_pb.VisualizeGrpcService.DeleteNode:input_type -> visualize_grpc_pb.DeleteNodeRequest
+	43, // 53: visualize_grpc_pb.VisualizeGrpcService.MoveNode:input_type -> visualize_grpc_pb.MoveNodeRequest
+	44, // 54: visualize_grpc_pb.VisualizeGrpcService.SetRadio:input_type -> visualize_grpc_pb.SetRadioRequest
+	45, // 55: visualize_grpc_pb.VisualizeGrpcService.Go:input_type -> visualize_grpc_pb.GoRequest
+	61, // 56: visualize_grpc_pb.VisualizeGrpcService.GetNodes:input_type -> visualize_grpc_pb.Empty
+	61, // 57: visualize_grpc_pb.VisualizeGrpcService.GetPartitions:input_type -> visualize_grpc_pb.Empty
+	61, // 58: visualize_grpc_pb.VisualizeGrpcService.GetPings:input_type -> visualize_grpc_pb.Empty
+	53, // 59: visualize_grpc_pb.VisualizeGrpcService.NodeCommand:input_type -> visualize_grpc_pb.NodeCommandRequest
+	55, // 60: visualize_grpc_pb.VisualizeGrpcService.NodeLogs:input_type -> visualize_grpc_pb.NodeLogsRequest
+	58, // 61: visualize_grpc_pb.VisualizeGrpcService.RssiHeatmap:input_type -> visualize_grpc_pb.RssiHeatmapRequest
+	2,  // 62: visualize_grpc_pb.VisualizeGrpcService.Visualize:output_type -> visualize_grpc_pb.VisualizeEvent
+	33, // 63: visualize_grpc_pb.VisualizeGrpcService.Command:output_type -> visualize_grpc_pb.CommandResponse
+	31, // 64: visualize_grpc_pb.VisualizeGrpcService.EnergyReport:output_type -> visualize_grpc_pb.NetworkEnergyEvent
+	38, // 65: visualize_grpc_pb.VisualizeGrpcService.FrameStats:output_type -> visualize_grpc_pb.FrameStatsResponse
+	41, // 66: visualize_grpc_pb.VisualizeGrpcService.AddNode:output_type -> visualize_grpc_pb.AddNodeResponse
+	61, // 67: visualize_grpc_pb.VisualizeGrpcService.DeleteNode:output_type -> visualize_grpc_pb.Empty
+	61, // 68: visualize_grpc_pb.VisualizeGrpcService.MoveNode:output_type -> visualize_grpc_pb.Empty
+	61, // 69: visualize_grpc_pb.VisualizeGrpcService.SetRadio:output_type -> visualize_grpc_pb.Empty
+	46, // 70: visualize_grpc_pb.VisualizeGrpcService.Go:output_type -> visualize_grpc_pb.GoResponse
+	48, // 71: visualize_grpc_pb.VisualizeGrpcService.GetNodes:output_type -> visualize_grpc_pb.GetNodesResponse
+	50, // 72: visualize_grpc_pb.VisualizeGrpcService.GetPartitions:output_type -> visualize_grpc_pb.GetPartitionsResponse
+	52, // 73: visualize_grpc_pb.VisualizeGrpcService.GetPings:output_type -> visualize_grpc_pb.GetPingsResponse
+	54, // 74: visualize_grpc_pb.VisualizeGrpcService.NodeCommand:output_type -> visualize_grpc_pb.NodeCommandResponse
+	56, // 75: visualize_grpc_pb.VisualizeGrpcService.NodeLogs:output_type -> visualize_grpc_pb.NodeLogEntry
+	59, // 76: visualize_grpc_pb.VisualizeGrpcService.RssiHeatmap:output_type -> visualize_grpc_pb.RssiHeatmapResponse
+	62, // [62:77] is the sub-list for method output_type
+	47, // [47:62] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_visualize_grpc_proto_init() }
@@ -2717,8 +4831,248 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VisualizeEvent); i {
+		file_visualize_grpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisualizeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVisualizeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRouterTableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRouterTableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChildTableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChildTableEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpeedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvanceTimeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetParentEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountDownEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowDemoLegendEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RssiHeatmapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodePosEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodeRoleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodePartitionIdEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnNodeFailEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnNodeRecoverEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNodeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2729,8 +5083,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendEvent); i {
+		file_visualize_grpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeMode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2741,8 +5095,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVisualizeInfo); i {
+		file_visualize_grpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodeRloc16Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2753,8 +5107,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRouterTableEvent); i {
+		file_visualize_grpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnExtAddrChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2765,8 +5119,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveRouterTableEvent); i {
+		file_visualize_grpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTitleEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2777,8 +5131,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddChildTableEvent); i {
+		file_visualize_grpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodeModeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2789,8 +5143,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChildTableEvent); i {
+		file_visualize_grpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNetworkInfoEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2801,8 +5155,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSpeedEvent); i {
+		file_visualize_grpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomStatusEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2813,8 +5167,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartbeatEvent); i {
+		file_visualize_grpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeEnergy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2825,8 +5179,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdvanceTimeEvent); i {
+		file_visualize_grpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkEnergyEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2837,8 +5191,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetParentEvent); i {
+		file_visualize_grpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2849,8 +5203,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountDownEvent); i {
+		file_visualize_grpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2861,8 +5215,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowDemoLegendEvent); i {
+		file_visualize_grpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2873,8 +5227,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNodePosEvent); i {
+		file_visualize_grpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2885,8 +5239,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNodeRoleEvent); i {
+		file_visualize_grpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeFrameStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2897,8 +5251,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNodePartitionIdEvent); i {
+		file_visualize_grpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkFrameStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2909,8 +5263,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OnNodeFailEvent); i {
+		file_visualize_grpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2921,8 +5275,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OnNodeRecoverEvent); i {
+		file_visualize_grpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodePosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2933,8 +5287,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNodeEvent); i {
+		file_visualize_grpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2945,8 +5299,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddNodeEvent); i {
+		file_visualize_grpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2957,8 +5311,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeMode); i {
+		file_visualize_grpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2969,8 +5323,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNodeRloc16Event); i {
+		file_visualize_grpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2981,8 +5335,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OnExtAddrChangeEvent); i {
+		file_visualize_grpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRadioRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2993,8 +5347,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTitleEvent); i {
+		file_visualize_grpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3005,8 +5359,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNodeModeEvent); i {
+		file_visualize_grpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3017,8 +5371,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNetworkInfoEvent); i {
+		file_visualize_grpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3029,8 +5383,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeEnergy); i {
+		file_visualize_grpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3041,8 +5395,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkEnergyEvent); i {
+		file_visualize_grpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Partition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3053,8 +5407,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandRequest); i {
+		file_visualize_grpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPartitionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3065,8 +5419,8 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandResponse); i {
+		file_visualize_grpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3077,7 +5431,103 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_visualize_grpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RssiHeatmap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RssiHeatmapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RssiHeatmapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_visualize_grpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplayEntry); i {
 			case 0:
 				return &v.state
@@ -3089,7 +5539,7 @@ func file_visualize_grpc_proto_init() {
 				return nil
 			}
 		}
-		file_visualize_grpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_visualize_grpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -3126,6 +5576,8 @@ func file_visualize_grpc_proto_init() {
 		(*VisualizeEvent_SetTitle)(nil),
 		(*VisualizeEvent_SetNodeMode)(nil),
 		(*VisualizeEvent_SetNetworkInfo)(nil),
+		(*VisualizeEvent_CustomStatus)(nil),
+		(*VisualizeEvent_RssiHeatmap)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3133,7 +5585,7 @@ func file_visualize_grpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_visualize_grpc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   33,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3163,63 +5615,197 @@ type VisualizeGrpcServiceClient interface {
 	Visualize(ctx context.Context, in *VisualizeRequest, opts ...grpc.CallOption) (VisualizeGrpcService_VisualizeClient, error)
 	Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error)
 	EnergyReport(ctx context.Context, in *VisualizeRequest, opts ...grpc.CallOption) (VisualizeGrpcService_EnergyReportClient, error)
+	FrameStats(ctx context.Context, in *FrameStatsRequest, opts ...grpc.CallOption) (*FrameStatsResponse, error)
+	AddNode(ctx context.Context, in *AddNodeRequest, opts ...grpc.CallOption) (*AddNodeResponse, error)
+	DeleteNode(ctx context.Context, in *DeleteNodeRequest, opts ...grpc.CallOption) (*Empty, error)
+	MoveNode(ctx context.Context, in *MoveNodeRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetRadio(ctx context.Context, in *SetRadioRequest, opts ...grpc.CallOption) (*Empty, error)
+	Go(ctx context.Context, in *GoRequest, opts ...grpc.CallOption) (*GoResponse, error)
+	GetNodes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetNodesResponse, error)
+	GetPartitions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPartitionsResponse, error)
+	GetPings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPingsResponse, error)
+	NodeCommand(ctx context.Context, in *NodeCommandRequest, opts ...grpc.CallOption) (*NodeCommandResponse, error)
+	NodeLogs(ctx context.Context, in *NodeLogsRequest, opts ...grpc.CallOption) (VisualizeGrpcService_NodeLogsClient, error)
+	RssiHeatmap(ctx context.Context, in *RssiHeatmapRequest, opts ...grpc.CallOption) (*RssiHeatmapResponse, error)
 }
 
 type visualizeGrpcServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewVisualizeGrpcServiceClient(cc grpc.ClientConnInterface) VisualizeGrpcServiceClient {
-	return &visualizeGrpcServiceClient{cc}
+func NewVisualizeGrpcServiceClient(cc grpc.ClientConnInterface) VisualizeGrpcServiceClient {
+	return &visualizeGrpcServiceClient{cc}
+}
+
+func (c *visualizeGrpcServiceClient) Visualize(ctx context.Context, in *VisualizeRequest, opts ...grpc.CallOption) (VisualizeGrpcService_VisualizeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VisualizeGrpcService_serviceDesc.Streams[0], "/visualize_grpc_pb.VisualizeGrpcService/Visualize", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &visualizeGrpcServiceVisualizeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VisualizeGrpcService_VisualizeClient interface {
+	Recv() (*VisualizeEvent, error)
+	grpc.ClientStream
+}
+
+type visualizeGrpcServiceVisualizeClient struct {
+	grpc.ClientStream
+}
+
+func (x *visualizeGrpcServiceVisualizeClient) Recv() (*VisualizeEvent, error) {
+	m := new(VisualizeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *visualizeGrpcServiceClient) Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/Command", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visualizeGrpcServiceClient) EnergyReport(ctx context.Context, in *VisualizeRequest, opts ...grpc.CallOption) (VisualizeGrpcService_EnergyReportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VisualizeGrpcService_serviceDesc.Streams[1], "/visualize_grpc_pb.VisualizeGrpcService/EnergyReport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &visualizeGrpcServiceEnergyReportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VisualizeGrpcService_EnergyReportClient interface {
+	Recv() (*NetworkEnergyEvent, error)
+	grpc.ClientStream
+}
+
+type visualizeGrpcServiceEnergyReportClient struct {
+	grpc.ClientStream
+}
+
+func (x *visualizeGrpcServiceEnergyReportClient) Recv() (*NetworkEnergyEvent, error) {
+	m := new(NetworkEnergyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *visualizeGrpcServiceClient) FrameStats(ctx context.Context, in *FrameStatsRequest, opts ...grpc.CallOption) (*FrameStatsResponse, error) {
+	out := new(FrameStatsResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/FrameStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visualizeGrpcServiceClient) AddNode(ctx context.Context, in *AddNodeRequest, opts ...grpc.CallOption) (*AddNodeResponse, error) {
+	out := new(AddNodeResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/AddNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visualizeGrpcServiceClient) DeleteNode(ctx context.Context, in *DeleteNodeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/DeleteNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *visualizeGrpcServiceClient) Visualize(ctx context.Context, in *VisualizeRequest, opts ...grpc.CallOption) (VisualizeGrpcService_VisualizeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_VisualizeGrpcService_serviceDesc.Streams[0], "/visualize_grpc_pb.VisualizeGrpcService/Visualize", opts...)
+func (c *visualizeGrpcServiceClient) MoveNode(ctx context.Context, in *MoveNodeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/MoveNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &visualizeGrpcServiceVisualizeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *visualizeGrpcServiceClient) SetRadio(ctx context.Context, in *SetRadioRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/SetRadio", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *visualizeGrpcServiceClient) Go(ctx context.Context, in *GoRequest, opts ...grpc.CallOption) (*GoResponse, error) {
+	out := new(GoResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/Go", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type VisualizeGrpcService_VisualizeClient interface {
-	Recv() (*VisualizeEvent, error)
-	grpc.ClientStream
+func (c *visualizeGrpcServiceClient) GetNodes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetNodesResponse, error) {
+	out := new(GetNodesResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/GetNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type visualizeGrpcServiceVisualizeClient struct {
-	grpc.ClientStream
+func (c *visualizeGrpcServiceClient) GetPartitions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPartitionsResponse, error) {
+	out := new(GetPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/GetPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *visualizeGrpcServiceVisualizeClient) Recv() (*VisualizeEvent, error) {
-	m := new(VisualizeEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *visualizeGrpcServiceClient) GetPings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPingsResponse, error) {
+	out := new(GetPingsResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/GetPings", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *visualizeGrpcServiceClient) Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
-	out := new(CommandResponse)
-	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/Command", in, out, opts...)
+func (c *visualizeGrpcServiceClient) NodeCommand(ctx context.Context, in *NodeCommandRequest, opts ...grpc.CallOption) (*NodeCommandResponse, error) {
+	out := new(NodeCommandResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/NodeCommand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *visualizeGrpcServiceClient) EnergyReport(ctx context.Context, in *VisualizeRequest, opts ...grpc.CallOption) (VisualizeGrpcService_EnergyReportClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_VisualizeGrpcService_serviceDesc.Streams[1], "/visualize_grpc_pb.VisualizeGrpcService/EnergyReport", opts...)
+func (c *visualizeGrpcServiceClient) NodeLogs(ctx context.Context, in *NodeLogsRequest, opts ...grpc.CallOption) (VisualizeGrpcService_NodeLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_VisualizeGrpcService_serviceDesc.Streams[2], "/visualize_grpc_pb.VisualizeGrpcService/NodeLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &visualizeGrpcServiceEnergyReportClient{stream}
+	x := &visualizeGrpcServiceNodeLogsClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -3229,28 +5815,49 @@ func (c *visualizeGrpcServiceClient) EnergyReport(ctx context.Context, in *Visua
 	return x, nil
 }
 
-type VisualizeGrpcService_EnergyReportClient interface {
-	Recv() (*NetworkEnergyEvent, error)
+type VisualizeGrpcService_NodeLogsClient interface {
+	Recv() (*NodeLogEntry, error)
 	grpc.ClientStream
 }
 
-type visualizeGrpcServiceEnergyReportClient struct {
+type visualizeGrpcServiceNodeLogsClient struct {
 	grpc.ClientStream
 }
 
-func (x *visualizeGrpcServiceEnergyReportClient) Recv() (*NetworkEnergyEvent, error) {
-	m := new(NetworkEnergyEvent)
+func (x *visualizeGrpcServiceNodeLogsClient) Recv() (*NodeLogEntry, error) {
+	m := new(NodeLogEntry)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
+func (c *visualizeGrpcServiceClient) RssiHeatmap(ctx context.Context, in *RssiHeatmapRequest, opts ...grpc.CallOption) (*RssiHeatmapResponse, error) {
+	out := new(RssiHeatmapResponse)
+	err := c.cc.Invoke(ctx, "/visualize_grpc_pb.VisualizeGrpcService/RssiHeatmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VisualizeGrpcServiceServer is the server API for VisualizeGrpcService service.
 type VisualizeGrpcServiceServer interface {
 	Visualize(*VisualizeRequest, VisualizeGrpcService_VisualizeServer) error
 	Command(context.Context, *CommandRequest) (*CommandResponse, error)
 	EnergyReport(*VisualizeRequest, VisualizeGrpcService_EnergyReportServer) error
+	FrameStats(context.Context, *FrameStatsRequest) (*FrameStatsResponse, error)
+	AddNode(context.Context, *AddNodeRequest) (*AddNodeResponse, error)
+	DeleteNode(context.Context, *DeleteNodeRequest) (*Empty, error)
+	MoveNode(context.Context, *MoveNodeRequest) (*Empty, error)
+	SetRadio(context.Context, *SetRadioRequest) (*Empty, error)
+	Go(context.Context, *GoRequest) (*GoResponse, error)
+	GetNodes(context.Context, *Empty) (*GetNodesResponse, error)
+	GetPartitions(context.Context, *Empty) (*GetPartitionsResponse, error)
+	GetPings(context.Context, *Empty) (*GetPingsResponse, error)
+	NodeCommand(context.Context, *NodeCommandRequest) (*NodeCommandResponse, error)
+	NodeLogs(*NodeLogsRequest, VisualizeGrpcService_NodeLogsServer) error
+	RssiHeatmap(context.Context, *RssiHeatmapRequest) (*RssiHeatmapResponse, error)
 }
 
 // UnimplementedVisualizeGrpcServiceServer can be embedded to have forward compatible implementations.
@@ -3266,6 +5873,42 @@ func (*UnimplementedVisualizeGrpcServiceServer) Command(context.Context, *Comman
 func (*UnimplementedVisualizeGrpcServiceServer) EnergyReport(*VisualizeRequest, VisualizeGrpcService_EnergyReportServer) error {
 	return status.Errorf(codes.Unimplemented, "method EnergyReport not implemented")
 }
+func (*UnimplementedVisualizeGrpcServiceServer) FrameStats(context.Context, *FrameStatsRequest) (*FrameStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrameStats not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) AddNode(context.Context, *AddNodeRequest) (*AddNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNode not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) DeleteNode(context.Context, *DeleteNodeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNode not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) MoveNode(context.Context, *MoveNodeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveNode not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) SetRadio(context.Context, *SetRadioRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRadio not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) Go(context.Context, *GoRequest) (*GoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Go not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) GetNodes(context.Context, *Empty) (*GetNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodes not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) GetPartitions(context.Context, *Empty) (*GetPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitions not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) GetPings(context.Context, *Empty) (*GetPingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPings not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) NodeCommand(context.Context, *NodeCommandRequest) (*NodeCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeCommand not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) NodeLogs(*NodeLogsRequest, VisualizeGrpcService_NodeLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method NodeLogs not implemented")
+}
+func (*UnimplementedVisualizeGrpcServiceServer) RssiHeatmap(context.Context, *RssiHeatmapRequest) (*RssiHeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RssiHeatmap not implemented")
+}
 
 func RegisterVisualizeGrpcServiceServer(s *grpc.Server, srv VisualizeGrpcServiceServer) {
 	s.RegisterService(&_VisualizeGrpcService_serviceDesc, srv)
@@ -3331,6 +5974,225 @@ func (x *visualizeGrpcServiceEnergyReportServer) Send(m *NetworkEnergyEvent) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _VisualizeGrpcService_FrameStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrameStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).FrameStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/FrameStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).FrameStats(ctx, req.(*FrameStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).AddNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/AddNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).AddNode(ctx, req.(*AddNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_DeleteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).DeleteNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/DeleteNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).DeleteNode(ctx, req.(*DeleteNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_MoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).MoveNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/MoveNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).MoveNode(ctx, req.(*MoveNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_SetRadio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRadioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).SetRadio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/SetRadio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).SetRadio(ctx, req.(*SetRadioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_Go_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).Go(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/Go",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).Go(ctx, req.(*GoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_GetNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).GetNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/GetNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).GetNodes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_GetPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).GetPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/GetPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).GetPartitions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_GetPings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).GetPings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/GetPings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).GetPings(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_NodeCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).NodeCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/NodeCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).NodeCommand(ctx, req.(*NodeCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisualizeGrpcService_NodeLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NodeLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VisualizeGrpcServiceServer).NodeLogs(m, &visualizeGrpcServiceNodeLogsServer{stream})
+}
+
+type VisualizeGrpcService_NodeLogsServer interface {
+	Send(*NodeLogEntry) error
+	grpc.ServerStream
+}
+
+type visualizeGrpcServiceNodeLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *visualizeGrpcServiceNodeLogsServer) Send(m *NodeLogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VisualizeGrpcService_RssiHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RssiHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisualizeGrpcServiceServer).RssiHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/visualize_grpc_pb.VisualizeGrpcService/RssiHeatmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisualizeGrpcServiceServer).RssiHeatmap(ctx, req.(*RssiHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VisualizeGrpcService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "visualize_grpc_pb.VisualizeGrpcService",
 	HandlerType: (*VisualizeGrpcServiceServer)(nil),
@@ -3339,6 +6201,50 @@ var _VisualizeGrpcService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Command",
 			Handler:    _VisualizeGrpcService_Command_Handler,
 		},
+		{
+			MethodName: "FrameStats",
+			Handler:    _VisualizeGrpcService_FrameStats_Handler,
+		},
+		{
+			MethodName: "AddNode",
+			Handler:    _VisualizeGrpcService_AddNode_Handler,
+		},
+		{
+			MethodName: "DeleteNode",
+			Handler:    _VisualizeGrpcService_DeleteNode_Handler,
+		},
+		{
+			MethodName: "MoveNode",
+			Handler:    _VisualizeGrpcService_MoveNode_Handler,
+		},
+		{
+			MethodName: "SetRadio",
+			Handler:    _VisualizeGrpcService_SetRadio_Handler,
+		},
+		{
+			MethodName: "Go",
+			Handler:    _VisualizeGrpcService_Go_Handler,
+		},
+		{
+			MethodName: "GetNodes",
+			Handler:    _VisualizeGrpcService_GetNodes_Handler,
+		},
+		{
+			MethodName: "GetPartitions",
+			Handler:    _VisualizeGrpcService_GetPartitions_Handler,
+		},
+		{
+			MethodName: "GetPings",
+			Handler:    _VisualizeGrpcService_GetPings_Handler,
+		},
+		{
+			MethodName: "NodeCommand",
+			Handler:    _VisualizeGrpcService_NodeCommand_Handler,
+		},
+		{
+			MethodName: "RssiHeatmap",
+			Handler:    _VisualizeGrpcService_RssiHeatmap_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3351,6 +6257,11 @@ var _VisualizeGrpcService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _VisualizeGrpcService_EnergyReport_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "NodeLogs",
+			Handler:       _VisualizeGrpcService_NodeLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "visualize_grpc.proto",
 }
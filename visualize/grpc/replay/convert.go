@@ -0,0 +1,63 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+// Convert converts a replay into the binary format. The source replay can be in the text format, as written by
+// previous versions of OTNS, or in the binary format, e.g. to rebuild the index of a replay that was not closed.
+func Convert(srcFilename string, dstFilename string) error {
+	r, err := Open(srcFilename)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	rep, err := newReplay(dstFilename)
+	if err != nil {
+		return err
+	}
+
+	for {
+		entry, err := r.Peek()
+		if err != nil {
+			_ = rep.Close()
+			return err
+		}
+		if entry == nil {
+			break
+		}
+
+		rep.SetCurTime(entry.Timestamp)
+		if entry.Event != nil {
+			rep.Append(entry.Event)
+		} else if entry.Energy != nil {
+			rep.AppendEnergy(entry.Energy)
+		}
+		r.Advance()
+	}
+
+	return rep.Close()
+}
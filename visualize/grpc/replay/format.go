@@ -0,0 +1,214 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+// The binary replay format is:
+//
+//	header:  binaryMagic, binaryVersion (1 byte)
+//	blocks:  blockTag, start time (uvarint), data length (uvarint), data
+//	index:   indexTag, number of blocks (uvarint), per block: start time (uvarint), file offset (uvarint)
+//	trailer: index file offset (8 bytes, little endian), indexMagic
+//
+// The data of a block is a flate compressed sequence of records, each being the length (uvarint) and the protobuf
+// encoding of a ReplayEntry. A block starts with keyframe entries, which are a snapshot of the network state at the
+// start time of the block, so that the replay can be played from any block. The index and trailer are written when the
+// replay is closed; without them, the blocks are found by scanning the file.
+const (
+	binaryMagic   = "OTNS-RPL"
+	binaryVersion = 1
+	indexMagic    = "OTNS-IDX"
+	blockTag      = 'B'
+	indexTag      = 'I'
+	trailerLen    = 8 + len(indexMagic)
+)
+
+// blockIndex is the index entry of a block of a binary replay.
+type blockIndex struct {
+	ts     uint64 // the simulation time of the keyframe of the block.
+	offset int64  // the file offset of the block.
+}
+
+func appendRecord(buf *bytes.Buffer, entry *pb.ReplayEntry) error {
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	buf.Write(appendUvarint(nil, uint64(len(data))))
+	buf.Write(data)
+	return nil
+}
+
+func writeBlock(w io.Writer, fw *flate.Writer, ts uint64, records []byte) (int, error) {
+	var data bytes.Buffer
+	fw.Reset(&data)
+	if _, err := fw.Write(records); err != nil {
+		return 0, err
+	}
+	if err := fw.Close(); err != nil {
+		return 0, err
+	}
+
+	header := []byte{blockTag}
+	header = appendUvarint(header, ts)
+	header = appendUvarint(header, uint64(data.Len()))
+	n, err := w.Write(header)
+	if err != nil {
+		return n, err
+	}
+	m, err := w.Write(data.Bytes())
+	return n + m, err
+}
+
+func writeIndex(w io.Writer, index []blockIndex, offset int64) error {
+	buf := []byte{indexTag}
+	buf = appendUvarint(buf, uint64(len(index)))
+	for _, bi := range index {
+		buf = appendUvarint(buf, bi.ts)
+		buf = appendUvarint(buf, uint64(bi.offset))
+	}
+	var offsetBytes [8]byte
+	binary.LittleEndian.PutUint64(offsetBytes[:], uint64(offset))
+	buf = append(buf, offsetBytes[:]...)
+	buf = append(buf, indexMagic...)
+	_, err := w.Write(buf)
+	return err
+}
+
+// readIndex reads the index of a binary replay at offset.
+func readIndex(r io.ReaderAt, offset int64, size int64) ([]blockIndex, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, offset, size-offset))
+	if tag, err := br.ReadByte(); err != nil || tag != indexTag {
+		return nil, errors.Errorf("invalid replay index")
+	}
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	var index []blockIndex
+	for i := uint64(0); i < n; i++ {
+		var bi blockIndex
+		if bi.ts, err = binary.ReadUvarint(br); err != nil {
+			return nil, err
+		}
+		blockOffset, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		bi.offset = int64(blockOffset)
+		index = append(index, bi)
+	}
+	return index, nil
+}
+
+// scanIndex builds the index of a binary replay by scanning its blocks from offset. A truncated last block, e.g. of a
+// replay that was not closed, is ignored.
+func scanIndex(r io.ReaderAt, offset int64, size int64) []blockIndex {
+	var index []blockIndex
+	for offset < size {
+		br := bufio.NewReader(io.NewSectionReader(r, offset, size-offset))
+		tag, err := br.ReadByte()
+		if err != nil || tag != blockTag {
+			break
+		}
+		ts, err := binary.ReadUvarint(br)
+		if err != nil {
+			break
+		}
+		dataLen, err := binary.ReadUvarint(br)
+		if err != nil {
+			break
+		}
+
+		next := offset + 1 + int64(uvarintLen(ts)) + int64(uvarintLen(dataLen)) + int64(dataLen)
+		if next > size {
+			break
+		}
+		index = append(index, blockIndex{ts: ts, offset: offset})
+		offset = next
+	}
+	return index
+}
+
+// readBlock reads and decodes the entries of the block at offset.
+func readBlock(r io.ReaderAt, offset int64, size int64) ([]*pb.ReplayEntry, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, offset, size-offset))
+	if tag, err := br.ReadByte(); err != nil || tag != blockTag {
+		return nil, errors.Errorf("invalid replay block at offset %d", offset)
+	}
+	if _, err := binary.ReadUvarint(br); err != nil {
+		return nil, err
+	}
+	dataLen, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := io.ReadAll(flate.NewReader(io.LimitReader(br, int64(dataLen))))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid replay block at offset %d", offset)
+	}
+
+	var entries []*pb.ReplayEntry
+	for len(records) > 0 {
+		n, k := binary.Uvarint(records)
+		if k <= 0 || uint64(len(records)-k) < n {
+			return nil, errors.Errorf("invalid replay record in block at offset %d", offset)
+		}
+		entry := &pb.ReplayEntry{}
+		if err := proto.Unmarshal(records[k:k+int(n)], entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+		records = records[k+int(n):]
+	}
+	return entries, nil
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func uvarintLen(v uint64) int {
+	var tmp [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(tmp[:], v)
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+// Reader reads the entries of a replay, in the binary or the text format, and keeps track of the network state at
+// the current position.
+type Reader struct {
+	f     *os.File
+	src   entrySource
+	state *State
+	next  *pb.ReplayEntry // the peeked entry, if any.
+	pos   uint64          // the simulation time up to which the entries were read.
+}
+
+// entrySource reads the entries of a replay file in a given format.
+type entrySource interface {
+	// read returns the next entry, skipping keyframes, or nil at the end of the replay.
+	read() (*pb.ReplayEntry, error)
+	// seek positions the source so that the entries at or after ts can be read faster than by reading from pos, and
+	// returns the events of the network state at the new position. It returns false if the source can only continue
+	// reading from pos.
+	seek(ts uint64, pos uint64) ([]*pb.VisualizeEvent, bool, error)
+}
+
+// Open opens a replay file, detecting its format.
+func Open(filename string) (*Reader, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := newEntrySource(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrapf(err, "invalid replay %s", filename)
+	}
+
+	return &Reader{
+		f:     f,
+		src:   src,
+		state: NewState(),
+	}, nil
+}
+
+func newEntrySource(f *os.File) (entrySource, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, len(binaryMagic)+1)
+	if _, err := io.ReadFull(f, header); err != nil || string(header[:len(binaryMagic)]) != binaryMagic {
+		return newTextSource(f)
+	}
+	if header[len(binaryMagic)] != binaryVersion {
+		return nil, errors.Errorf("unsupported binary replay version %d", header[len(binaryMagic)])
+	}
+	return newBinarySource(f, int64(len(header)), fi.Size())
+}
+
+// Peek returns the next entry, without consuming it, or nil at the end of the replay.
+func (r *Reader) Peek() (*pb.ReplayEntry, error) {
+	if r.next == nil {
+		entry, err := r.src.read()
+		if err != nil {
+			return nil, err
+		}
+		r.next = entry
+	}
+	return r.next, nil
+}
+
+// Advance consumes the peeked entry, applying it to the network state.
+func (r *Reader) Advance() {
+	if r.next == nil {
+		return
+	}
+	if r.next.Event != nil {
+		r.state.Apply(r.next.Event)
+	}
+	r.pos = r.next.Timestamp
+	r.next = nil
+}
+
+// Seek positions the reader after the entries at or before the simulation time ts, skipping energy reports. The
+// network state is the state at ts afterwards.
+func (r *Reader) Seek(ts uint64) error {
+	events, ok, err := r.src.seek(ts, r.pos)
+	if err != nil {
+		return err
+	}
+	if ok {
+		r.state = NewState()
+		for _, event := range events {
+			r.state.Apply(event)
+		}
+		r.next = nil
+	}
+
+	for {
+		entry, err := r.Peek()
+		if err != nil {
+			return err
+		}
+		if entry == nil || entry.Timestamp > ts {
+			break
+		}
+		r.Advance()
+	}
+	r.pos = ts
+	return nil
+}
+
+// State returns the network state at the current position.
+func (r *Reader) State() *State {
+	return r.state
+}
+
+func (r *Reader) Close() error {
+	return r.f.Close()
+}
+
+// textSource reads replays in the text format: one ReplayEntry in the protobuf text format per line.
+type textSource struct {
+	f       *os.File
+	scanner *bufio.Scanner
+}
+
+func newTextSource(f *os.File) (*textSource, error) {
+	src := &textSource{f: f}
+	if err := src.rewind(); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+func (src *textSource) rewind() error {
+	if _, err := src.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	src.scanner = bufio.NewScanner(bufio.NewReader(src.f))
+	src.scanner.Buffer(nil, 1024*1024)
+	src.scanner.Split(bufio.ScanLines)
+	return nil
+}
+
+func (src *textSource) read() (*pb.ReplayEntry, error) {
+	for src.scanner.Scan() {
+		line := bytes.TrimSpace(src.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		entry := &pb.ReplayEntry{}
+		if err := prototext.Unmarshal(line, entry); err != nil {
+			return nil, err
+		}
+		return entry, nil
+	}
+	return nil, src.scanner.Err()
+}
+
+// seek rewinds to the start of the replay when seeking backwards, as the text format has no keyframes.
+func (src *textSource) seek(ts uint64, pos uint64) ([]*pb.VisualizeEvent, bool, error) {
+	if ts >= pos {
+		return nil, false, nil
+	}
+	return nil, true, src.rewind()
+}
+
+// binarySource reads replays in the binary format, one block at a time.
+type binarySource struct {
+	f       *os.File
+	size    int64
+	index   []blockIndex
+	block   int // the index of the current block, or -1 if none was read.
+	entries []*pb.ReplayEntry
+	next    int // the index of the next entry in entries.
+}
+
+func newBinarySource(f *os.File, offset int64, size int64) (*binarySource, error) {
+	src := &binarySource{
+		f:     f,
+		size:  size,
+		block: -1,
+	}
+
+	if size >= offset+int64(trailerLen) {
+		trailer := make([]byte, trailerLen)
+		if _, err := f.ReadAt(trailer, size-int64(trailerLen)); err != nil {
+			return nil, err
+		}
+		if string(trailer[8:]) == indexMagic {
+			indexOffset := int64(binary.LittleEndian.Uint64(trailer[:8]))
+			index, err := readIndex(f, indexOffset, size-int64(trailerLen))
+			if err != nil {
+				return nil, err
+			}
+			src.index = index
+			return src, nil
+		}
+	}
+
+	src.index = scanIndex(f, offset, size)
+	return src, nil
+}
+
+func (src *binarySource) read() (*pb.ReplayEntry, error) {
+	for src.next >= len(src.entries) {
+		if src.block+1 >= len(src.index) {
+			return nil, nil
+		}
+		if err := src.load(src.block + 1); err != nil {
+			return nil, err
+		}
+		src.skipKeyframe()
+	}
+
+	entry := src.entries[src.next]
+	src.next += 1
+	return entry, nil
+}
+
+// seek jumps to the latest block starting at or before ts, when seeking backwards or when that block is after the
+// current one.
+func (src *binarySource) seek(ts uint64, pos uint64) ([]*pb.VisualizeEvent, bool, error) {
+	block := sort.Search(len(src.index), func(i int) bool {
+		return src.index[i].ts > ts
+	}) - 1
+
+	if ts >= pos && block <= src.block {
+		return nil, false, nil
+	}
+
+	if block < 0 {
+		// ts is before the first block: play from the start.
+		src.block, src.entries, src.next = -1, nil, 0
+		return nil, true, nil
+	}
+
+	if err := src.load(block); err != nil {
+		return nil, false, err
+	}
+
+	var events []*pb.VisualizeEvent
+	for _, entry := range src.entries {
+		if !entry.Keyframe {
+			break
+		}
+		events = append(events, entry.Event)
+	}
+	src.skipKeyframe()
+	return events, true, nil
+}
+
+func (src *binarySource) load(block int) error {
+	entries, err := readBlock(src.f, src.index[block].offset, src.size)
+	if err != nil {
+		return err
+	}
+	src.block, src.entries, src.next = block, entries, 0
+	return nil
+}
+
+func (src *binarySource) skipKeyframe() {
+	for src.next < len(src.entries) && src.entries[src.next].Keyframe {
+		src.next += 1
+	}
+}
@@ -2,57 +2,103 @@ package replay
 
 import (
 	"bufio"
+	"bytes"
+	"compress/flate"
 	"os"
-	"time"
 
 	"github.com/openthread/ot-ns/logger"
-	visualize_grpc_pb "github.com/openthread/ot-ns/visualize/grpc/pb"
-	"google.golang.org/protobuf/encoding/prototext"
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
 )
 
-var (
-	marshalOptions = prototext.MarshalOptions{
-		Multiline: false,
-	}
+const (
+	// defaultKeyframeInterval is the simulation time (in us) after which a replay block is completed, so that a new
+	// block, starting with a keyframe, is started.
+	defaultKeyframeInterval = 10000000
+	// defaultMaxBlockSize is the uncompressed size of the entries of a replay block after which the block is completed.
+	defaultMaxBlockSize = 256 * 1024
 )
 
+// Replay writes a binary replay (see format.go). Entries are never dropped: if the file writer routine is busy,
+// appending an entry waits until it has written the previous blocks.
 type Replay struct {
-	f              *os.File
-	fileWriter     *bufio.Writer
-	pendingChan    chan *visualize_grpc_pb.ReplayEntry
-	fileWriterDone chan struct{}
-	beginTime      time.Time
+	f                *os.File
+	blockChan        chan *pendingBlock
+	fileWriterDone   chan struct{}
+	curTime          uint64 // the simulation time (in us) of the appended entries.
+	state            *State // the network state, for the keyframes.
+	block            bytes.Buffer
+	blockTime        uint64
+	keyframeInterval uint64
+	maxBlockSize     int
+	err              error
+}
+
+type pendingBlock struct {
+	ts      uint64
+	records []byte
+}
+
+// SetCurTime sets the simulation time (in us) with which the following entries are timestamped.
+func (rep *Replay) SetCurTime(ts uint64) {
+	rep.curTime = ts
 }
 
-func (rep *Replay) Append(event *visualize_grpc_pb.VisualizeEvent, trivial bool) {
-	timestamp := time.Since(rep.beginTime) / time.Microsecond
-	entry := &visualize_grpc_pb.ReplayEntry{
+func (rep *Replay) Append(event *pb.VisualizeEvent) {
+	rep.appendEntry(&pb.ReplayEntry{
 		Event:     event,
-		Timestamp: uint64(timestamp),
-	}
+		Timestamp: rep.curTime,
+	})
+	rep.state.Apply(event)
+}
 
-	if !trivial {
-		rep.pendingChan <- entry
-	} else {
-		select {
-		case rep.pendingChan <- entry:
-			break
-		default:
-			logger.Warnf("replay generation routine is busy, dropping trivial events ...")
-			break
+func (rep *Replay) AppendEnergy(event *pb.NetworkEnergyEvent) {
+	rep.appendEntry(&pb.ReplayEntry{
+		Energy:    event,
+		Timestamp: rep.curTime,
+	})
+}
+
+// Close writes the pending entries and the index of the replay, and closes the replay file.
+func (rep *Replay) Close() error {
+	rep.completeBlock()
+	close(rep.blockChan)
+	<-rep.fileWriterDone
+	return rep.err
+}
+
+func (rep *Replay) appendEntry(entry *pb.ReplayEntry) {
+	if rep.block.Len() > 0 && (rep.curTime >= rep.blockTime+rep.keyframeInterval || rep.block.Len() >= rep.maxBlockSize) {
+		rep.completeBlock()
+
+		rep.blockTime = rep.curTime
+		for _, event := range rep.state.Snapshot() {
+			logger.PanicIfError(appendRecord(&rep.block, &pb.ReplayEntry{
+				Event:     event,
+				Timestamp: rep.curTime,
+				Keyframe:  true,
+			}))
 		}
 	}
+
+	logger.PanicIfError(appendRecord(&rep.block, entry))
 }
 
-func (rep *Replay) Close() {
-	close(rep.pendingChan)
-	<-rep.fileWriterDone
+func (rep *Replay) completeBlock() {
+	if rep.block.Len() == 0 {
+		return
+	}
+
+	records := make([]byte, rep.block.Len())
+	copy(records, rep.block.Bytes())
+	rep.block.Reset()
+	rep.blockChan <- &pendingBlock{ts: rep.blockTime, records: records}
 }
 
 func (rep *Replay) fileWriterRoutine() {
 	var err error
 
 	defer func() {
+		rep.err = err
 		close(rep.fileWriterDone)
 
 		if err != nil {
@@ -62,38 +108,68 @@ func (rep *Replay) fileWriterRoutine() {
 
 	defer rep.f.Close()
 
-	for e := range rep.pendingChan {
-		var data []byte
+	fileWriter := bufio.NewWriterSize(rep.f, 65536)
+	fw, err := flate.NewWriter(nil, flate.DefaultCompression)
+	if err != nil {
+		return
+	}
+
+	header := append([]byte(binaryMagic), binaryVersion)
+	if _, err = fileWriter.Write(header); err != nil {
+		return
+	}
+	offset := int64(len(header))
 
-		if data, err = marshalOptions.Marshal(e); err != nil {
+	var index []blockIndex
+	for b := range rep.blockChan {
+		var n int
+		if n, err = writeBlock(fileWriter, fw, b.ts, b.records); err != nil {
 			break
 		}
+		index = append(index, blockIndex{ts: b.ts, offset: offset})
+		offset += int64(n)
 
-		if _, err = rep.fileWriter.Write(data); err != nil {
+		// flush every block, so that the replay is usable even if it is not closed.
+		if err = fileWriter.Flush(); err != nil {
 			break
 		}
+	}
 
-		if _, err = rep.fileWriter.Write([]byte{'\n'}); err != nil {
-			break
+	if err != nil {
+		// keep receiving blocks, so that appending entries does not block forever.
+		for range rep.blockChan {
 		}
+		return
 	}
 
-	err = rep.fileWriter.Flush()
+	if err = writeIndex(fileWriter, index, offset); err != nil {
+		return
+	}
+	err = fileWriter.Flush()
 }
 
-func NewReplay(filename string) *Replay {
+func newReplay(filename string) (*Replay, error) {
 	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
-	logger.PanicIfError(err)
+	if err != nil {
+		return nil, err
+	}
 
 	rep := &Replay{
-		f:              f,
-		fileWriter:     bufio.NewWriterSize(f, 8192),
-		pendingChan:    make(chan *visualize_grpc_pb.ReplayEntry, 10000),
-		fileWriterDone: make(chan struct{}),
-		beginTime:      time.Now(),
+		f:                f,
+		blockChan:        make(chan *pendingBlock, 16),
+		fileWriterDone:   make(chan struct{}),
+		state:            NewState(),
+		keyframeInterval: defaultKeyframeInterval,
+		maxBlockSize:     defaultMaxBlockSize,
 	}
 
 	go rep.fileWriterRoutine()
 
+	return rep, nil
+}
+
+func NewReplay(filename string) *Replay {
+	rep, err := newReplay(filename)
+	logger.PanicIfError(err)
 	return rep
 }
@@ -0,0 +1,235 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+func readAll(t *testing.T, r *Reader) []*pb.ReplayEntry {
+	var entries []*pb.ReplayEntry
+	for {
+		entry, err := r.Peek()
+		assert.Nil(t, err)
+		if entry == nil {
+			return entries
+		}
+		entries = append(entries, entry)
+		r.Advance()
+	}
+}
+
+func assertEvents(t *testing.T, expected []*pb.VisualizeEvent, actual []*pb.VisualizeEvent) {
+	assert.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		if i < len(actual) {
+			assert.True(t, proto.Equal(expected[i], actual[i]), "expected %v, got %v", expected[i], actual[i])
+		}
+	}
+}
+
+func TestReplaySimulationTime(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.replay")
+	rep := NewReplay(filename)
+
+	rep.Append(&pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddNode{
+		AddNode: &pb.AddNodeEvent{NodeId: 1},
+	}})
+	rep.SetCurTime(1500000)
+	rep.Append(&pb.VisualizeEvent{Type: &pb.VisualizeEvent_DeleteNode{
+		DeleteNode: &pb.DeleteNodeEvent{NodeId: 1},
+	}})
+	rep.SetCurTime(3000000)
+	rep.AppendEnergy(&pb.NetworkEnergyEvent{Timestamp: 3})
+	assert.Nil(t, rep.Close())
+
+	r, err := Open(filename)
+	assert.Nil(t, err)
+	defer r.Close()
+	entries := readAll(t, r)
+
+	assert.Equal(t, 3, len(entries))
+	assert.Equal(t, uint64(0), entries[0].Timestamp)
+	assert.Equal(t, int32(1), entries[0].Event.GetAddNode().NodeId)
+	assert.Equal(t, uint64(1500000), entries[1].Timestamp)
+	assert.Equal(t, int32(1), entries[1].Event.GetDeleteNode().NodeId)
+	assert.Equal(t, uint64(3000000), entries[2].Timestamp)
+	assert.Nil(t, entries[2].Event)
+	assert.Equal(t, uint64(3), entries[2].Energy.Timestamp)
+}
+
+// writeTestReplay writes a replay of a node being added every second, and changing its role every 100 ms, and returns
+// the appended events.
+func writeTestReplay(t *testing.T, filename string) []*pb.ReplayEntry {
+	rep, err := newReplay(filename)
+	assert.Nil(t, err)
+	rep.keyframeInterval = 5000000
+
+	var entries []*pb.ReplayEntry
+	for ts := uint64(0); ts < 60000000; ts += 100000 {
+		rep.SetCurTime(ts)
+		nodeid := int32(ts/1000000) + 1
+
+		var event *pb.VisualizeEvent
+		if ts%1000000 == 0 {
+			event = &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddNode{AddNode: &pb.AddNodeEvent{NodeId: nodeid}}}
+		} else {
+			event = &pb.VisualizeEvent{Type: &pb.VisualizeEvent_SetNodeRole{SetNodeRole: &pb.SetNodeRoleEvent{
+				NodeId: nodeid,
+				Role:   pb.OtDeviceRole(ts / 100000 % 5),
+			}}}
+		}
+		rep.Append(event)
+		entries = append(entries, &pb.ReplayEntry{Timestamp: ts, Event: event})
+	}
+	assert.Nil(t, rep.Close())
+	return entries
+}
+
+func stateAt(entries []*pb.ReplayEntry, ts uint64) *State {
+	state := NewState()
+	for _, entry := range entries {
+		if entry.Timestamp <= ts {
+			state.Apply(entry.Event)
+		}
+	}
+	return state
+}
+
+func TestReplayBlocksAndSeek(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.replay")
+	expected := writeTestReplay(t, filename)
+
+	r, err := Open(filename)
+	assert.Nil(t, err)
+	defer r.Close()
+
+	src := r.src.(*binarySource)
+	assert.Equal(t, 12, len(src.index))
+	for i, bi := range src.index {
+		assert.Equal(t, uint64(i)*5000000, bi.ts)
+	}
+
+	entries := readAll(t, r)
+	assert.Equal(t, len(expected), len(entries))
+	for i := range expected {
+		assert.True(t, proto.Equal(expected[i], entries[i]), "entry %d", i)
+	}
+	assertEvents(t, stateAt(expected, 60000000).Snapshot(), r.State().Snapshot())
+
+	for _, ts := range []uint64{32150000, 1000000, 0, 1000000, 49999999, 50000000, 50000001, 51000000, 12345678, 70000000} {
+		assert.Nil(t, r.Seek(ts))
+		assertEvents(t, stateAt(expected, ts).Snapshot(), r.State().Snapshot())
+
+		next, err := r.Peek()
+		assert.Nil(t, err)
+		if ts < 59900000 {
+			assert.Equal(t, (ts/100000+1)*100000, next.Timestamp, "seek %d", ts)
+		} else {
+			assert.Nil(t, next)
+		}
+	}
+}
+
+func TestReplayWithoutIndex(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.replay")
+	expected := writeTestReplay(t, filename)
+
+	// truncate the index and half of the last block, as if the replay was not closed.
+	r, err := Open(filename)
+	assert.Nil(t, err)
+	index := r.src.(*binarySource).index
+	assert.Nil(t, r.Close())
+
+	lastBlock := index[len(index)-1].offset
+	fi, err := os.Stat(filename)
+	assert.Nil(t, err)
+	assert.Nil(t, os.Truncate(filename, lastBlock+(fi.Size()-lastBlock)/4))
+
+	r, err = Open(filename)
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Equal(t, index[:len(index)-1], r.src.(*binarySource).index)
+
+	entries := readAll(t, r)
+	assert.Equal(t, 550, len(entries))
+	assert.Equal(t, uint64(54900000), entries[len(entries)-1].Timestamp)
+
+	assert.Nil(t, r.Seek(20000000))
+	assertEvents(t, stateAt(expected, 20000000).Snapshot(), r.State().Snapshot())
+}
+
+func TestConvertTextReplay(t *testing.T) {
+	dir := t.TempDir()
+	textFilename := filepath.Join(dir, "text.replay")
+	binaryFilename := filepath.Join(dir, "binary.replay")
+
+	expected := []*pb.ReplayEntry{
+		{Timestamp: 0, Event: &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddNode{AddNode: &pb.AddNodeEvent{NodeId: 1, X: 100, Y: 200}}}},
+		{Timestamp: 1000, Event: &pb.VisualizeEvent{Type: &pb.VisualizeEvent_SetNodeRole{SetNodeRole: &pb.SetNodeRoleEvent{NodeId: 1, Role: pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER}}}},
+		{Timestamp: 2000, Energy: &pb.NetworkEnergyEvent{Timestamp: 2, NodesEnergy: []*pb.NodeEnergy{{NodeId: 1, Tx: 1.5}}}},
+		{Timestamp: 3000, Event: &pb.VisualizeEvent{Type: &pb.VisualizeEvent_Send{Send: &pb.SendEvent{SrcId: 1, DstId: -1}}}},
+	}
+	f, err := os.Create(textFilename)
+	assert.Nil(t, err)
+	for _, entry := range expected {
+		_, err = fmt.Fprintln(f, prototext.MarshalOptions{}.Format(entry))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, f.Close())
+
+	// the text replay can be read as is.
+	r, err := Open(textFilename)
+	assert.Nil(t, err)
+	entries := readAll(t, r)
+	assert.Nil(t, r.Seek(1500))
+	assertEvents(t, stateAt(expected[:2], 1500).Snapshot(), r.State().Snapshot())
+	assert.Nil(t, r.Close())
+	assert.Equal(t, len(expected), len(entries))
+
+	assert.Nil(t, Convert(textFilename, binaryFilename))
+	r, err = Open(binaryFilename)
+	assert.Nil(t, err)
+	defer r.Close()
+	_, ok := r.src.(*binarySource)
+	assert.True(t, ok)
+
+	entries = readAll(t, r)
+	assert.Equal(t, len(expected), len(entries))
+	for i := range expected {
+		assert.True(t, proto.Equal(expected[i], entries[i]), "entry %d", i)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+// nodeState is the reconstructed state of a node: the event that added the node and the latest event per node
+// attribute.
+type nodeState struct {
+	add   *pb.VisualizeEvent
+	attrs map[string]*pb.VisualizeEvent
+}
+
+// State reconstructs the network state at a point of a replay from the events up to that point. It is used to write
+// the keyframes of binary replays, and to re-send the network state to the visualization when seeking.
+type State struct {
+	nodes   map[int32]*nodeState
+	globals map[string]*pb.VisualizeEvent
+}
+
+func NewState() *State {
+	return &State{
+		nodes:   map[int32]*nodeState{},
+		globals: map[string]*pb.VisualizeEvent{},
+	}
+}
+
+// Apply updates the state with a replayed event. Transient events (e.g. frames being sent) do not change the state.
+func (s *State) Apply(e *pb.VisualizeEvent) {
+	switch ev := e.Type.(type) {
+	case *pb.VisualizeEvent_AddNode:
+		s.nodes[ev.AddNode.NodeId] = &nodeState{
+			add:   e,
+			attrs: map[string]*pb.VisualizeEvent{},
+		}
+	case *pb.VisualizeEvent_DeleteNode:
+		delete(s.nodes, ev.DeleteNode.NodeId)
+	case *pb.VisualizeEvent_SetNodeRloc16:
+		s.setNodeAttr(ev.SetNodeRloc16.NodeId, "rloc16", e)
+	case *pb.VisualizeEvent_SetNodeRole:
+		s.setNodeAttr(ev.SetNodeRole.NodeId, "role", e)
+	case *pb.VisualizeEvent_SetNodeMode:
+		s.setNodeAttr(ev.SetNodeMode.NodeId, "mode", e)
+	case *pb.VisualizeEvent_SetNodePos:
+		s.setNodeAttr(ev.SetNodePos.NodeId, "pos", e)
+	case *pb.VisualizeEvent_SetNodePartitionId:
+		s.setNodeAttr(ev.SetNodePartitionId.NodeId, "partition_id", e)
+	case *pb.VisualizeEvent_OnExtAddrChange:
+		s.setNodeAttr(ev.OnExtAddrChange.NodeId, "ext_addr", e)
+	case *pb.VisualizeEvent_SetParent:
+		s.setNodeAttr(ev.SetParent.NodeId, "parent", e)
+	case *pb.VisualizeEvent_OnNodeFail:
+		s.setNodeAttr(ev.OnNodeFail.NodeId, "failed", e)
+	case *pb.VisualizeEvent_OnNodeRecover:
+		s.setNodeAttr(ev.OnNodeRecover.NodeId, "failed", e)
+	case *pb.VisualizeEvent_AddRouterTable:
+		s.setNodeAttr(ev.AddRouterTable.NodeId, tableAttr("router_table", ev.AddRouterTable.ExtAddr), e)
+	case *pb.VisualizeEvent_RemoveRouterTable:
+		s.setNodeAttr(ev.RemoveRouterTable.NodeId, tableAttr("router_table", ev.RemoveRouterTable.ExtAddr), nil)
+	case *pb.VisualizeEvent_AddChildTable:
+		s.setNodeAttr(ev.AddChildTable.NodeId, tableAttr("child_table", ev.AddChildTable.ExtAddr), e)
+	case *pb.VisualizeEvent_RemoveChildTable:
+		s.setNodeAttr(ev.RemoveChildTable.NodeId, tableAttr("child_table", ev.RemoveChildTable.ExtAddr), nil)
+	case *pb.VisualizeEvent_CustomStatus:
+		s.setNodeAttr(ev.CustomStatus.NodeId, "custom_status:"+ev.CustomStatus.Key, e)
+	case *pb.VisualizeEvent_SetNetworkInfo:
+		s.globals["network_info"] = e
+	case *pb.VisualizeEvent_SetTitle:
+		s.globals["title"] = e
+	case *pb.VisualizeEvent_SetSpeed:
+		s.globals["speed"] = e
+	case *pb.VisualizeEvent_AdvanceTime:
+		s.globals["time"] = e
+	case *pb.VisualizeEvent_ShowDemoLegend:
+		s.globals["demo_legend"] = e
+	case *pb.VisualizeEvent_RssiHeatmap:
+		if ev.RssiHeatmap.Heatmap != nil {
+			s.globals["rssi_heatmap"] = e
+		} else {
+			delete(s.globals, "rssi_heatmap")
+		}
+	}
+}
+
+// Snapshot returns the events that rebuild the state on a visualization without any nodes.
+func (s *State) Snapshot() []*pb.VisualizeEvent {
+	var events []*pb.VisualizeEvent
+	for _, key := range sortedKeys(s.globals) {
+		events = append(events, s.globals[key])
+	}
+
+	for _, nodeid := range s.nodeIds() {
+		node := s.nodes[nodeid]
+		events = append(events, node.add)
+		for _, key := range sortedKeys(node.attrs) {
+			events = append(events, node.attrs[key])
+		}
+	}
+	return events
+}
+
+// ClearEvents returns the events that delete all nodes of the state from the visualization, and hide the RSSI
+// heatmap if shown.
+func (s *State) ClearEvents() []*pb.VisualizeEvent {
+	var events []*pb.VisualizeEvent
+	if _, ok := s.globals["rssi_heatmap"]; ok {
+		events = append(events, &pb.VisualizeEvent{
+			Type: &pb.VisualizeEvent_RssiHeatmap{RssiHeatmap: &pb.RssiHeatmapEvent{}},
+		})
+	}
+	for _, nodeid := range s.nodeIds() {
+		events = append(events, &pb.VisualizeEvent{
+			Type: &pb.VisualizeEvent_DeleteNode{DeleteNode: &pb.DeleteNodeEvent{NodeId: nodeid}},
+		})
+	}
+	return events
+}
+
+func (s *State) nodeIds() []int32 {
+	nodeids := make([]int32, 0, len(s.nodes))
+	for nodeid := range s.nodes {
+		nodeids = append(nodeids, nodeid)
+	}
+	sort.Slice(nodeids, func(i, j int) bool {
+		return nodeids[i] < nodeids[j]
+	})
+	return nodeids
+}
+
+// setNodeAttr sets the latest event of a node attribute, or removes the attribute if e is nil. Events of unknown
+// nodes are ignored.
+func (s *State) setNodeAttr(nodeid int32, attr string, e *pb.VisualizeEvent) {
+	node := s.nodes[nodeid]
+	if node == nil {
+		return
+	}
+	if e == nil {
+		delete(node.attrs, attr)
+	} else {
+		node.attrs[attr] = e
+	}
+}
+
+func tableAttr(table string, extaddr uint64) string {
+	return fmt.Sprintf("%s:%016x", table, extaddr)
+}
+
+func sortedKeys(m map[string]*pb.VisualizeEvent) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
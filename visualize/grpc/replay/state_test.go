@@ -0,0 +1,95 @@
+// Copyright (c) 2023, The OTNS Authors.
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// 1. Redistributions of source code must retain the above copyright
+//    notice, this list of conditions and the following disclaimer.
+// 2. Redistributions in binary form must reproduce the above copyright
+//    notice, this list of conditions and the following disclaimer in the
+//    documentation and/or other materials provided with the distribution.
+// 3. Neither the name of the copyright holder nor the
+//    names of its contributors may be used to endorse or promote products
+//    derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/openthread/ot-ns/visualize/grpc/pb"
+)
+
+func TestStateSnapshot(t *testing.T) {
+	s := NewState()
+	addNode := func(nodeid int32) *pb.VisualizeEvent {
+		return &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddNode{AddNode: &pb.AddNodeEvent{NodeId: nodeid}}}
+	}
+	setRole := func(nodeid int32, role pb.OtDeviceRole) *pb.VisualizeEvent {
+		return &pb.VisualizeEvent{Type: &pb.VisualizeEvent_SetNodeRole{SetNodeRole: &pb.SetNodeRoleEvent{NodeId: nodeid, Role: role}}}
+	}
+	childTable := func(nodeid int32, extaddr uint64, add bool) *pb.VisualizeEvent {
+		if add {
+			return &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AddChildTable{AddChildTable: &pb.AddChildTableEvent{NodeId: nodeid, ExtAddr: extaddr}}}
+		}
+		return &pb.VisualizeEvent{Type: &pb.VisualizeEvent_RemoveChildTable{RemoveChildTable: &pb.RemoveChildTableEvent{NodeId: nodeid, ExtAddr: extaddr}}}
+	}
+	send := &pb.VisualizeEvent{Type: &pb.VisualizeEvent_Send{Send: &pb.SendEvent{SrcId: 1, DstId: 2}}}
+	advanceTime := &pb.VisualizeEvent{Type: &pb.VisualizeEvent_AdvanceTime{AdvanceTime: &pb.AdvanceTimeEvent{Ts: 1000}}}
+
+	s.Apply(addNode(2))
+	s.Apply(addNode(1))
+	s.Apply(addNode(3))
+	s.Apply(setRole(1, pb.OtDeviceRole_OT_DEVICE_ROLE_DETACHED))
+	s.Apply(setRole(1, pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER))
+	s.Apply(childTable(1, 0x1234, true))
+	s.Apply(childTable(1, 0x5678, true))
+	s.Apply(childTable(1, 0x1234, false))
+	s.Apply(send)
+	s.Apply(advanceTime)
+	s.Apply(&pb.VisualizeEvent{Type: &pb.VisualizeEvent_DeleteNode{DeleteNode: &pb.DeleteNodeEvent{NodeId: 3}}})
+	s.Apply(setRole(3, pb.OtDeviceRole_OT_DEVICE_ROLE_ROUTER))
+
+	assert.Equal(t, []*pb.VisualizeEvent{
+		advanceTime,
+		addNode(1),
+		childTable(1, 0x5678, true),
+		setRole(1, pb.OtDeviceRole_OT_DEVICE_ROLE_LEADER),
+		addNode(2),
+	}, s.Snapshot())
+
+	assert.Equal(t, []*pb.VisualizeEvent{
+		{Type: &pb.VisualizeEvent_DeleteNode{DeleteNode: &pb.DeleteNodeEvent{NodeId: 1}}},
+		{Type: &pb.VisualizeEvent_DeleteNode{DeleteNode: &pb.DeleteNodeEvent{NodeId: 2}}},
+	}, s.ClearEvents())
+}
+
+func TestStateRssiHeatmap(t *testing.T) {
+	s := NewState()
+	showHeatmap := &pb.VisualizeEvent{Type: &pb.VisualizeEvent_RssiHeatmap{RssiHeatmap: &pb.RssiHeatmapEvent{
+		Heatmap: &pb.RssiHeatmap{CellSize: 20, Cols: 1, Rows: 1, Rssi: []float32{-60}},
+	}}}
+	hideHeatmap := &pb.VisualizeEvent{Type: &pb.VisualizeEvent_RssiHeatmap{RssiHeatmap: &pb.RssiHeatmapEvent{}}}
+
+	s.Apply(showHeatmap)
+	assert.Equal(t, []*pb.VisualizeEvent{showHeatmap}, s.Snapshot())
+	assert.Equal(t, []*pb.VisualizeEvent{hideHeatmap}, s.ClearEvents())
+
+	s.Apply(hideHeatmap)
+	assert.Equal(t, 0, len(s.Snapshot()))
+	assert.Equal(t, 0, len(s.ClearEvents()))
+}
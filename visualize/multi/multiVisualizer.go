@@ -113,6 +113,12 @@ func (mv *multiVisualizer) AdvanceTime(ts uint64, speed float64) {
 	}
 }
 
+func (mv *multiVisualizer) SetCurTime(ts uint64) {
+	for _, v := range mv.vs {
+		v.SetCurTime(ts)
+	}
+}
+
 func (mv *multiVisualizer) OnNodeFail(nodeid NodeId) {
 	for _, v := range mv.vs {
 		v.OnNodeFail(nodeid)
@@ -173,6 +179,12 @@ func (mv *multiVisualizer) ShowDemoLegend(x int, y int, title string) {
 	}
 }
 
+func (mv *multiVisualizer) ShowRssiHeatmap(heatmap *pb.RssiHeatmap) {
+	for _, v := range mv.vs {
+		v.ShowRssiHeatmap(heatmap)
+	}
+}
+
 func (mv *multiVisualizer) CountDown(duration time.Duration, text string) {
 	for _, v := range mv.vs {
 		v.CountDown(duration, text)
@@ -185,6 +197,12 @@ func (mv *multiVisualizer) SetParent(id NodeId, extaddr uint64) {
 	}
 }
 
+func (mv *multiVisualizer) OnCustomStatus(id NodeId, key string, value string) {
+	for _, v := range mv.vs {
+		v.OnCustomStatus(id, key, value)
+	}
+}
+
 func (mv *multiVisualizer) SetTitle(titleInfo visualize.TitleInfo) {
 	for _, v := range mv.vs {
 		v.SetTitle(titleInfo)
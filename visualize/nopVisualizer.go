@@ -54,6 +54,9 @@ func (nv nopVisualizer) CountDown(duration time.Duration, text string) {
 func (nv nopVisualizer) ShowDemoLegend(x int, y int, title string) {
 }
 
+func (nv nopVisualizer) ShowRssiHeatmap(heatmap *pb.RssiHeatmap) {
+}
+
 func (nv nopVisualizer) AddRouterTable(id NodeId, extaddr uint64) {
 }
 
@@ -113,6 +116,10 @@ func (nv nopVisualizer) AdvanceTime(ts uint64, speed float64) {
 
 }
 
+func (nv nopVisualizer) SetCurTime(ts uint64) {
+
+}
+
 func (nv nopVisualizer) OnNodeFail(NodeId) {
 
 }
@@ -121,6 +128,10 @@ func (nv nopVisualizer) OnNodeRecover(NodeId) {
 
 }
 
+func (nv nopVisualizer) OnCustomStatus(id NodeId, key string, value string) {
+
+}
+
 func (nv nopVisualizer) SetTitle(titleInfo TitleInfo) {
 
 }
@@ -48,6 +48,7 @@ type Visualizer interface {
 	SetNodePartitionId(nodeid NodeId, parid uint32)
 	SetSpeed(speed float64)
 	AdvanceTime(ts uint64, speed float64)
+	SetCurTime(ts uint64)
 
 	OnNodeFail(nodeId NodeId)
 	OnNodeRecover(nodeId NodeId)
@@ -59,9 +60,11 @@ type Visualizer interface {
 	AddChildTable(id NodeId, extaddr uint64)
 	RemoveChildTable(id NodeId, extaddr uint64)
 	ShowDemoLegend(x int, y int, title string)
+	ShowRssiHeatmap(heatmap *pb.RssiHeatmap)
 	CountDown(duration time.Duration, text string)
 	SetParent(id NodeId, extaddr uint64)
 	OnExtAddrChange(id NodeId, extaddr uint64)
+	OnCustomStatus(id NodeId, key string, value string)
 	SetTitle(titleInfo TitleInfo)
 	SetNetworkInfo(networkInfo NetworkInfo)
 	UpdateNodesEnergy(node []*pb.NodeEnergy, timestamp uint64, updateView bool)
@@ -76,9 +79,13 @@ type MsgVisualizeInfo struct {
 	DstAddrExtended uint64
 	SendDurationUs  uint32
 	PowerDbm        int8
+	MsgType         string // the dissected message type, e.g. "MLE" or "CMD"; empty if unknown.
 }
 
 func (info *MsgVisualizeInfo) Label() string {
+	if info.MsgType != "" {
+		return fmt.Sprintf("%s%03d", info.MsgType, info.Seq)
+	}
 	frameType := info.FrameControl.FrameType()
 	if frameType == wpan.FrameTypeAck {
 		return fmt.Sprintf("ACK%03d", info.Seq)
@@ -54,6 +54,7 @@ export default class Node extends VObject {
         this.role = OtDeviceRole.OT_DEVICE_ROLE_DISABLED;
         this.txPowerLast = POWER_DBM_INVALID;
         this.channelLast = -1;
+        this.customStatus = {};
         this._failed = false;
         this._parent = 0;
         this._partition = 0;
@@ -78,6 +78,10 @@ export default class NodeWindow extends VObject {
                 +  `Tx-Channel: ${node.channelLast}     (last fr)\n`;
         }
 
+        for (let key of Object.keys(node.customStatus).sort()) {
+            txt += `${key.padEnd(10)}: ${node.customStatus[key]}\n`;
+        }
+
         win.value = txt;
     }
 }
@@ -30,7 +30,8 @@ import ActionBar from "./ActionBar";
 import {Text} from "./wrapper";
 import {
     FRAME_CONTROL_MASK_FRAME_TYPE, FRAME_TYPE_ACK, LOG_WINDOW_FONT_COLOR, MAX_SPEED, PAUSE_SPEED, STATUS_MSG_FONT_FAMILY,
-    STATUS_MSG_FONT_SIZE, NODE_ID_INVALID
+    STATUS_MSG_FONT_SIZE, NODE_ID_INVALID, HEATMAP_ALPHA, HEATMAP_RSSI_STRONG_DBM, HEATMAP_RSSI_WEAK_DBM,
+    COLOR_HEATMAP_STRONG, COLOR_HEATMAP_MEDIUM, COLOR_HEATMAP_WEAK, COLOR_HEATMAP_UNREACHABLE
 } from "./consts";
 import Node from "./Node"
 import {AckMessage, BroadcastMessage, UnicastMessage} from "./message";
@@ -76,6 +77,9 @@ export default class PixiVisualizer extends VObject {
 
         this.nodeLogColor = {};
 
+        this._heatmapStage = new PIXI.Container();
+        this.addChild(this._heatmapStage);
+
         this._bgStage = new PIXI.Container();
         this.addChild(this._bgStage);
 
@@ -365,6 +369,12 @@ export default class PixiVisualizer extends VObject {
         this.onNodeUpdate(nodeId);
     }
 
+    visOnCustomStatus(nodeId, key, value) {
+        this.nodes[nodeId].customStatus[key] = value;
+        this.logNode(nodeId, `Status ${key}=${value}`)
+        this.onNodeUpdate(nodeId);
+    }
+
     visOnNodeFail(nodeId) {
         this.nodes[nodeId].failed = true;
         this.logNode(nodeId, "Radio is OFF")
@@ -439,6 +449,37 @@ export default class PixiVisualizer extends VObject {
         console.log("ShowDemoLegend not implemented")
     }
 
+    visShowRssiHeatmap(heatmap) {
+        this._heatmapStage.removeChildren().forEach(child => child.destroy());
+        if (!heatmap) {
+            return
+        }
+
+        const graphics = new PIXI.Graphics();
+        let cellSize = heatmap.getCellSize();
+        let rssi = heatmap.getRssiList();
+        let reachable = heatmap.getReachableList();
+        for (let row = 0; row < heatmap.getRows(); row++) {
+            for (let col = 0; col < heatmap.getCols(); col++) {
+                let i = row * heatmap.getCols() + col;
+                let color = COLOR_HEATMAP_UNREACHABLE;
+                if (reachable[i]) {
+                    if (rssi[i] >= HEATMAP_RSSI_STRONG_DBM) {
+                        color = COLOR_HEATMAP_STRONG
+                    } else if (rssi[i] >= HEATMAP_RSSI_WEAK_DBM) {
+                        color = COLOR_HEATMAP_MEDIUM
+                    } else {
+                        color = COLOR_HEATMAP_WEAK
+                    }
+                }
+                graphics.beginFill(color, HEATMAP_ALPHA);
+                graphics.drawRect(heatmap.getX() + col * cellSize, heatmap.getY() + row * cellSize, cellSize, cellSize);
+                graphics.endFill();
+            }
+        }
+        this._heatmapStage.addChild(graphics)
+    }
+
     visCountDown(durationMs, title) {
         console.log("CountDown not implemented")
     }
@@ -732,7 +773,7 @@ export default class PixiVisualizer extends VObject {
 
     deleteMessage(msg) {
         delete this._messages[msg.id];
-        msg._root.destroy()
+        msg._root.destroy({children: true})
     }
 
     createBroadcastMessage(src, mvInfo) {
@@ -39,6 +39,15 @@ export const FRAME_CONTROL_MASK_FRAME_TYPE = 0x7;
 export const FRAME_TYPE_ACK = 2;
 export const POWER_DBM_INVALID = 127;
 
+// RSSI heatmap overlay: cell colors from strong to weak RSSI, and for cells out of reach.
+export const HEATMAP_ALPHA = 0.3;
+export const HEATMAP_RSSI_STRONG_DBM = -60;
+export const HEATMAP_RSSI_WEAK_DBM = -80;
+export const COLOR_HEATMAP_STRONG = 0x4caf50;
+export const COLOR_HEATMAP_MEDIUM = 0xffeb3b;
+export const COLOR_HEATMAP_WEAK = 0xff9800;
+export const COLOR_HEATMAP_UNREACHABLE = 0xf44336;
+
 // colors and fonts
 export const COLOR_ACK_MESSAGE = 0xaee571;
 export const BUTTON_LABEL_FONT_FAMILY = 'verdana, helvetica, sans-serif';
@@ -46,6 +55,8 @@ export const NODE_LABEL_FONT_FAMILY = 'helvetica, arial, monospace, sans-serif';
 export const NODE_LABEL_FONT_SIZE = 13;
 export const STATUS_MSG_FONT_FAMILY = 'consolas, monaco, monospace';
 export const STATUS_MSG_FONT_SIZE = 13;
+export const MESSAGE_LABEL_FONT_FAMILY = 'helvetica, arial, monospace, sans-serif';
+export const MESSAGE_LABEL_FONT_SIZE = 10;
 
 export const LOG_WINDOW_FONT_FAMILY = 'verdana, helvetica, sans-serif';
 export const LOG_WINDOW_FONT_SIZE = 11.5;
@@ -27,13 +27,31 @@
 import * as PIXI from "pixi.js-legacy";
 import LVObject from "./LVObject";
 import {Resources} from "./resources";
-import {COLOR_ACK_MESSAGE} from "./consts";
+import {COLOR_ACK_MESSAGE, MESSAGE_LABEL_FONT_FAMILY, MESSAGE_LABEL_FONT_SIZE} from "./consts";
 
 const BROADCAST_MESSAGE_SCALE = 128;
 const UNICAST_MESSAGE_SCALE = 64;
 
 let nextMessageId = 1;
 
+// createMessageRoot returns the root container of a message with the given sprite, and a label with the
+// dissected message type (e.g. "MLE" or "UDP") if it is known.
+function createMessageRoot(sprite, mvInfo, color) {
+    let root = new PIXI.Container();
+    root.addChild(sprite);
+    let msgType = mvInfo.getMsgType();
+    if (msgType) {
+        let label = new PIXI.Text(msgType, {
+            fontFamily: MESSAGE_LABEL_FONT_FAMILY,
+            fontSize: MESSAGE_LABEL_FONT_SIZE,
+            fill: color
+        });
+        label.position.set(6, -6 - MESSAGE_LABEL_FONT_SIZE);
+        root.addChild(label);
+    }
+    return root;
+}
+
 export class BroadcastMessage extends LVObject {
     constructor(src, mvInfo) {
         super();
@@ -47,10 +65,11 @@ export class BroadcastMessage extends LVObject {
         sprite.tint = this.getColor();
         sprite.scale.set(beginRadius * 2 / BROADCAST_MESSAGE_SCALE, beginRadius * 2 / BROADCAST_MESSAGE_SCALE);
         sprite.anchor.set(0.5, 0.5);
-        sprite.position = src.position;
         this._targetRadius = src.radioRange;
         sprite.alpha = 0.1;
-        this._root = this.sprite = sprite;
+        this.sprite = sprite;
+        this._root = createMessageRoot(sprite, mvInfo, this.getColor());
+        this._root.position = src.position;
         this.configureLifetime(mvInfo);
     }
 
@@ -105,8 +124,9 @@ export class UnicastMessage extends LVObject {
         sprite.tint = this.getColor();
         sprite.scale.set(size / UNICAST_MESSAGE_SCALE, size / UNICAST_MESSAGE_SCALE);
         sprite.anchor.set(0.5, 0.5);
-        sprite.position = this.src.position;
-        this._root = this.sprite = sprite;
+        this.sprite = sprite;
+        this._root = createMessageRoot(sprite, mvInfo, this.getColor());
+        this._root.position = this.src.position;
         this.configureLifetime(mvInfo);
     }
 
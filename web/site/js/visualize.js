@@ -191,6 +191,14 @@ function loadOk() {
                 e = resp.getSetNetworkInfo();
                 vis.visSetNetworkInfo(e.getVersion(), e.getCommit(), e.getReal());
                 break;
+            case VisualizeEvent.TypeCase.CUSTOM_STATUS:
+                e = resp.getCustomStatus();
+                vis.visOnCustomStatus(e.getNodeId(), e.getKey(), e.getValue());
+                break;
+            case VisualizeEvent.TypeCase.RSSI_HEATMAP:
+                e = resp.getRssiHeatmap();
+                vis.visShowRssiHeatmap(e.getHeatmap());
+                break;
             default:
                 console.log('unknown event!!! ' + resp.getTypeCase());
                 break